@@ -8,4 +8,7 @@ import (
 func main() {
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: netapp.Provider})
+
+	// Terraform is done with the provider
+	netapp.Shutdown()
 }
@@ -0,0 +1,191 @@
+package netapp
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// ontapiVersion is the ONTAPI version major.minor reported on connect,
+// e.g. 1.30 for ONTAP 8.3 and 1.130 for ONTAP 9.3
+type ontapiVersion struct {
+	major, minor int
+}
+
+func parseOntapiVersion(version string) (ontapiVersion, error) {
+	parts := strings.SplitN(version, ".", 2)
+	if len(parts) != 2 {
+		return ontapiVersion{}, fmt.Errorf(
+			"ONTAPI version must be [major.minor], got: %s", version)
+	}
+
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return ontapiVersion{}, fmt.Errorf(
+			"invalid ONTAPI major version [%s], got: %s", version, err)
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return ontapiVersion{}, fmt.Errorf(
+			"invalid ONTAPI minor version [%s], got: %s", version, err)
+	}
+
+	return ontapiVersion{major: major, minor: minor}, nil
+}
+
+func (v ontapiVersion) less(other ontapiVersion) bool {
+	if v.major != other.major {
+		return v.major < other.major
+	}
+
+	return v.minor < other.minor
+}
+
+// release returns the ONTAP release of the ONTAPI version, e.g. ONTAPI
+// 1.31 is ONTAP 8.3.1 and 1.130 is ONTAP 9.3
+func (v ontapiVersion) release() string {
+	generation, minor := 8, v.minor
+	if minor >= 100 {
+		generation, minor = 9, minor-100
+	}
+
+	release := fmt.Sprintf("%d.%d", generation, minor/10)
+	if minor%10 != 0 {
+		release += fmt.Sprintf(".%d", minor%10)
+	}
+
+	return release
+}
+
+func (v ontapiVersion) String() string {
+	return fmt.Sprintf("ONTAP %s (ONTAPI %d.%d)", v.release(), v.major, v.minor)
+}
+
+// Capability is a feature of the NetApp API available from ONTAPI version
+// Since until (excluding) ONTAPI version Until, zero for no bound
+type Capability struct {
+	Feature string
+	Since   ontapiVersion
+	Until   ontapiVersion
+	Hint    string // alternative if the feature is not supported
+}
+
+// the capability matrix of the resources
+var (
+	// broadcast domains, IPspaces and subnets replace the port roles of
+	// ONTAP 8.2 and earlier
+	capBroadcastDomain = &Capability{
+		Feature: "broadcast domains",
+		Since:   ontapiVersion{1, 30},
+	}
+	capBcDomainMtuUpdate = &Capability{
+		Feature: "broadcast domain MTU update",
+		Since:   ontapiVersion{1, 30},
+		Hint:    "set admin_mtu of the ports",
+	}
+	capIPSpace = &Capability{
+		Feature: "IPspaces",
+		Since:   ontapiVersion{1, 30},
+	}
+	capSubnet = &Capability{
+		Feature: "subnets",
+		Since:   ontapiVersion{1, 30},
+	}
+	capPortRole = &Capability{
+		Feature: "port role",
+		Until:   ontapiVersion{1, 30},
+		Hint:    "use ipspace and broadcast domains",
+	}
+)
+
+// supportedBy reports whether the ONTAPI version has the capability
+func (c *Capability) supportedBy(version ontapiVersion) bool {
+	if version.less(c.Since) {
+		return false
+	}
+
+	return c.Until == (ontapiVersion{}) || version.less(c.Until)
+}
+
+func (c *Capability) unsupportedError(version ontapiVersion) error {
+	msg := fmt.Sprintf("%s not supported by the cluster %s", c.Feature, version)
+	switch {
+	case version.less(c.Since):
+		msg += fmt.Sprintf(", requires %s or later", c.Since)
+	case c.Until != (ontapiVersion{}):
+		msg += fmt.Sprintf(", removed with %s", c.Until)
+	}
+	if c.Hint != "" {
+		msg += ", " + c.Hint
+	}
+
+	return errors.New(msg)
+}
+
+// Supports reports whether the cluster has the capability, an unknown
+// cluster version, e.g. an incomplete cassette, supports everything
+func (c *NetAppClient) Supports(capability *Capability) bool {
+	return c.RequireCapability(capability) == nil
+}
+
+// RequireCapability returns the error explaining why the cluster version
+// does not support the capability, nil if supported
+func (c *NetAppClient) RequireCapability(capability *Capability) error {
+	if c.OntapVersion == "" {
+		return nil
+	}
+
+	version, err := parseOntapiVersion(c.OntapVersion)
+	if err != nil {
+		return err
+	}
+	if !capability.supportedBy(version) {
+		return capability.unsupportedError(version)
+	}
+
+	return nil
+}
+
+// attributeCapability requires the capability for an attribute set in
+// the configuration, for updateOnly the attribute change of an existing
+// resource requires it. An empty attribute requires it for the resource
+type attributeCapability struct {
+	attribute  string
+	capability *Capability
+	updateOnly bool
+}
+
+// customizeDiffCapabilities rejects attributes the cluster version does
+// not support at plan time instead of failing during apply
+func customizeDiffCapabilities(
+	resourceName string, gates ...attributeCapability) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		client := meta.(*NetAppClient)
+		for _, gate := range gates {
+			switch {
+			case gate.attribute == "":
+			case gate.updateOnly:
+				if d.Id() == "" || !d.HasChange(gate.attribute) {
+					continue
+				}
+			default:
+				if _, ok := d.GetOk(gate.attribute); !ok {
+					continue
+				}
+			}
+
+			if err := client.RequireCapability(gate.capability); err != nil {
+				if gate.attribute == "" {
+					return fmt.Errorf("%s: %s", resourceName, err)
+				}
+
+				return fmt.Errorf("%s attribute %q: %s", resourceName, gate.attribute, err)
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,85 @@
+package netapp
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestOntapiVersionRelease(t *testing.T) {
+	for version, release := range map[string]string{
+		"1.21": "8.2.1", "1.30": "8.3", "1.31": "8.3.1",
+		"1.130": "9.3", "1.160": "9.6", "1.191": "9.9.1",
+	} {
+		v, err := parseOntapiVersion(version)
+		if err != nil {
+			t.Fatalf("could not parse ONTAPI version [%s]: %s", version, err)
+		}
+		if v.release() != release {
+			t.Fatalf("expected ONTAPI [%s] to be ONTAP %s, got: %s", version, release, v.release())
+		}
+	}
+
+	for _, version := range []string{"", "1", "1.x", "NetApp Release 9.3"} {
+		if _, err := parseOntapiVersion(version); err == nil {
+			t.Fatalf("expected error for ONTAPI version [%s]", version)
+		}
+	}
+}
+
+func TestClientCapabilities(t *testing.T) {
+	client := &NetAppClient{OntapVersion: "1.21"}
+	if client.Supports(capIPSpace) || !client.Supports(capPortRole) {
+		t.Fatalf("expected ONTAP 8.2.1 without IPspaces but with port roles")
+	}
+	err := client.RequireCapability(capIPSpace)
+	if err == nil || !strings.Contains(err.Error(), "requires ONTAP 8.3 (ONTAPI 1.30) or later") {
+		t.Fatalf("expected required version in error, got: %v", err)
+	}
+
+	client.OntapVersion = "1.130"
+	if !client.Supports(capIPSpace) || client.Supports(capPortRole) {
+		t.Fatalf("expected ONTAP 9.3 with IPspaces but without port roles")
+	}
+	err = client.RequireCapability(capPortRole)
+	if err == nil || !strings.Contains(err.Error(), "use ipspace") {
+		t.Fatalf("expected hint in error, got: %v", err)
+	}
+
+	// e.g. cassette without connect
+	client.OntapVersion = ""
+	if !client.Supports(capIPSpace) || !client.Supports(capPortRole) {
+		t.Fatalf("expected unknown version to support all capabilities")
+	}
+}
+
+func TestCapabilityPlanRejection(t *testing.T) {
+	tc := newTestCluster(t)
+	meta := tc.client(t)
+
+	// port role removed with ONTAP 8.3
+	port := resourceNetAppPort()
+	cfg := map[string]interface{}{
+		"node_id":  tc.nodeID,
+		"nic_name": "e0c",
+		"role":     "data",
+	}
+	_, err := port.Diff(nil, testResourceConfig(t, cfg), meta)
+	if err == nil || !strings.Contains(err.Error(), `netapp_port attribute "role": port role not supported`) {
+		t.Fatalf("expected plan to reject port role, got: %v", err)
+	}
+	delete(cfg, "role")
+	if _, err := port.Diff(nil, testResourceConfig(t, cfg), meta); err != nil {
+		t.Fatalf("expected plan without port role, got: %s", err)
+	}
+
+	// IPspaces introduced with ONTAP 8.3
+	meta.OntapVersion = "1.21"
+	_, err = resourceNetAppIPSpace().Diff(
+		nil, testResourceConfig(t, map[string]interface{}{"name": "ips1"}), meta)
+	if err == nil || !strings.Contains(err.Error(), "netapp_ipspace: IPspaces not supported by the cluster ONTAP 8.2.1") {
+		t.Fatalf("expected plan to reject ipspace, got: %v", err)
+	}
+	if tc.CommandCount("NW.IPSPACE.CREATE") != 0 {
+		t.Fatalf("expected no ipspace create")
+	}
+}
@@ -0,0 +1,58 @@
+package netapp
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/cassette"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/pythonapi"
+)
+
+// port resource IDs of the simulated cluster the cassettes are recorded on
+var testCassettePortIDs = map[string]string{
+	"e0c": "node1|e0c|00:a0:98:00:00:01",
+	"e0d": "node1|e0d|00:a0:98:00:00:02",
+	"e0e": "node1|e0e|00:a0:98:00:00:03",
+}
+
+// newCassetteCluster replays the API calls from testdata/<name>.cassette
+// to the test providers, with NETAPP_TEST_CASSETTE_MODE=record the
+// cassette is (re-)recorded against the simulator, NETAPP_CASSETTE_MODE
+// would set the cassette_mode of the test providers. Cassettes captured
+// from a real cluster with the provider cassette_mode can be dropped in
+// to turn them into regression tests.
+func newCassetteCluster(t *testing.T, name string) *testCluster {
+	path := filepath.Join("testdata", name+".cassette")
+
+	if os.Getenv("NETAPP_TEST_CASSETTE_MODE") == cassette.ModeRecord {
+		tc := newTestCluster(t)
+		for pName, pID := range testCassettePortIDs {
+			if tc.portIDs[pName] != pID {
+				t.Fatalf("simulator port [%s] ID changed to: %s", pName, tc.portIDs[pName])
+			}
+		}
+
+		// one recording of all providers of the test
+		rec, err := cassette.NewRecorder(path)
+		if err != nil {
+			t.Fatalf("could not start recording: %s", err)
+		}
+		t.Cleanup(func() { rec.Close() })
+
+		tc.backend = pythonapi.Wrap(tc.backend, rec.Middleware())
+		return tc
+	}
+
+	player, err := cassette.Load(path)
+	if err != nil {
+		t.Fatalf("could not load cassette: %s", err)
+	}
+	t.Cleanup(func() {
+		if remaining := player.Remaining(); remaining > 0 && !t.Failed() {
+			t.Errorf("cassette [%s] has %d calls not replayed", path, remaining)
+		}
+	})
+
+	return &testCluster{backend: player, portIDs: testCassettePortIDs}
+}
@@ -1,26 +1,114 @@
 package netapp
 
 import (
+	"context"
 	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/cassette"
 	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/pythonapi"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/restapi"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/zapiapi"
 
 	netappsys "github.com/jogam/terraform-provider-netapp/netapp/internal/helper/system"
 )
 
+const (
+	// apiTypeNMSDK uses the Python API with NetApp Manageability SDK
+	apiTypeNMSDK = "nmsdk"
+	// apiTypeZAPI uses the native Go ZAPI client
+	apiTypeZAPI = "zapi"
+	// apiTypeREST uses the native Go ONTAP REST client (ONTAP 9.6+)
+	apiTypeREST = "rest"
+)
+
+const (
+	// installModeOnline installs the Python API packages from the index
+	installModeOnline = "online"
+	// installModeWheelhouse installs them offline from api_wheelhouse
+	installModeWheelhouse = "wheelhouse"
+	// installModeBundled installs them offline from the bundledWheels
+	// folder next to the provider binary
+	installModeBundled = "bundled"
+
+	bundledWheels = "wheels"
+)
+
+// NetAppClient is the provider meta data used by all resources
 type NetAppClient struct {
-	api *pythonapi.NetAppAPI
+	api pythonapi.Backend
+
+	// OntapVersion is the ONTAPI version of the cluster, e.g. 1.130 for
+	// ONTAP 9.3, OsVersion the ONTAP release of the cluster
+	OntapVersion string
+	OsVersion    string
+
+	// AdoptExisting adopts objects which already exist on create
+	AdoptExisting bool
 }
 
 type Config struct {
 	User     string
 	Password string
 	Host     string
+	ApiType  string
 	SdkRoot  string
 	ApiPath  string
 	ApiPort  string
 	RegPort  string
+
+	// InstallMode is the Python API package install, Wheelhouse the folder
+	// of the wheels for an offline install, empty installs online
+	InstallMode string
+	Wheelhouse  string
+
+	// ApiLogFile / ApiLogMaxSize are the file log of the Python API and its
+	// size in MB before rotation
+	ApiLogFile    string
+	ApiLogMaxSize int
+
+	// connection options of the NetApp host, see netappsys.ConnectRequest
+	Transport  string
+	Port       int
+	ServerType string
+	Insecure   bool
+	CAFile     string
+
+	// ClientCertFile / ClientKeyFile authenticate User instead of Password
+	ClientCertFile string
+	ClientKeyFile  string
+
+	// ApiTimeout / CommandTimeouts are the API call timeouts in seconds
+	ApiTimeout      int
+	CommandTimeouts map[string]int
+
+	// MaxRetries / RetryMaxWait are the retries of transient API failures,
+	// RetryMaxWait in seconds
+	MaxRetries   int
+	RetryMaxWait int
+
+	// MaxConcurrentCalls is the limit of concurrent API calls, 0 for none
+	MaxConcurrentCalls int
+
+	// ReadCacheTTL is the time in seconds the idempotent reads are cached,
+	// 0 disables the cache
+	ReadCacheTTL int
+
+	AdoptExisting bool
+
+	CassetteMode string
+	CassetteFile string
+
+	// MetricsFormat of the API call metrics file in ApiPath written at
+	// provider shutdown, empty only logs the metrics
+	MetricsFormat string
+
+	// backend replaces the API of ApiType, e.g. by the cluster simulator
+	backend pythonapi.Backend
 }
 
 // NewConfig returns a new Config from the supplied ResourceData
@@ -29,61 +117,393 @@ func NewConfig(d *schema.ResourceData) (*Config, error) {
 		User:     d.Get("user").(string),
 		Password: d.Get("password").(string),
 		Host:     d.Get("host").(string),
+		ApiType:  d.Get("api_type").(string),
 		SdkRoot:  d.Get("nmsdk_root_path").(string),
 		ApiPath:  d.Get("api_folder").(string),
 		ApiPort:  d.Get("api_port").(string),
 		RegPort:  d.Get("api_client_registry_port").(string),
+
+		InstallMode: d.Get("api_install_mode").(string),
+		Wheelhouse:  d.Get("api_wheelhouse").(string),
+
+		ApiLogFile:    d.Get("api_log_file").(string),
+		ApiLogMaxSize: d.Get("api_log_max_size").(int),
+
+		Transport:  d.Get("transport").(string),
+		Port:       d.Get("port").(int),
+		ServerType: d.Get("server_type").(string),
+		Insecure:   d.Get("insecure").(bool),
+		CAFile:     d.Get("ca_file").(string),
+
+		ClientCertFile: d.Get("client_cert_file").(string),
+		ClientKeyFile:  d.Get("client_key_file").(string),
+
+		ApiTimeout:   d.Get("api_timeout").(int),
+		MaxRetries:   d.Get("max_retries").(int),
+		RetryMaxWait: d.Get("retry_max_wait").(int),
+		ReadCacheTTL: d.Get("api_read_cache_ttl").(int),
+
+		MaxConcurrentCalls: d.Get("max_concurrent_calls").(int),
+
+		AdoptExisting: d.Get("adopt_existing").(bool),
+
+		CassetteMode: d.Get("cassette_mode").(string),
+		CassetteFile: d.Get("cassette_file").(string),
+
+		MetricsFormat: d.Get("api_metrics_format").(string),
+	}
+
+	if c.ApiTimeout < 0 {
+		return nil, fmt.Errorf("api_timeout must not be negative, got: %d", c.ApiTimeout)
+	}
+
+	if c.ApiLogMaxSize < 0 {
+		return nil, fmt.Errorf(
+			"api_log_max_size must not be negative, got: %d", c.ApiLogMaxSize)
+	}
+
+	if c.MaxRetries < 0 || c.RetryMaxWait < 0 {
+		return nil, fmt.Errorf(
+			"max_retries [%d] and retry_max_wait [%d] must not be negative",
+			c.MaxRetries, c.RetryMaxWait)
+	}
+
+	if c.MaxConcurrentCalls < 0 {
+		return nil, fmt.Errorf(
+			"max_concurrent_calls must not be negative, got: %d", c.MaxConcurrentCalls)
+	}
+
+	if c.ReadCacheTTL < 0 {
+		return nil, fmt.Errorf(
+			"api_read_cache_ttl must not be negative, got: %d", c.ReadCacheTTL)
+	}
+
+	for cmdName, timeout := range d.Get("api_command_timeouts").(map[string]interface{}) {
+		if c.CommandTimeouts == nil {
+			c.CommandTimeouts = map[string]int{}
+		}
+
+		c.CommandTimeouts[cmdName] = timeout.(int)
+		if c.CommandTimeouts[cmdName] < 0 {
+			return nil, fmt.Errorf(
+				"api_command_timeouts [%s] must not be negative, got: %d",
+				cmdName, c.CommandTimeouts[cmdName])
+		}
+	}
+
+	if err := c.validateAuth(); err != nil {
+		return nil, err
+	}
+
+	if c.Transport == netappsys.TransportHTTP && (c.Insecure || c.CAFile != "") {
+		return nil, fmt.Errorf(
+			"insecure and ca_file require transport [%s], got: [%s]",
+			netappsys.TransportHTTPS, c.Transport)
+	}
+
+	if c.Insecure && c.CAFile != "" {
+		return nil, fmt.Errorf(
+			"ca_file [%s] is not used with insecure, the certificate is not verified",
+			c.CAFile)
+	}
+
+	if c.Insecure {
+		log.Printf("[WARN] certificate verification of NetApp host [%s] disabled", c.Host)
+	}
+
+	if c.MetricsFormat != "" && c.ApiPath == "" {
+		return nil, fmt.Errorf(
+			"api_metrics_format [%s] requires api_folder", c.MetricsFormat)
+	}
+
+	if c.CassetteMode != "" && c.CassetteFile == "" {
+		return nil, fmt.Errorf(
+			"cassette_mode [%s] requires cassette_file", c.CassetteMode)
+	}
+
+	if c.CassetteMode == cassette.ModeReplay {
+		// no API backend is created on replay
+		return c, nil
+	}
+
+	if c.ApiType == apiTypeNMSDK && (c.SdkRoot == "" || c.ApiPath == "") {
+		return nil, fmt.Errorf(
+			"api_type [%s] requires nmsdk_root_path and api_folder, got: [%s] and [%s]",
+			c.ApiType, c.SdkRoot, c.ApiPath)
+	}
+
+	if err := c.resolveWheelhouse(); err != nil {
+		return nil, err
 	}
 
 	return c, nil
 }
 
-func (c *Config) savedOrNewApiSession() (*pythonapi.NetAppAPI, bool, error) {
-	// TODO: look into saving conn info via ReattachConfig from go-plugin client
-	api, err := pythonapi.CreateAPI(
+// resolveWheelhouse sets the Wheelhouse of the InstallMode, the wheels of
+// a bundled install are next to the provider binary
+func (c *Config) resolveWheelhouse() error {
+	switch c.InstallMode {
+	case installModeWheelhouse:
+		if c.Wheelhouse == "" {
+			return fmt.Errorf(
+				"api_install_mode [%s] requires api_wheelhouse", c.InstallMode)
+		}
+	case installModeBundled:
+		if c.Wheelhouse != "" {
+			return fmt.Errorf(
+				"api_wheelhouse [%s] is not used with api_install_mode [%s]",
+				c.Wheelhouse, c.InstallMode)
+		}
+
+		exe, err := os.Executable()
+		if err != nil {
+			return fmt.Errorf("could not locate provider binary for bundled wheels, got: %s", err)
+		}
+		c.Wheelhouse = filepath.Join(filepath.Dir(exe), bundledWheels)
+	default:
+		if c.Wheelhouse != "" {
+			return fmt.Errorf(
+				"api_wheelhouse [%s] requires api_install_mode [%s]",
+				c.Wheelhouse, installModeWheelhouse)
+		}
+	}
+
+	return nil
+}
+
+// validateAuth checks that either the password or the client certificate
+// authenticates the user, never both
+func (c *Config) validateAuth() error {
+	if (c.ClientCertFile == "") != (c.ClientKeyFile == "") {
+		return fmt.Errorf(
+			"client_cert_file [%s] and client_key_file [%s] must be set together",
+			c.ClientCertFile, c.ClientKeyFile)
+	}
+
+	if c.ClientCertFile == "" {
+		if c.Password == "" {
+			return fmt.Errorf(
+				"user [%s] requires password or client_cert_file/client_key_file authentication",
+				c.User)
+		}
+
+		return nil
+	}
+
+	if c.Password != "" {
+		return fmt.Errorf(
+			"password and client_cert_file [%s] both configured for user [%s], "+
+				"only one authentication can be used: remove password (e.g. NETAPP_PASSWORD) "+
+				"for certificate authentication or client_cert_file/client_key_file "+
+				"for password authentication", c.ClientCertFile, c.User)
+	}
+
+	if c.Transport == netappsys.TransportHTTP {
+		return fmt.Errorf(
+			"client_cert_file authentication requires transport [%s], got: [%s]",
+			netappsys.TransportHTTPS, c.Transport)
+	}
+
+	return nil
+}
+
+// savedOrNewApiSession returns the API backend and the connection of a
+// saved session, the connection is nil if the API must be connected
+func (c *Config) savedOrNewApiSession() (
+	pythonapi.Backend, *netappsys.ConnectResponse, error) {
+	switch c.ApiType {
+	case apiTypeZAPI:
+		api, err := zapiapi.CreateAPI()
+		if err != nil {
+			return nil, nil, fmt.Errorf("Error creating ZAPI NetApp API: %s", err)
+		}
+
+		return api, nil, nil
+	case apiTypeREST:
+		api, err := restapi.CreateAPI()
+		if err != nil {
+			return nil, nil, fmt.Errorf("Error creating REST NetApp API: %s", err)
+		}
+
+		return api, nil, nil
+	}
+
+	// reuse the API server of an earlier run and its session of the
+	// configured connection, which is connected unless the server lost it
+	api, err := pythonapi.ReattachAPI(c.ApiPath)
+	if err == nil {
+		api.ReuseSession(c.sessionKey())
+		if info := c.validSession(api); info != nil {
+			return api, &info.ConnectResponse, nil
+		}
+
+		// connected again by SYS.CONNECT
+		return api, nil, nil
+	}
+	log.Printf("[INFO] starting python NetApp API, no API to reattach: %s", err)
+
+	api, err = pythonapi.CreateAPI(
 		c.ApiPath, c.SdkRoot,
-		c.RegPort, c.ApiPort)
+		c.RegPort, c.ApiPort, c.Wheelhouse,
+		pythonapi.LogConfig{File: c.ApiLogFile, MaxSize: c.ApiLogMaxSize << 20})
 	if err != nil {
-		return nil, false, fmt.Errorf("Error creating python NetApp API: %s", err)
+		return nil, nil, fmt.Errorf("Error creating python NetApp API: %s", err)
 	}
+	api.ReuseSession(c.sessionKey())
+
+	return api, nil, nil
+}
 
-	return api, false, nil
+// sessionKey identifies the API server session of the configured
+// connection for later provider runs
+func (c *Config) sessionKey() string {
+	return c.User + "@" + c.Host
 }
 
-func (c *Config) connectToAPI(client *NetAppClient, d *schema.ResourceData) error {
-	// connect and get the ONTAP/OS version
-	resp, err := netappsys.Connect(
+// validSession returns the connection of the reattached API if it is
+// connected to the configured host with the configured user, else nil
+func (c *Config) validSession(api pythonapi.Backend) *netappsys.ConnectInfo {
+	info, err := netappsys.GetConnectInfo(api)
+	if err != nil {
+		log.Printf("[WARN] could not get reattached API connection, got: %s", err)
+		return nil
+	}
+
+	if !info.Connected || info.Host != c.Host || info.User != c.User {
+		log.Printf(
+			"[INFO] reattached API connected to [%s@%s], reconnecting to [%s@%s]",
+			info.User, info.Host, c.User, c.Host)
+		return nil
+	}
+
+	return info
+}
+
+// connectToAPI connects the API and returns the ONTAP/OS version
+func (c *Config) connectToAPI(client *NetAppClient) (*netappsys.ConnectResponse, error) {
+	return netappsys.Connect(
 		client.api, &netappsys.ConnectRequest{
 			Host: c.Host, User: c.User, Password: c.Password,
+			Transport: c.Transport, Port: c.Port, ServerType: c.ServerType,
+			Insecure: c.Insecure, CAFile: c.CAFile,
+			ClientCertFile: c.ClientCertFile, ClientKeyFile: c.ClientKeyFile,
 		})
+}
 
-	if err != nil {
-		return err
+// cassetteBackend returns the backend serving calls from the cassette
+// on replay, otherwise the API backend recording to the cassette
+func (c *Config) cassetteBackend(api pythonapi.Backend) (pythonapi.Backend, error) {
+	switch c.CassetteMode {
+	case cassette.ModeReplay:
+		player, err := cassette.Load(c.CassetteFile)
+		if err != nil {
+			return nil, err
+		}
+
+		return player, nil
+	case cassette.ModeRecord:
+		// the recorder writes each call, the file is closed on provider exit
+		rec, err := cassette.NewRecorder(c.CassetteFile)
+		if err != nil {
+			return nil, err
+		}
+		atShutdown(func() {
+			if err := rec.Close(); err != nil {
+				log.Printf("[WARN] could not close cassette [%s], got: %s", c.CassetteFile, err)
+			}
+		})
+
+		return pythonapi.Wrap(api, rec.Middleware()), nil
 	}
 
-	d.Set("ontap_version", resp.OntapVersion)
-	d.Set("os_version", resp.OsVersion)
+	return api, nil
+}
 
-	return nil
+// deadline returns the middleware applying the configured API call
+// timeouts, all calls are cancelled once stopCtx is done
+func (c *Config) deadline(stopCtx context.Context) pythonapi.Middleware {
+	overrides := map[string]time.Duration{}
+	for cmdName, timeout := range c.CommandTimeouts {
+		overrides[cmdName] = time.Duration(timeout) * time.Second
+	}
+
+	return pythonapi.Deadline(
+		stopCtx, time.Duration(c.ApiTimeout)*time.Second, overrides)
 }
 
-func (c *Config) Client(d *schema.ResourceData) (*NetAppClient, error) {
-	client := new(NetAppClient)
+// retryBaseWait is the wait before the first retry of an API call
+const retryBaseWait = time.Second
+
+// retry returns the middleware retrying transient API failures, waits
+// end once stopCtx is done
+func (c *Config) retry(stopCtx context.Context) pythonapi.Middleware {
+	return pythonapi.Retry(stopCtx, pythonapi.RetryPolicy{
+		MaxRetries: c.MaxRetries,
+		BaseWait:   retryBaseWait,
+		MaxWait:    time.Duration(c.RetryMaxWait) * time.Second,
+	})
+}
+
+// cache returns the middleware caching the idempotent reads, it passes
+// all calls on if the cache is disabled
+func (c *Config) cache() pythonapi.Middleware {
+	if c.ReadCacheTTL == 0 {
+		return func(next pythonapi.Backend) pythonapi.Backend { return next }
+	}
 
-	var saved bool
+	return pythonapi.NewReadCache(
+		time.Duration(c.ReadCacheTTL) * time.Second).Middleware()
+}
+
+func (c *Config) Client(stopCtx context.Context) (*NetAppClient, error) {
+	client := &NetAppClient{AdoptExisting: c.AdoptExisting}
+
+	var session *netappsys.ConnectResponse
 	var err error
 
-	client.api, saved, err = c.savedOrNewApiSession()
+	if c.backend != nil {
+		client.api = c.backend
+	} else if c.CassetteMode != cassette.ModeReplay {
+		client.api, session, err = c.savedOrNewApiSession()
+		if err != nil {
+			return nil, err
+		}
+
+		// closes the session of the python API client, a reused session
+		// stays open
+		if api, ok := client.api.(*pythonapi.NetAppAPI); ok {
+			atShutdown(func() {
+				if err := api.Stop(); err != nil {
+					log.Printf("[WARN] could not stop NetApp API, got: %s", err)
+				}
+			})
+		}
+	}
+
+	client.api, err = c.cassetteBackend(client.api)
 	if err != nil {
 		return nil, err
 	}
 
-	if !saved {
-		err := c.connectToAPI(client, d)
+	// each retry is a new call with its own timeout, the metrics measure
+	// the calls including their retries but not the cached reads. The
+	// queue of the limiter counts for the timeout and ends with stopCtx
+	client.api = pythonapi.Wrap(client.api,
+		c.cache(), c.metrics(), c.retry(stopCtx), c.deadline(stopCtx),
+		pythonapi.NewLimiter(c.MaxConcurrentCalls).Middleware())
+
+	// the recorded cassette must start with SYS.CONNECT for its replay
+	if session == nil || c.CassetteMode == cassette.ModeRecord {
+		session, err = c.connectToAPI(client)
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	client.OntapVersion = session.OntapVersion
+	client.OsVersion = session.OsVersion
+	log.Printf("[INFO] connected to [%s] ONTAPI %s, OS: %s",
+		c.Host, client.OntapVersion, client.OsVersion)
+
 	return client, nil
 }
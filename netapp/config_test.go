@@ -1,11 +1,19 @@
 package netapp
 
 import (
+	"context"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/cassette"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/pythonapi"
+	netappsys "github.com/jogam/terraform-provider-netapp/netapp/internal/helper/system"
 )
 
 func testAccClientGenerateConfig(t *testing.T) *Config {
@@ -23,6 +31,7 @@ func TestNewConfig(t *testing.T) {
 		User:     "foo",
 		Password: "bar",
 		Host:     "cookie",
+		ApiType:  "nmsdk",
 		SdkRoot:  "rootPath",
 		ApiPath:  "apiFldr",
 	}
@@ -32,6 +41,7 @@ func TestNewConfig(t *testing.T) {
 	d.Set("user", expected.User)
 	d.Set("password", expected.Password)
 	d.Set("host", expected.Host)
+	d.Set("api_type", expected.ApiType)
 	d.Set("nmsdk_root_path", expected.SdkRoot)
 	d.Set("api_folder", expected.ApiPath)
 
@@ -43,3 +53,411 @@ func TestNewConfig(t *testing.T) {
 		t.Fatalf("expected %#v, got %#v", expected, actual)
 	}
 }
+
+func TestNewConfigAPIType(t *testing.T) {
+	r := &schema.Resource{Schema: Provider().(*schema.Provider).Schema}
+	d := r.Data(nil)
+	d.Set("user", "foo")
+	d.Set("password", "bar")
+	d.Set("host", "cookie")
+	d.Set("api_type", "nmsdk")
+
+	if _, err := NewConfig(d); err == nil {
+		t.Fatalf("expected error for api_type nmsdk without SDK root and API folder")
+	}
+
+	d.Set("api_type", "zapi")
+	actual, err := NewConfig(d)
+	if err != nil {
+		t.Fatalf("error creating new zapi configuration: %s", err)
+	}
+	if actual.ApiType != "zapi" {
+		t.Fatalf("expected api type zapi, got %s", actual.ApiType)
+	}
+
+	d.Set("api_type", "rest")
+	actual, err = NewConfig(d)
+	if err != nil {
+		t.Fatalf("error creating new rest configuration: %s", err)
+	}
+	if actual.ApiType != "rest" {
+		t.Fatalf("expected api type rest, got %s", actual.ApiType)
+	}
+}
+
+func TestNewConfigCassette(t *testing.T) {
+	r := &schema.Resource{Schema: Provider().(*schema.Provider).Schema}
+	d := r.Data(nil)
+	d.Set("user", "foo")
+	d.Set("password", "bar")
+	d.Set("host", "cookie")
+	d.Set("api_type", "nmsdk")
+	d.Set("cassette_mode", "replay")
+
+	if _, err := NewConfig(d); err == nil {
+		t.Fatalf("expected error for cassette_mode without cassette_file")
+	}
+
+	folder, err := ioutil.TempDir("", "cassette")
+	if err != nil {
+		t.Fatalf("could not create temp folder: %s", err)
+	}
+	defer os.RemoveAll(folder)
+
+	path := filepath.Join(folder, "connect.cassette")
+	err = ioutil.WriteFile(path, []byte(`{"command":"SYS.CONNECT",`+
+		`"request":{"host":"cookie","pwd":"REDACTED","user":"foo"},`+
+		`"response":{"version_ontap":"1.130","version_os":"9.3"}}`), 0600)
+	if err != nil {
+		t.Fatalf("could not write cassette: %s", err)
+	}
+
+	// replay does not need the nmsdk API setup
+	d.Set("cassette_file", path)
+	actual, err := NewConfig(d)
+	if err != nil {
+		t.Fatalf("error creating new replay configuration: %s", err)
+	}
+
+	client, err := actual.Client(context.Background())
+	if err != nil {
+		t.Fatalf("error creating replay client: %s", err)
+	}
+
+	if client.OntapVersion != "1.130" || client.OsVersion != "9.3" {
+		t.Fatalf("expected replayed cluster version, got: %s / %s",
+			client.OntapVersion, client.OsVersion)
+	}
+
+	_, err = netappsys.NodeGetByName(client.api, "node1")
+	if err == nil || !strings.Contains(err.Error(), "not found in cassette") {
+		t.Fatalf("expected call not in cassette to fail, got: %v", err)
+	}
+}
+
+func TestConfigCassetteRecordShutdown(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "record.cassette")
+	c := &Config{CassetteMode: cassette.ModeRecord, CassetteFile: path}
+
+	api, err := c.cassetteBackend(pythonapi.BackendFunc(func(
+		ctx context.Context, cmdName string, request, response interface{}) error {
+		return nil
+	}))
+	if err != nil {
+		t.Fatalf("error creating recording backend: %s", err)
+	}
+	if err := api.Call(context.Background(), "SYS.NODE.GET", map[string]string{"name": "node1"}, &struct{}{}); err != nil {
+		t.Fatalf("error recording call: %s", err)
+	}
+
+	shutdownHooks.Lock()
+	registered := len(shutdownHooks.hooks)
+	shutdownHooks.Unlock()
+	if registered == 0 {
+		t.Fatalf("expected recorder to be closed on shutdown")
+	}
+
+	Shutdown()
+
+	shutdownHooks.Lock()
+	remaining := len(shutdownHooks.hooks)
+	shutdownHooks.Unlock()
+	if remaining != 0 {
+		t.Fatalf("expected shutdown hooks to run once, %d left", remaining)
+	}
+
+	player, err := cassette.Load(path)
+	if err != nil {
+		t.Fatalf("could not load recorded cassette: %s", err)
+	}
+	if player.Remaining() != 1 {
+		t.Fatalf("expected 1 recorded call, got: %d", player.Remaining())
+	}
+}
+
+func TestNewConfigTimeouts(t *testing.T) {
+	r := &schema.Resource{Schema: Provider().(*schema.Provider).Schema}
+	d := r.Data(nil)
+	d.Set("user", "foo")
+	d.Set("password", "bar")
+	d.Set("host", "cookie")
+	d.Set("api_type", "zapi")
+	d.Set("api_timeout", 60)
+	d.Set("api_command_timeouts", map[string]interface{}{"SVM.CREATE": 900})
+
+	actual, err := NewConfig(d)
+	if err != nil {
+		t.Fatalf("error creating new configuration: %s", err)
+	}
+	if actual.ApiTimeout != 60 {
+		t.Fatalf("expected api timeout 60, got %d", actual.ApiTimeout)
+	}
+	expected := map[string]int{"SVM.CREATE": 900}
+	if !reflect.DeepEqual(expected, actual.CommandTimeouts) {
+		t.Fatalf("expected %#v, got %#v", expected, actual.CommandTimeouts)
+	}
+
+	d.Set("api_timeout", -1)
+	if _, err := NewConfig(d); err == nil {
+		t.Fatalf("expected error for negative api_timeout")
+	}
+}
+
+func TestNewConfigRetries(t *testing.T) {
+	r := &schema.Resource{Schema: Provider().(*schema.Provider).Schema}
+	d := r.Data(nil)
+	d.Set("user", "foo")
+	d.Set("password", "bar")
+	d.Set("host", "cookie")
+	d.Set("api_type", "zapi")
+	d.Set("max_retries", 2)
+	d.Set("retry_max_wait", 10)
+
+	actual, err := NewConfig(d)
+	if err != nil {
+		t.Fatalf("error creating new configuration: %s", err)
+	}
+	if actual.MaxRetries != 2 || actual.RetryMaxWait != 10 {
+		t.Fatalf("expected 2 retries waiting up to 10s, got: %d / %d",
+			actual.MaxRetries, actual.RetryMaxWait)
+	}
+
+	d.Set("retry_max_wait", -1)
+	if _, err := NewConfig(d); err == nil {
+		t.Fatalf("expected error for negative retry_max_wait")
+	}
+}
+
+func TestNewConfigMaxConcurrentCalls(t *testing.T) {
+	r := &schema.Resource{Schema: Provider().(*schema.Provider).Schema}
+	d := r.Data(nil)
+	d.Set("user", "foo")
+	d.Set("password", "bar")
+	d.Set("host", "cookie")
+	d.Set("api_type", "zapi")
+	d.Set("max_concurrent_calls", 4)
+
+	actual, err := NewConfig(d)
+	if err != nil {
+		t.Fatalf("error creating new configuration: %s", err)
+	}
+	if actual.MaxConcurrentCalls != 4 {
+		t.Fatalf("expected 4 concurrent calls, got: %d", actual.MaxConcurrentCalls)
+	}
+
+	d.Set("max_concurrent_calls", -1)
+	if _, err := NewConfig(d); err == nil {
+		t.Fatalf("expected error for negative max_concurrent_calls")
+	}
+}
+
+func TestNewConfigReadCacheTTL(t *testing.T) {
+	r := &schema.Resource{Schema: Provider().(*schema.Provider).Schema}
+	d := r.Data(nil)
+	d.Set("user", "foo")
+	d.Set("password", "bar")
+	d.Set("host", "cookie")
+	d.Set("api_type", "zapi")
+
+	actual, err := NewConfig(d)
+	if err != nil {
+		t.Fatalf("error creating new configuration: %s", err)
+	}
+	if actual.ReadCacheTTL != 0 {
+		t.Fatalf("expected read cache disabled by default, got TTL: %d", actual.ReadCacheTTL)
+	}
+
+	d.Set("api_read_cache_ttl", 30)
+	if actual, err = NewConfig(d); err != nil {
+		t.Fatalf("error creating new configuration: %s", err)
+	}
+	if actual.ReadCacheTTL != 30 {
+		t.Fatalf("expected read cache TTL 30, got: %d", actual.ReadCacheTTL)
+	}
+
+	d.Set("api_read_cache_ttl", -1)
+	if _, err := NewConfig(d); err == nil {
+		t.Fatalf("expected error for negative api_read_cache_ttl")
+	}
+}
+
+func TestNewConfigApiLog(t *testing.T) {
+	r := &schema.Resource{Schema: Provider().(*schema.Provider).Schema}
+	d := r.Data(nil)
+	d.Set("user", "foo")
+	d.Set("password", "bar")
+	d.Set("host", "cookie")
+	d.Set("api_type", "zapi")
+	d.Set("api_log_file", "logs/api.log")
+	d.Set("api_log_max_size", 5)
+
+	actual, err := NewConfig(d)
+	if err != nil {
+		t.Fatalf("error creating new configuration: %s", err)
+	}
+	if actual.ApiLogFile != "logs/api.log" || actual.ApiLogMaxSize != 5 {
+		t.Fatalf("expected api log logs/api.log rotated at 5MB, got: %s / %d",
+			actual.ApiLogFile, actual.ApiLogMaxSize)
+	}
+
+	d.Set("api_log_max_size", -1)
+	if _, err := NewConfig(d); err == nil {
+		t.Fatalf("expected error for negative api_log_max_size")
+	}
+}
+
+func TestNewConfigMetricsFormat(t *testing.T) {
+	r := &schema.Resource{Schema: Provider().(*schema.Provider).Schema}
+	d := r.Data(nil)
+	d.Set("user", "foo")
+	d.Set("password", "bar")
+	d.Set("host", "cookie")
+	d.Set("api_type", "zapi")
+	d.Set("api_metrics_format", "prometheus")
+
+	if _, err := NewConfig(d); err == nil {
+		t.Fatalf("expected error for api_metrics_format without api_folder")
+	}
+
+	d.Set("api_folder", "/opt/api")
+	actual, err := NewConfig(d)
+	if err != nil {
+		t.Fatalf("error creating new configuration: %s", err)
+	}
+	if actual.MetricsFormat != "prometheus" {
+		t.Fatalf("expected metrics format prometheus, got: %s", actual.MetricsFormat)
+	}
+}
+
+func TestNewConfigInstallMode(t *testing.T) {
+	r := &schema.Resource{Schema: Provider().(*schema.Provider).Schema}
+	d := r.Data(nil)
+	d.Set("user", "foo")
+	d.Set("password", "bar")
+	d.Set("host", "cookie")
+	d.Set("api_type", "nmsdk")
+	d.Set("nmsdk_root_path", "/opt/nmsdk")
+	d.Set("api_folder", "/opt/api")
+	d.Set("api_install_mode", "wheelhouse")
+
+	if _, err := NewConfig(d); err == nil {
+		t.Fatalf("expected error for wheelhouse install without api_wheelhouse")
+	}
+
+	d.Set("api_wheelhouse", "/opt/wheels")
+	actual, err := NewConfig(d)
+	if err != nil {
+		t.Fatalf("error creating new configuration: %s", err)
+	}
+	if actual.Wheelhouse != "/opt/wheels" {
+		t.Fatalf("expected wheelhouse /opt/wheels, got: %s", actual.Wheelhouse)
+	}
+
+	d.Set("api_install_mode", "bundled")
+	if _, err := NewConfig(d); err == nil {
+		t.Fatalf("expected error for api_wheelhouse with bundled install")
+	}
+
+	d.Set("api_wheelhouse", "")
+	actual, err = NewConfig(d)
+	if err != nil {
+		t.Fatalf("error creating new configuration: %s", err)
+	}
+	if filepath.Base(actual.Wheelhouse) != bundledWheels {
+		t.Fatalf("expected bundled wheels next to the binary, got: %s", actual.Wheelhouse)
+	}
+
+	d.Set("api_install_mode", "online")
+	actual, err = NewConfig(d)
+	if err != nil {
+		t.Fatalf("error creating new configuration: %s", err)
+	}
+	if actual.Wheelhouse != "" {
+		t.Fatalf("expected no wheelhouse for online install, got: %s", actual.Wheelhouse)
+	}
+}
+
+func TestNewConfigTLS(t *testing.T) {
+	r := &schema.Resource{Schema: Provider().(*schema.Provider).Schema}
+	d := r.Data(nil)
+	d.Set("user", "foo")
+	d.Set("password", "bar")
+	d.Set("host", "cookie")
+	d.Set("api_type", "zapi")
+	d.Set("transport", "HTTPS")
+	d.Set("port", 8443)
+	d.Set("server_type", "VSERVER")
+	d.Set("ca_file", "ca.pem")
+
+	actual, err := NewConfig(d)
+	if err != nil {
+		t.Fatalf("error creating new configuration: %s", err)
+	}
+	if actual.Transport != "HTTPS" || actual.Port != 8443 ||
+		actual.ServerType != "VSERVER" || actual.CAFile != "ca.pem" || actual.Insecure {
+		t.Fatalf("unexpected connection options: %#v", actual)
+	}
+
+	d.Set("insecure", true)
+	if _, err := NewConfig(d); err == nil {
+		t.Fatalf("expected error for insecure with ca_file")
+	}
+
+	d.Set("ca_file", "")
+	d.Set("transport", "HTTP")
+	if _, err := NewConfig(d); err == nil {
+		t.Fatalf("expected error for insecure with transport HTTP")
+	}
+}
+
+func TestNewConfigClientCert(t *testing.T) {
+	r := &schema.Resource{Schema: Provider().(*schema.Provider).Schema}
+	d := r.Data(nil)
+	d.Set("user", "foo")
+	d.Set("host", "cookie")
+	d.Set("api_type", "zapi")
+
+	if _, err := NewConfig(d); err == nil {
+		t.Fatalf("expected error without password and client certificate")
+	}
+
+	d.Set("client_cert_file", "client.pem")
+	if _, err := NewConfig(d); err == nil {
+		t.Fatalf("expected error for client_cert_file without client_key_file")
+	}
+
+	d.Set("client_key_file", "client.key")
+	actual, err := NewConfig(d)
+	if err != nil {
+		t.Fatalf("error creating new configuration: %s", err)
+	}
+	if actual.ClientCertFile != "client.pem" || actual.ClientKeyFile != "client.key" {
+		t.Fatalf("unexpected client certificate: %#v", actual)
+	}
+
+	d.Set("password", "bar")
+	_, err = NewConfig(d)
+	if err == nil || !strings.Contains(err.Error(), "only one authentication can be used") {
+		t.Fatalf("expected error for password with client certificate, got: %v", err)
+	}
+}
+
+func TestConfigValidSession(t *testing.T) {
+	tc := newTestCluster(t)
+	api := tc.client(t).api
+
+	c := &Config{Host: "simulator", User: "admin"}
+	if c.validSession(api) == nil {
+		t.Fatalf("expected session connected to [admin@simulator] to be valid")
+	}
+
+	c.User = "other"
+	if c.validSession(api) != nil {
+		t.Fatalf("expected session of other user to be invalid")
+	}
+
+	c = &Config{Host: "simulator", User: "admin"}
+	if c.validSession(tc.Cluster.API()) != nil {
+		t.Fatalf("expected not connected session to be invalid")
+	}
+}
@@ -0,0 +1,43 @@
+package netapp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestDataSourceNetAppAggregation(t *testing.T) {
+	tc := newTestCluster(t)
+	aggr := fmt.Sprintf(`
+data "netapp_aggr" "aggr" {
+  name    = %q
+  node_id = %q
+}
+`, testAggrName, tc.nodeID)
+
+	// the SVM root volume is placed on the aggregate, the data source is
+	// read again on the refresh of the next step
+	svm := testConfig(aggr + testIPSpaceConfig("ips1") +
+		testSVMConfig("svm1", "${data.netapp_aggr.aggr.id}"))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: tc.providerFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(aggr),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netapp_aggr.aggr", "id", tc.aggrID),
+					resource.TestCheckResourceAttr("data.netapp_aggr.aggr", "flexvol_count", "0"),
+				),
+			},
+			{
+				Config: svm,
+			},
+			{
+				Config: svm,
+				Check:  resource.TestCheckResourceAttr("data.netapp_aggr.aggr", "flexvol_count", "1"),
+			},
+		},
+	})
+}
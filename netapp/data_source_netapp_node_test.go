@@ -0,0 +1,46 @@
+package netapp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// testNodeConfig is the node data source node of the node name
+func testNodeConfig(name string) string {
+	return fmt.Sprintf(`
+data "netapp_node" "node" {
+  name = %q
+}
+`, name)
+}
+
+func TestDataSourceNetAppNode(t *testing.T) {
+	tc := newTestCluster(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: tc.providerFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(testNodeConfig(testNodeName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netapp_node.node", "id", tc.nodeID),
+					resource.TestCheckResourceAttr("data.netapp_node.node", "healthy", "true"),
+				),
+			},
+			{
+				// an unknown node is read again by every plan
+				Config:             testConfig(testNodeConfig("node9")),
+				ExpectNonEmptyPlan: true,
+				Check: func(s *terraform.State) error {
+					if rs, ok := s.RootModule().Resources["data.netapp_node.node"]; ok && rs.Primary.ID != "" {
+						return fmt.Errorf("expected unknown node to be empty, got: %s", rs.Primary.ID)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,42 @@
+package netapp
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/pythonapi"
+)
+
+// readGone removes the resource from the state if the read failed as the
+// object does not exist anymore, e.g. deleted outside of terraform
+func readGone(d *schema.ResourceData, err error) bool {
+	if !errors.Is(err, pythonapi.ErrNotFound) {
+		return false
+	}
+
+	log.Printf("[WARN] [%s] not found, removing it from the state, got: %s", d.Id(), err)
+	d.SetId("")
+	return true
+}
+
+// adoptExisting returns nil if the create failed as the object already
+// exists and the provider adopts existing objects, the resource then
+// reads the object with importID into the state. Otherwise the error of
+// an existing object hints at the import, any other error is returned
+func adoptExisting(meta interface{}, object, importID string, err error) error {
+	if !errors.Is(err, pythonapi.ErrAlreadyExists) {
+		return err
+	}
+
+	if !meta.(*NetAppClient).AdoptExisting {
+		return fmt.Errorf(
+			"%s already exists, import via cmd: terraform import $RESNAME$ '%s' "+
+				"or set adopt_existing of the provider, got: %s",
+			object, importID, err)
+	}
+
+	log.Printf("[WARN] adopting existing %s with ID [%s]", object, importID)
+	return nil
+}
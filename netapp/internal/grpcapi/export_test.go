@@ -0,0 +1,14 @@
+package grpcapi
+
+import "sort"
+
+// TypedCommands returns the sorted names of the commands with typed RPC
+func TypedCommands() []string {
+	names := make([]string, 0, len(typedRPCs))
+	for name := range typedRPCs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
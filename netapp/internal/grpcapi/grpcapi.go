@@ -13,6 +13,7 @@ var APIScripts = []string{
 	"grpcapi_pb2_grpc.py",
 	"grpcapi_pb2.py",
 	"grpcapi.py",
+	"redact.py",
 	"registry.py",
 	"apicmd/__init__.py",
 	"apicmd/system.py",
@@ -29,31 +30,34 @@ type PythonAPI interface {
 	GRPCNetAppAPI
 }
 
-// GRPCNetAppApi is the interface that is implemented by GRPC/Python
+// GRPCNetAppApi is the interface that is implemented by GRPC/Python,
+// the context deadline and cancellation are passed on to the Python side.
+// A failed command is an unsuccessful response with the error message and
+// the ZAPI errno if known, transport errors are returned as error
 type GRPCNetAppAPI interface {
-	Call(cmd string, data []byte) (bool, string, []byte, error)
-	Shutdown(clientID string) (bool, error)
+	Call(ctx context.Context, cmd string, data []byte) (*CallResponse, error)
+	Shutdown(ctx context.Context, clientID string) (bool, error)
 }
 
 // GRPCClient is an implementation of KV that talks over RPC.
 type gRPCClient struct{ client GRPCNetAppApiClient }
 
-func (m *gRPCClient) Call(cmd string, data []byte) (bool, string, []byte, error) {
-	// TODO: expose context created here to upstream for timeout config
-	resp, err := m.client.Call(context.Background(), &CallRequest{
+// Call executes the command with its typed RPC, commands without typed
+// RPC fall back to the generic JSON call
+func (m *gRPCClient) Call(
+	ctx context.Context, cmd string, data []byte) (*CallResponse, error) {
+	if rpc, ok := typedRPCs[cmd]; ok {
+		return rpc(ctx, m.client, cmd, data)
+	}
+
+	return m.client.Call(ctx, &CallRequest{
 		Cmd:  cmd,
 		Data: data,
 	})
-	if err != nil {
-		return false, "", nil, err
-	}
-
-	return resp.Success, resp.Errmsg, resp.Data, err
 }
 
-func (m *gRPCClient) Shutdown(clientID string) (bool, error) {
-	// TODO: expose context created here to upstream for timeout config
-	resp, err := m.client.Shutdown(context.Background(), &ShutdownRequest{
+func (m *gRPCClient) Shutdown(ctx context.Context, clientID string) (bool, error) {
+	resp, err := m.client.Shutdown(ctx, &ShutdownRequest{
 		Clientid: clientID,
 	})
 	if err != nil {
@@ -72,15 +76,13 @@ type gRPCServer struct {
 func (m *gRPCServer) Call(
 	ctx context.Context,
 	req *CallRequest) (*CallResponse, error) {
-	succ, errmsg, data, err := m.Impl.Call(req.Cmd, req.Data)
-	return &CallResponse{
-		Success: succ, Errmsg: errmsg, Data: data}, err
+	return m.Impl.Call(ctx, req.Cmd, req.Data)
 }
 
 func (m *gRPCServer) Shutdown(
 	ctx context.Context,
 	req *ShutdownRequest) (*ShutdownResponse, error) {
-	v, err := m.Impl.Shutdown(req.Clientid)
+	v, err := m.Impl.Shutdown(ctx, req.Clientid)
 	return &ShutdownResponse{Result: v}, err
 }
 
@@ -97,6 +99,14 @@ var PluginMap = map[string]plugin.Plugin{
 	"grpcapi": &gRPCApiPlugin{},
 }
 
+// NewPluginMap returns the plugin map serving impl, e.g. to serve a Go
+// implementation of the API in tests
+func NewPluginMap(impl GRPCNetAppAPI) map[string]plugin.Plugin {
+	return map[string]plugin.Plugin{
+		"grpcapi": &gRPCApiPlugin{Impl: impl},
+	}
+}
+
 // This is the implementation of plugin.GRPCPlugin so we can serve/consume this.
 type gRPCApiPlugin struct {
 	// GRPCPlugin must still implement the Plugin interface
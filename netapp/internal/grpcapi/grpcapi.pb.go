@@ -73,6 +73,7 @@ type CallResponse struct {
 	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Errmsg               string   `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
 	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Errno                int32    `protobuf:"varint,4,opt,name=errno,proto3" json:"errno,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -124,6 +125,94 @@ func (m *CallResponse) GetData() []byte {
 	return nil
 }
 
+func (m *CallResponse) GetErrno() int32 {
+	if m != nil {
+		return m.Errno
+	}
+	return 0
+}
+
+// BatchRequest executes the calls in order in one round trip, a failed call
+// does not stop the batch
+type BatchRequest struct {
+	Calls                []*CallRequest `protobuf:"bytes,1,rep,name=calls,proto3" json:"calls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *BatchRequest) Reset()         { *m = BatchRequest{} }
+func (m *BatchRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchRequest) ProtoMessage()    {}
+func (*BatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{2}
+}
+
+func (m *BatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchRequest.Unmarshal(m, b)
+}
+func (m *BatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchRequest.Marshal(b, m, deterministic)
+}
+func (m *BatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchRequest.Merge(m, src)
+}
+func (m *BatchRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchRequest.Size(m)
+}
+func (m *BatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchRequest proto.InternalMessageInfo
+
+func (m *BatchRequest) GetCalls() []*CallRequest {
+	if m != nil {
+		return m.Calls
+	}
+	return nil
+}
+
+// BatchResponse has the results of the calls in the order of the request
+type BatchResponse struct {
+	Results              []*CallResponse `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *BatchResponse) Reset()         { *m = BatchResponse{} }
+func (m *BatchResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchResponse) ProtoMessage()    {}
+func (*BatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{3}
+}
+
+func (m *BatchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchResponse.Unmarshal(m, b)
+}
+func (m *BatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchResponse.Marshal(b, m, deterministic)
+}
+func (m *BatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchResponse.Merge(m, src)
+}
+func (m *BatchResponse) XXX_Size() int {
+	return xxx_messageInfo_BatchResponse.Size(m)
+}
+func (m *BatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchResponse proto.InternalMessageInfo
+
+func (m *BatchResponse) GetResults() []*CallResponse {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 type ShutdownRequest struct {
 	Clientid             string   `protobuf:"bytes,1,opt,name=clientid,proto3" json:"clientid,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -135,7 +224,7 @@ func (m *ShutdownRequest) Reset()         { *m = ShutdownRequest{} }
 func (m *ShutdownRequest) String() string { return proto.CompactTextString(m) }
 func (*ShutdownRequest) ProtoMessage()    {}
 func (*ShutdownRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a7b78476b7b33751, []int{2}
+	return fileDescriptor_a7b78476b7b33751, []int{4}
 }
 
 func (m *ShutdownRequest) XXX_Unmarshal(b []byte) error {
@@ -174,7 +263,7 @@ func (m *ShutdownResponse) Reset()         { *m = ShutdownResponse{} }
 func (m *ShutdownResponse) String() string { return proto.CompactTextString(m) }
 func (*ShutdownResponse) ProtoMessage()    {}
 func (*ShutdownResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a7b78476b7b33751, []int{3}
+	return fileDescriptor_a7b78476b7b33751, []int{5}
 }
 
 func (m *ShutdownResponse) XXX_Unmarshal(b []byte) error {
@@ -202,136 +291,4662 @@ func (m *ShutdownResponse) GetResult() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*CallRequest)(nil), "grpcapi.CallRequest")
-	proto.RegisterType((*CallResponse)(nil), "grpcapi.CallResponse")
-	proto.RegisterType((*ShutdownRequest)(nil), "grpcapi.ShutdownRequest")
-	proto.RegisterType((*ShutdownResponse)(nil), "grpcapi.ShutdownResponse")
+type VersionRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("grpcapi.proto", fileDescriptor_a7b78476b7b33751) }
+func (m *VersionRequest) Reset()         { *m = VersionRequest{} }
+func (m *VersionRequest) String() string { return proto.CompactTextString(m) }
+func (*VersionRequest) ProtoMessage()    {}
+func (*VersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{6}
+}
 
-var fileDescriptor_a7b78476b7b33751 = []byte{
-	// 248 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xd1, 0x4a, 0xc3, 0x30,
-	0x14, 0x86, 0xa9, 0x1b, 0x5d, 0x77, 0xdc, 0x70, 0x1c, 0x74, 0xd4, 0x5e, 0x8d, 0x5e, 0x0d, 0xc1,
-	0x5d, 0xd8, 0x07, 0x90, 0xb1, 0x0b, 0xef, 0x44, 0xa2, 0x2f, 0x50, 0xd3, 0xc3, 0x2c, 0x74, 0x6d,
-	0xcc, 0x49, 0xf0, 0x09, 0x7c, 0x6f, 0x59, 0x96, 0xd4, 0x51, 0x76, 0x77, 0xfe, 0xe4, 0xfc, 0x7f,
-	0xbe, 0xfc, 0x30, 0xdf, 0x6b, 0x25, 0x4b, 0x55, 0x6f, 0x94, 0xee, 0x4c, 0x87, 0x13, 0x2f, 0xf3,
-	0x02, 0xae, 0x77, 0x65, 0xd3, 0x08, 0xfa, 0xb6, 0xc4, 0x06, 0x17, 0x30, 0x92, 0x87, 0x2a, 0x8d,
-	0x56, 0xd1, 0x7a, 0x2a, 0x8e, 0x23, 0x22, 0x8c, 0xab, 0xd2, 0x94, 0xe9, 0xd5, 0x2a, 0x5a, 0xcf,
-	0x84, 0x9b, 0xf3, 0x0f, 0x98, 0x9d, 0x4c, 0xac, 0xba, 0x96, 0x09, 0x53, 0x98, 0xb0, 0x95, 0x92,
-	0x98, 0x9d, 0x33, 0x11, 0x41, 0xe2, 0x12, 0x62, 0xd2, 0xfa, 0xc0, 0x7b, 0xe7, 0x9f, 0x0a, 0xaf,
-	0xfa, 0xd4, 0xd1, 0x59, 0xea, 0x23, 0xdc, 0xbc, 0x7f, 0x59, 0x53, 0x75, 0x3f, 0x6d, 0xc0, 0xc9,
-	0x20, 0x91, 0x4d, 0x4d, 0xad, 0xa9, 0x03, 0x53, 0xaf, 0xf3, 0x07, 0x58, 0xfc, 0xaf, 0x7b, 0x90,
-	0x25, 0xc4, 0x9a, 0xd8, 0x36, 0xc6, 0x73, 0x78, 0xf5, 0xf4, 0x1b, 0xc1, 0xfc, 0x45, 0xbc, 0xed,
-	0x5e, 0xc9, 0x6c, 0x95, 0xda, 0xaa, 0x1a, 0x0b, 0x18, 0x1f, 0xbf, 0x80, 0xb7, 0x9b, 0x50, 0xcc,
-	0x59, 0x0d, 0xd9, 0xdd, 0xe0, 0xd4, 0xc7, 0x3f, 0x43, 0x12, 0x9e, 0xc4, 0xb4, 0x5f, 0x19, 0x40,
-	0x67, 0xf7, 0x17, 0x6e, 0x4e, 0x01, 0x9f, 0xb1, 0x6b, 0xbf, 0xf8, 0x0b, 0x00, 0x00, 0xff, 0xff,
-	0x22, 0x50, 0x78, 0x0e, 0x8e, 0x01, 0x00, 0x00,
+func (m *VersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VersionRequest.Unmarshal(m, b)
+}
+func (m *VersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VersionRequest.Marshal(b, m, deterministic)
+}
+func (m *VersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionRequest.Merge(m, src)
+}
+func (m *VersionRequest) XXX_Size() int {
+	return xxx_messageInfo_VersionRequest.Size(m)
+}
+func (m *VersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_VersionRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// VersionResponse is the build of the running API server, the provider
+// restarts a server of another build
+type VersionResponse struct {
+	Build                string   `protobuf:"bytes,1,opt,name=build,proto3" json:"build,omitempty"`
+	Commands             []string `protobuf:"bytes,2,rep,name=commands,proto3" json:"commands,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
 
-// GRPCNetAppApiClient is the client API for GRPCNetAppApi service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GRPCNetAppApiClient interface {
-	Call(ctx context.Context, in *CallRequest, opts ...grpc.CallOption) (*CallResponse, error)
-	Shutdown(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*ShutdownResponse, error)
+func (m *VersionResponse) Reset()         { *m = VersionResponse{} }
+func (m *VersionResponse) String() string { return proto.CompactTextString(m) }
+func (*VersionResponse) ProtoMessage()    {}
+func (*VersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{7}
 }
 
-type gRPCNetAppApiClient struct {
-	cc *grpc.ClientConn
+func (m *VersionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VersionResponse.Unmarshal(m, b)
+}
+func (m *VersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VersionResponse.Marshal(b, m, deterministic)
+}
+func (m *VersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionResponse.Merge(m, src)
+}
+func (m *VersionResponse) XXX_Size() int {
+	return xxx_messageInfo_VersionResponse.Size(m)
+}
+func (m *VersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionResponse.DiscardUnknown(m)
 }
 
-func NewGRPCNetAppApiClient(cc *grpc.ClientConn) GRPCNetAppApiClient {
-	return &gRPCNetAppApiClient{cc}
+var xxx_messageInfo_VersionResponse proto.InternalMessageInfo
+
+func (m *VersionResponse) GetBuild() string {
+	if m != nil {
+		return m.Build
+	}
+	return ""
 }
 
-func (c *gRPCNetAppApiClient) Call(ctx context.Context, in *CallRequest, opts ...grpc.CallOption) (*CallResponse, error) {
-	out := new(CallResponse)
-	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/Call", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *VersionResponse) GetCommands() []string {
+	if m != nil {
+		return m.Commands
 	}
-	return out, nil
+	return nil
 }
 
-func (c *gRPCNetAppApiClient) Shutdown(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*ShutdownResponse, error) {
-	out := new(ShutdownResponse)
-	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/Shutdown", in, out, opts...)
-	if err != nil {
-		return nil, err
+// EmptyResponse for commands without return value
+type EmptyResponse struct {
+	NonExist             bool     `protobuf:"varint,1,opt,name=non_exist,json=nonExist,proto3" json:"non_exist,omitempty"`
+	Dummy                int64    `protobuf:"varint,2,opt,name=dummy,proto3" json:"dummy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EmptyResponse) Reset()         { *m = EmptyResponse{} }
+func (m *EmptyResponse) String() string { return proto.CompactTextString(m) }
+func (*EmptyResponse) ProtoMessage()    {}
+func (*EmptyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{8}
+}
+
+func (m *EmptyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EmptyResponse.Unmarshal(m, b)
+}
+func (m *EmptyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EmptyResponse.Marshal(b, m, deterministic)
+}
+func (m *EmptyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmptyResponse.Merge(m, src)
+}
+func (m *EmptyResponse) XXX_Size() int {
+	return xxx_messageInfo_EmptyResponse.Size(m)
+}
+func (m *EmptyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmptyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmptyResponse proto.InternalMessageInfo
+
+func (m *EmptyResponse) GetNonExist() bool {
+	if m != nil {
+		return m.NonExist
 	}
-	return out, nil
+	return false
 }
 
-// GRPCNetAppApiServer is the server API for GRPCNetAppApi service.
-type GRPCNetAppApiServer interface {
-	Call(context.Context, *CallRequest) (*CallResponse, error)
-	Shutdown(context.Context, *ShutdownRequest) (*ShutdownResponse, error)
+func (m *EmptyResponse) GetDummy() int64 {
+	if m != nil {
+		return m.Dummy
+	}
+	return 0
 }
 
-func RegisterGRPCNetAppApiServer(s *grpc.Server, srv GRPCNetAppApiServer) {
-	s.RegisterService(&_GRPCNetAppApi_serviceDesc, srv)
+// LogRequest subscribes the API server log records of level and above,
+// one of DEBUG, INFO, WARNING, ERROR
+type LogRequest struct {
+	Level                string   `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _GRPCNetAppApi_Call_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CallRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *LogRequest) Reset()         { *m = LogRequest{} }
+func (m *LogRequest) String() string { return proto.CompactTextString(m) }
+func (*LogRequest) ProtoMessage()    {}
+func (*LogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{9}
+}
+
+func (m *LogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogRequest.Unmarshal(m, b)
+}
+func (m *LogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogRequest.Marshal(b, m, deterministic)
+}
+func (m *LogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogRequest.Merge(m, src)
+}
+func (m *LogRequest) XXX_Size() int {
+	return xxx_messageInfo_LogRequest.Size(m)
+}
+func (m *LogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogRequest proto.InternalMessageInfo
+
+func (m *LogRequest) GetLevel() string {
+	if m != nil {
+		return m.Level
 	}
-	if interceptor == nil {
-		return srv.(GRPCNetAppApiServer).Call(ctx, in)
+	return ""
+}
+
+// LogRecord is a Python log record, the session is the client session of
+// the call which logged it, empty for server records
+type LogRecord struct {
+	Level                string   `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Session              string   `protobuf:"bytes,4,opt,name=session,proto3" json:"session,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogRecord) Reset()         { *m = LogRecord{} }
+func (m *LogRecord) String() string { return proto.CompactTextString(m) }
+func (*LogRecord) ProtoMessage()    {}
+func (*LogRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{10}
+}
+
+func (m *LogRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogRecord.Unmarshal(m, b)
+}
+func (m *LogRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogRecord.Marshal(b, m, deterministic)
+}
+func (m *LogRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogRecord.Merge(m, src)
+}
+func (m *LogRecord) XXX_Size() int {
+	return xxx_messageInfo_LogRecord.Size(m)
+}
+func (m *LogRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogRecord proto.InternalMessageInfo
+
+func (m *LogRecord) GetLevel() string {
+	if m != nil {
+		return m.Level
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpcapi.GRPCNetAppApi/Call",
+	return ""
+}
+
+func (m *LogRecord) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GRPCNetAppApiServer).Call(ctx, req.(*CallRequest))
+	return ""
+}
+
+func (m *LogRecord) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _GRPCNetAppApi_Shutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ShutdownRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *LogRecord) GetSession() string {
+	if m != nil {
+		return m.Session
 	}
-	if interceptor == nil {
-		return srv.(GRPCNetAppApiServer).Shutdown(ctx, in)
+	return ""
+}
+
+type NodeRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uuid                 string   `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeRequest) Reset()         { *m = NodeRequest{} }
+func (m *NodeRequest) String() string { return proto.CompactTextString(m) }
+func (*NodeRequest) ProtoMessage()    {}
+func (*NodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{11}
+}
+
+func (m *NodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeRequest.Unmarshal(m, b)
+}
+func (m *NodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeRequest.Marshal(b, m, deterministic)
+}
+func (m *NodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeRequest.Merge(m, src)
+}
+func (m *NodeRequest) XXX_Size() int {
+	return xxx_messageInfo_NodeRequest.Size(m)
+}
+func (m *NodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeRequest proto.InternalMessageInfo
+
+func (m *NodeRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/grpcapi.GRPCNetAppApi/Shutdown",
+	return ""
+}
+
+func (m *NodeRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GRPCNetAppApiServer).Shutdown(ctx, req.(*ShutdownRequest))
+	return ""
+}
+
+type NodeInfo struct {
+	NonExist             bool     `protobuf:"varint,1,opt,name=non_exist,json=nonExist,proto3" json:"non_exist,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Serial               string   `protobuf:"bytes,3,opt,name=serial,proto3" json:"serial,omitempty"`
+	Id                   string   `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Uuid                 string   `protobuf:"bytes,5,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Version              string   `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
+	Healthy              bool     `protobuf:"varint,7,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	Uptime               int64    `protobuf:"varint,8,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeInfo) Reset()         { *m = NodeInfo{} }
+func (m *NodeInfo) String() string { return proto.CompactTextString(m) }
+func (*NodeInfo) ProtoMessage()    {}
+func (*NodeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{12}
+}
+
+func (m *NodeInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeInfo.Unmarshal(m, b)
+}
+func (m *NodeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeInfo.Marshal(b, m, deterministic)
+}
+func (m *NodeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeInfo.Merge(m, src)
+}
+func (m *NodeInfo) XXX_Size() int {
+	return xxx_messageInfo_NodeInfo.Size(m)
+}
+func (m *NodeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeInfo proto.InternalMessageInfo
+
+func (m *NodeInfo) GetNonExist() bool {
+	if m != nil {
+		return m.NonExist
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-var _GRPCNetAppApi_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "grpcapi.GRPCNetAppApi",
-	HandlerType: (*GRPCNetAppApiServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Call",
-			Handler:    _GRPCNetAppApi_Call_Handler,
-		},
-		{
-			MethodName: "Shutdown",
-			Handler:    _GRPCNetAppApi_Shutdown_Handler,
+func (m *NodeInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NodeInfo) GetSerial() string {
+	if m != nil {
+		return m.Serial
+	}
+	return ""
+}
+
+func (m *NodeInfo) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *NodeInfo) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *NodeInfo) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *NodeInfo) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
+	}
+	return false
+}
+
+func (m *NodeInfo) GetUptime() int64 {
+	if m != nil {
+		return m.Uptime
+	}
+	return 0
+}
+
+type PortRequest struct {
+	Node                 string   `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Port                 string   `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PortRequest) Reset()         { *m = PortRequest{} }
+func (m *PortRequest) String() string { return proto.CompactTextString(m) }
+func (*PortRequest) ProtoMessage()    {}
+func (*PortRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{13}
+}
+
+func (m *PortRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortRequest.Unmarshal(m, b)
+}
+func (m *PortRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortRequest.Marshal(b, m, deterministic)
+}
+func (m *PortRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortRequest.Merge(m, src)
+}
+func (m *PortRequest) XXX_Size() int {
+	return xxx_messageInfo_PortRequest.Size(m)
+}
+func (m *PortRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortRequest proto.InternalMessageInfo
+
+func (m *PortRequest) GetNode() string {
+	if m != nil {
+		return m.Node
+	}
+	return ""
+}
+
+func (m *PortRequest) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+type PortInfo struct {
+	NonExist             bool     `protobuf:"varint,1,opt,name=non_exist,json=nonExist,proto3" json:"non_exist,omitempty"`
+	Node                 string   `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	Port                 string   `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
+	AutoRevDelay         string   `protobuf:"bytes,4,opt,name=auto_rev_delay,json=autoRevDelay,proto3" json:"auto_rev_delay,omitempty"`
+	IgnrHealth           string   `protobuf:"bytes,5,opt,name=ignr_health,json=ignrHealth,proto3" json:"ignr_health,omitempty"`
+	Ipspace              string   `protobuf:"bytes,6,opt,name=ipspace,proto3" json:"ipspace,omitempty"`
+	Role                 string   `protobuf:"bytes,7,opt,name=role,proto3" json:"role,omitempty"`
+	AdminUp              string   `protobuf:"bytes,8,opt,name=admin_up,json=adminUp,proto3" json:"admin_up,omitempty"`
+	AdminMtu             string   `protobuf:"bytes,9,opt,name=admin_mtu,json=adminMtu,proto3" json:"admin_mtu,omitempty"`
+	AdminAuto            string   `protobuf:"bytes,10,opt,name=admin_auto,json=adminAuto,proto3" json:"admin_auto,omitempty"`
+	AdminSpeed           string   `protobuf:"bytes,11,opt,name=admin_speed,json=adminSpeed,proto3" json:"admin_speed,omitempty"`
+	AdminDuplex          string   `protobuf:"bytes,12,opt,name=admin_duplex,json=adminDuplex,proto3" json:"admin_duplex,omitempty"`
+	AdminFlow            string   `protobuf:"bytes,13,opt,name=admin_flow,json=adminFlow,proto3" json:"admin_flow,omitempty"`
+	Status               string   `protobuf:"bytes,14,opt,name=status,proto3" json:"status,omitempty"`
+	Health               string   `protobuf:"bytes,15,opt,name=health,proto3" json:"health,omitempty"`
+	Mac                  string   `protobuf:"bytes,16,opt,name=mac,proto3" json:"mac,omitempty"`
+	BroadcastDomain      string   `protobuf:"bytes,17,opt,name=broadcast_domain,json=broadcastDomain,proto3" json:"broadcast_domain,omitempty"`
+	Mtu                  string   `protobuf:"bytes,18,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	Auto                 string   `protobuf:"bytes,19,opt,name=auto,proto3" json:"auto,omitempty"`
+	Speed                string   `protobuf:"bytes,20,opt,name=speed,proto3" json:"speed,omitempty"`
+	Duplex               string   `protobuf:"bytes,21,opt,name=duplex,proto3" json:"duplex,omitempty"`
+	Flow                 string   `protobuf:"bytes,22,opt,name=flow,proto3" json:"flow,omitempty"`
+	Type                 string   `protobuf:"bytes,23,opt,name=type,proto3" json:"type,omitempty"`
+	VlanId               string   `protobuf:"bytes,24,opt,name=vlan_id,json=vlanId,proto3" json:"vlan_id,omitempty"`
+	VlanNode             string   `protobuf:"bytes,25,opt,name=vlan_node,json=vlanNode,proto3" json:"vlan_node,omitempty"`
+	VlanPort             string   `protobuf:"bytes,26,opt,name=vlan_port,json=vlanPort,proto3" json:"vlan_port,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PortInfo) Reset()         { *m = PortInfo{} }
+func (m *PortInfo) String() string { return proto.CompactTextString(m) }
+func (*PortInfo) ProtoMessage()    {}
+func (*PortInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{14}
+}
+
+func (m *PortInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortInfo.Unmarshal(m, b)
+}
+func (m *PortInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortInfo.Marshal(b, m, deterministic)
+}
+func (m *PortInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortInfo.Merge(m, src)
+}
+func (m *PortInfo) XXX_Size() int {
+	return xxx_messageInfo_PortInfo.Size(m)
+}
+func (m *PortInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortInfo proto.InternalMessageInfo
+
+func (m *PortInfo) GetNonExist() bool {
+	if m != nil {
+		return m.NonExist
+	}
+	return false
+}
+
+func (m *PortInfo) GetNode() string {
+	if m != nil {
+		return m.Node
+	}
+	return ""
+}
+
+func (m *PortInfo) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+func (m *PortInfo) GetAutoRevDelay() string {
+	if m != nil {
+		return m.AutoRevDelay
+	}
+	return ""
+}
+
+func (m *PortInfo) GetIgnrHealth() string {
+	if m != nil {
+		return m.IgnrHealth
+	}
+	return ""
+}
+
+func (m *PortInfo) GetIpspace() string {
+	if m != nil {
+		return m.Ipspace
+	}
+	return ""
+}
+
+func (m *PortInfo) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *PortInfo) GetAdminUp() string {
+	if m != nil {
+		return m.AdminUp
+	}
+	return ""
+}
+
+func (m *PortInfo) GetAdminMtu() string {
+	if m != nil {
+		return m.AdminMtu
+	}
+	return ""
+}
+
+func (m *PortInfo) GetAdminAuto() string {
+	if m != nil {
+		return m.AdminAuto
+	}
+	return ""
+}
+
+func (m *PortInfo) GetAdminSpeed() string {
+	if m != nil {
+		return m.AdminSpeed
+	}
+	return ""
+}
+
+func (m *PortInfo) GetAdminDuplex() string {
+	if m != nil {
+		return m.AdminDuplex
+	}
+	return ""
+}
+
+func (m *PortInfo) GetAdminFlow() string {
+	if m != nil {
+		return m.AdminFlow
+	}
+	return ""
+}
+
+func (m *PortInfo) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *PortInfo) GetHealth() string {
+	if m != nil {
+		return m.Health
+	}
+	return ""
+}
+
+func (m *PortInfo) GetMac() string {
+	if m != nil {
+		return m.Mac
+	}
+	return ""
+}
+
+func (m *PortInfo) GetBroadcastDomain() string {
+	if m != nil {
+		return m.BroadcastDomain
+	}
+	return ""
+}
+
+func (m *PortInfo) GetMtu() string {
+	if m != nil {
+		return m.Mtu
+	}
+	return ""
+}
+
+func (m *PortInfo) GetAuto() string {
+	if m != nil {
+		return m.Auto
+	}
+	return ""
+}
+
+func (m *PortInfo) GetSpeed() string {
+	if m != nil {
+		return m.Speed
+	}
+	return ""
+}
+
+func (m *PortInfo) GetDuplex() string {
+	if m != nil {
+		return m.Duplex
+	}
+	return ""
+}
+
+func (m *PortInfo) GetFlow() string {
+	if m != nil {
+		return m.Flow
+	}
+	return ""
+}
+
+func (m *PortInfo) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *PortInfo) GetVlanId() string {
+	if m != nil {
+		return m.VlanId
+	}
+	return ""
+}
+
+func (m *PortInfo) GetVlanNode() string {
+	if m != nil {
+		return m.VlanNode
+	}
+	return ""
+}
+
+func (m *PortInfo) GetVlanPort() string {
+	if m != nil {
+		return m.VlanPort
+	}
+	return ""
+}
+
+type PortModifyRequest struct {
+	Node                 string   `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Port                 string   `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`
+	Up                   string   `protobuf:"bytes,3,opt,name=up,proto3" json:"up,omitempty"`
+	Mtu                  string   `protobuf:"bytes,4,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	Auto                 string   `protobuf:"bytes,5,opt,name=auto,proto3" json:"auto,omitempty"`
+	Duplex               string   `protobuf:"bytes,6,opt,name=duplex,proto3" json:"duplex,omitempty"`
+	Flow                 string   `protobuf:"bytes,7,opt,name=flow,proto3" json:"flow,omitempty"`
+	Speed                string   `protobuf:"bytes,8,opt,name=speed,proto3" json:"speed,omitempty"`
+	AutoRevDelay         string   `protobuf:"bytes,9,opt,name=auto_rev_delay,json=autoRevDelay,proto3" json:"auto_rev_delay,omitempty"`
+	IgnrHealth           string   `protobuf:"bytes,10,opt,name=ignr_health,json=ignrHealth,proto3" json:"ignr_health,omitempty"`
+	Ipspace              string   `protobuf:"bytes,11,opt,name=ipspace,proto3" json:"ipspace,omitempty"`
+	Role                 string   `protobuf:"bytes,12,opt,name=role,proto3" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PortModifyRequest) Reset()         { *m = PortModifyRequest{} }
+func (m *PortModifyRequest) String() string { return proto.CompactTextString(m) }
+func (*PortModifyRequest) ProtoMessage()    {}
+func (*PortModifyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{15}
+}
+
+func (m *PortModifyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortModifyRequest.Unmarshal(m, b)
+}
+func (m *PortModifyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortModifyRequest.Marshal(b, m, deterministic)
+}
+func (m *PortModifyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortModifyRequest.Merge(m, src)
+}
+func (m *PortModifyRequest) XXX_Size() int {
+	return xxx_messageInfo_PortModifyRequest.Size(m)
+}
+func (m *PortModifyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortModifyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortModifyRequest proto.InternalMessageInfo
+
+func (m *PortModifyRequest) GetNode() string {
+	if m != nil {
+		return m.Node
+	}
+	return ""
+}
+
+func (m *PortModifyRequest) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+func (m *PortModifyRequest) GetUp() string {
+	if m != nil {
+		return m.Up
+	}
+	return ""
+}
+
+func (m *PortModifyRequest) GetMtu() string {
+	if m != nil {
+		return m.Mtu
+	}
+	return ""
+}
+
+func (m *PortModifyRequest) GetAuto() string {
+	if m != nil {
+		return m.Auto
+	}
+	return ""
+}
+
+func (m *PortModifyRequest) GetDuplex() string {
+	if m != nil {
+		return m.Duplex
+	}
+	return ""
+}
+
+func (m *PortModifyRequest) GetFlow() string {
+	if m != nil {
+		return m.Flow
+	}
+	return ""
+}
+
+func (m *PortModifyRequest) GetSpeed() string {
+	if m != nil {
+		return m.Speed
+	}
+	return ""
+}
+
+func (m *PortModifyRequest) GetAutoRevDelay() string {
+	if m != nil {
+		return m.AutoRevDelay
+	}
+	return ""
+}
+
+func (m *PortModifyRequest) GetIgnrHealth() string {
+	if m != nil {
+		return m.IgnrHealth
+	}
+	return ""
+}
+
+func (m *PortModifyRequest) GetIpspace() string {
+	if m != nil {
+		return m.Ipspace
+	}
+	return ""
+}
+
+func (m *PortModifyRequest) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+type PortFindResponse struct {
+	NonExist             bool     `protobuf:"varint,1,opt,name=non_exist,json=nonExist,proto3" json:"non_exist,omitempty"`
+	Ports                []string `protobuf:"bytes,2,rep,name=ports,proto3" json:"ports,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PortFindResponse) Reset()         { *m = PortFindResponse{} }
+func (m *PortFindResponse) String() string { return proto.CompactTextString(m) }
+func (*PortFindResponse) ProtoMessage()    {}
+func (*PortFindResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{16}
+}
+
+func (m *PortFindResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortFindResponse.Unmarshal(m, b)
+}
+func (m *PortFindResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortFindResponse.Marshal(b, m, deterministic)
+}
+func (m *PortFindResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortFindResponse.Merge(m, src)
+}
+func (m *PortFindResponse) XXX_Size() int {
+	return xxx_messageInfo_PortFindResponse.Size(m)
+}
+func (m *PortFindResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortFindResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortFindResponse proto.InternalMessageInfo
+
+func (m *PortFindResponse) GetNonExist() bool {
+	if m != nil {
+		return m.NonExist
+	}
+	return false
+}
+
+func (m *PortFindResponse) GetPorts() []string {
+	if m != nil {
+		return m.Ports
+	}
+	return nil
+}
+
+type PortGroupRequest struct {
+	Node                 string   `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Mode                 string   `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	Dist                 string   `protobuf:"bytes,4,opt,name=dist,proto3" json:"dist,omitempty"`
+	Ports                []string `protobuf:"bytes,5,rep,name=ports,proto3" json:"ports,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PortGroupRequest) Reset()         { *m = PortGroupRequest{} }
+func (m *PortGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*PortGroupRequest) ProtoMessage()    {}
+func (*PortGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{17}
+}
+
+func (m *PortGroupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortGroupRequest.Unmarshal(m, b)
+}
+func (m *PortGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortGroupRequest.Marshal(b, m, deterministic)
+}
+func (m *PortGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortGroupRequest.Merge(m, src)
+}
+func (m *PortGroupRequest) XXX_Size() int {
+	return xxx_messageInfo_PortGroupRequest.Size(m)
+}
+func (m *PortGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortGroupRequest proto.InternalMessageInfo
+
+func (m *PortGroupRequest) GetNode() string {
+	if m != nil {
+		return m.Node
+	}
+	return ""
+}
+
+func (m *PortGroupRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PortGroupRequest) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
+func (m *PortGroupRequest) GetDist() string {
+	if m != nil {
+		return m.Dist
+	}
+	return ""
+}
+
+func (m *PortGroupRequest) GetPorts() []string {
+	if m != nil {
+		return m.Ports
+	}
+	return nil
+}
+
+type PortGroupInfo struct {
+	NonExist             bool     `protobuf:"varint,1,opt,name=non_exist,json=nonExist,proto3" json:"non_exist,omitempty"`
+	Node                 string   `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Mode                 string   `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	Dist                 string   `protobuf:"bytes,5,opt,name=dist,proto3" json:"dist,omitempty"`
+	Ports                []string `protobuf:"bytes,6,rep,name=ports,proto3" json:"ports,omitempty"`
+	Part                 string   `protobuf:"bytes,7,opt,name=part,proto3" json:"part,omitempty"`
+	PortsDown            []string `protobuf:"bytes,8,rep,name=ports_down,json=portsDown,proto3" json:"ports_down,omitempty"`
+	PortsUp              []string `protobuf:"bytes,9,rep,name=ports_up,json=portsUp,proto3" json:"ports_up,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PortGroupInfo) Reset()         { *m = PortGroupInfo{} }
+func (m *PortGroupInfo) String() string { return proto.CompactTextString(m) }
+func (*PortGroupInfo) ProtoMessage()    {}
+func (*PortGroupInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{18}
+}
+
+func (m *PortGroupInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortGroupInfo.Unmarshal(m, b)
+}
+func (m *PortGroupInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortGroupInfo.Marshal(b, m, deterministic)
+}
+func (m *PortGroupInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortGroupInfo.Merge(m, src)
+}
+func (m *PortGroupInfo) XXX_Size() int {
+	return xxx_messageInfo_PortGroupInfo.Size(m)
+}
+func (m *PortGroupInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortGroupInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortGroupInfo proto.InternalMessageInfo
+
+func (m *PortGroupInfo) GetNonExist() bool {
+	if m != nil {
+		return m.NonExist
+	}
+	return false
+}
+
+func (m *PortGroupInfo) GetNode() string {
+	if m != nil {
+		return m.Node
+	}
+	return ""
+}
+
+func (m *PortGroupInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PortGroupInfo) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
+func (m *PortGroupInfo) GetDist() string {
+	if m != nil {
+		return m.Dist
+	}
+	return ""
+}
+
+func (m *PortGroupInfo) GetPorts() []string {
+	if m != nil {
+		return m.Ports
+	}
+	return nil
+}
+
+func (m *PortGroupInfo) GetPart() string {
+	if m != nil {
+		return m.Part
+	}
+	return ""
+}
+
+func (m *PortGroupInfo) GetPortsDown() []string {
+	if m != nil {
+		return m.PortsDown
+	}
+	return nil
+}
+
+func (m *PortGroupInfo) GetPortsUp() []string {
+	if m != nil {
+		return m.PortsUp
+	}
+	return nil
+}
+
+type AggrRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uuid                 string   `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Nodes                []string `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AggrRequest) Reset()         { *m = AggrRequest{} }
+func (m *AggrRequest) String() string { return proto.CompactTextString(m) }
+func (*AggrRequest) ProtoMessage()    {}
+func (*AggrRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{19}
+}
+
+func (m *AggrRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AggrRequest.Unmarshal(m, b)
+}
+func (m *AggrRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AggrRequest.Marshal(b, m, deterministic)
+}
+func (m *AggrRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggrRequest.Merge(m, src)
+}
+func (m *AggrRequest) XXX_Size() int {
+	return xxx_messageInfo_AggrRequest.Size(m)
+}
+func (m *AggrRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggrRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggrRequest proto.InternalMessageInfo
+
+func (m *AggrRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AggrRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *AggrRequest) GetNodes() []string {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+type AggrInfo struct {
+	NonExist             bool     `protobuf:"varint,1,opt,name=non_exist,json=nonExist,proto3" json:"non_exist,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Uuid                 string   `protobuf:"bytes,3,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Nodes                []string `protobuf:"bytes,4,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	FlexvolCnt           int64    `protobuf:"varint,5,opt,name=flexvol_cnt,json=flexvolCnt,proto3" json:"flexvol_cnt,omitempty"`
+	PctUsedCap           int64    `protobuf:"varint,6,opt,name=pct_used_cap,json=pctUsedCap,proto3" json:"pct_used_cap,omitempty"`
+	PctUsedPhys          int64    `protobuf:"varint,7,opt,name=pct_used_phys,json=pctUsedPhys,proto3" json:"pct_used_phys,omitempty"`
+	SizeTotal            int64    `protobuf:"varint,8,opt,name=size_total,json=sizeTotal,proto3" json:"size_total,omitempty"`
+	SizeUsed             int64    `protobuf:"varint,9,opt,name=size_used,json=sizeUsed,proto3" json:"size_used,omitempty"`
+	SizeAvail            int64    `protobuf:"varint,10,opt,name=size_avail,json=sizeAvail,proto3" json:"size_avail,omitempty"`
+	SizeReserve          int64    `protobuf:"varint,11,opt,name=size_reserve,json=sizeReserve,proto3" json:"size_reserve,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AggrInfo) Reset()         { *m = AggrInfo{} }
+func (m *AggrInfo) String() string { return proto.CompactTextString(m) }
+func (*AggrInfo) ProtoMessage()    {}
+func (*AggrInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{20}
+}
+
+func (m *AggrInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AggrInfo.Unmarshal(m, b)
+}
+func (m *AggrInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AggrInfo.Marshal(b, m, deterministic)
+}
+func (m *AggrInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggrInfo.Merge(m, src)
+}
+func (m *AggrInfo) XXX_Size() int {
+	return xxx_messageInfo_AggrInfo.Size(m)
+}
+func (m *AggrInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggrInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggrInfo proto.InternalMessageInfo
+
+func (m *AggrInfo) GetNonExist() bool {
+	if m != nil {
+		return m.NonExist
+	}
+	return false
+}
+
+func (m *AggrInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AggrInfo) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *AggrInfo) GetNodes() []string {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *AggrInfo) GetFlexvolCnt() int64 {
+	if m != nil {
+		return m.FlexvolCnt
+	}
+	return 0
+}
+
+func (m *AggrInfo) GetPctUsedCap() int64 {
+	if m != nil {
+		return m.PctUsedCap
+	}
+	return 0
+}
+
+func (m *AggrInfo) GetPctUsedPhys() int64 {
+	if m != nil {
+		return m.PctUsedPhys
+	}
+	return 0
+}
+
+func (m *AggrInfo) GetSizeTotal() int64 {
+	if m != nil {
+		return m.SizeTotal
+	}
+	return 0
+}
+
+func (m *AggrInfo) GetSizeUsed() int64 {
+	if m != nil {
+		return m.SizeUsed
+	}
+	return 0
+}
+
+func (m *AggrInfo) GetSizeAvail() int64 {
+	if m != nil {
+		return m.SizeAvail
+	}
+	return 0
+}
+
+func (m *AggrInfo) GetSizeReserve() int64 {
+	if m != nil {
+		return m.SizeReserve
+	}
+	return 0
+}
+
+type JobRequest struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Svm                  string   `protobuf:"bytes,2,opt,name=svm,proto3" json:"svm,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JobRequest) Reset()         { *m = JobRequest{} }
+func (m *JobRequest) String() string { return proto.CompactTextString(m) }
+func (*JobRequest) ProtoMessage()    {}
+func (*JobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{21}
+}
+
+func (m *JobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JobRequest.Unmarshal(m, b)
+}
+func (m *JobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JobRequest.Marshal(b, m, deterministic)
+}
+func (m *JobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobRequest.Merge(m, src)
+}
+func (m *JobRequest) XXX_Size() int {
+	return xxx_messageInfo_JobRequest.Size(m)
+}
+func (m *JobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobRequest proto.InternalMessageInfo
+
+func (m *JobRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *JobRequest) GetSvm() string {
+	if m != nil {
+		return m.Svm
+	}
+	return ""
+}
+
+type JobInfo struct {
+	NonExist             bool     `protobuf:"varint,1,opt,name=non_exist,json=nonExist,proto3" json:"non_exist,omitempty"`
+	Id                   int64    `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Svm                  string   `protobuf:"bytes,3,opt,name=svm,proto3" json:"svm,omitempty"`
+	Msg                  string   `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+	Status               string   `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Errno                int64    `protobuf:"varint,6,opt,name=errno,proto3" json:"errno,omitempty"`
+	Progress             string   `protobuf:"bytes,7,opt,name=progress,proto3" json:"progress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JobInfo) Reset()         { *m = JobInfo{} }
+func (m *JobInfo) String() string { return proto.CompactTextString(m) }
+func (*JobInfo) ProtoMessage()    {}
+func (*JobInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{22}
+}
+
+func (m *JobInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JobInfo.Unmarshal(m, b)
+}
+func (m *JobInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JobInfo.Marshal(b, m, deterministic)
+}
+func (m *JobInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobInfo.Merge(m, src)
+}
+func (m *JobInfo) XXX_Size() int {
+	return xxx_messageInfo_JobInfo.Size(m)
+}
+func (m *JobInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobInfo proto.InternalMessageInfo
+
+func (m *JobInfo) GetNonExist() bool {
+	if m != nil {
+		return m.NonExist
+	}
+	return false
+}
+
+func (m *JobInfo) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *JobInfo) GetSvm() string {
+	if m != nil {
+		return m.Svm
+	}
+	return ""
+}
+
+func (m *JobInfo) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *JobInfo) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *JobInfo) GetErrno() int64 {
+	if m != nil {
+		return m.Errno
+	}
+	return 0
+}
+
+func (m *JobInfo) GetProgress() string {
+	if m != nil {
+		return m.Progress
+	}
+	return ""
+}
+
+type WatchJobRequest struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Svm                  string   `protobuf:"bytes,2,opt,name=svm,proto3" json:"svm,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchJobRequest) Reset()         { *m = WatchJobRequest{} }
+func (m *WatchJobRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchJobRequest) ProtoMessage()    {}
+func (*WatchJobRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{23}
+}
+
+func (m *WatchJobRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchJobRequest.Unmarshal(m, b)
+}
+func (m *WatchJobRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchJobRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchJobRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchJobRequest.Merge(m, src)
+}
+func (m *WatchJobRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchJobRequest.Size(m)
+}
+func (m *WatchJobRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchJobRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchJobRequest proto.InternalMessageInfo
+
+func (m *WatchJobRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *WatchJobRequest) GetSvm() string {
+	if m != nil {
+		return m.Svm
+	}
+	return ""
+}
+
+type VlanRequest struct {
+	NodeName             string   `protobuf:"bytes,1,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	ParentName           string   `protobuf:"bytes,2,opt,name=parent_name,json=parentName,proto3" json:"parent_name,omitempty"`
+	VlanId               string   `protobuf:"bytes,3,opt,name=vlan_id,json=vlanId,proto3" json:"vlan_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VlanRequest) Reset()         { *m = VlanRequest{} }
+func (m *VlanRequest) String() string { return proto.CompactTextString(m) }
+func (*VlanRequest) ProtoMessage()    {}
+func (*VlanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{24}
+}
+
+func (m *VlanRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VlanRequest.Unmarshal(m, b)
+}
+func (m *VlanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VlanRequest.Marshal(b, m, deterministic)
+}
+func (m *VlanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VlanRequest.Merge(m, src)
+}
+func (m *VlanRequest) XXX_Size() int {
+	return xxx_messageInfo_VlanRequest.Size(m)
+}
+func (m *VlanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VlanRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VlanRequest proto.InternalMessageInfo
+
+func (m *VlanRequest) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
+	}
+	return ""
+}
+
+func (m *VlanRequest) GetParentName() string {
+	if m != nil {
+		return m.ParentName
+	}
+	return ""
+}
+
+func (m *VlanRequest) GetVlanId() string {
+	if m != nil {
+		return m.VlanId
+	}
+	return ""
+}
+
+type VlanInfo struct {
+	NonExist             bool     `protobuf:"varint,1,opt,name=non_exist,json=nonExist,proto3" json:"non_exist,omitempty"`
+	NodeName             string   `protobuf:"bytes,2,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	ParentName           string   `protobuf:"bytes,3,opt,name=parent_name,json=parentName,proto3" json:"parent_name,omitempty"`
+	VlanId               string   `protobuf:"bytes,4,opt,name=vlan_id,json=vlanId,proto3" json:"vlan_id,omitempty"`
+	Name                 string   `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VlanInfo) Reset()         { *m = VlanInfo{} }
+func (m *VlanInfo) String() string { return proto.CompactTextString(m) }
+func (*VlanInfo) ProtoMessage()    {}
+func (*VlanInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{25}
+}
+
+func (m *VlanInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VlanInfo.Unmarshal(m, b)
+}
+func (m *VlanInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VlanInfo.Marshal(b, m, deterministic)
+}
+func (m *VlanInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VlanInfo.Merge(m, src)
+}
+func (m *VlanInfo) XXX_Size() int {
+	return xxx_messageInfo_VlanInfo.Size(m)
+}
+func (m *VlanInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_VlanInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VlanInfo proto.InternalMessageInfo
+
+func (m *VlanInfo) GetNonExist() bool {
+	if m != nil {
+		return m.NonExist
+	}
+	return false
+}
+
+func (m *VlanInfo) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
+	}
+	return ""
+}
+
+func (m *VlanInfo) GetParentName() string {
+	if m != nil {
+		return m.ParentName
+	}
+	return ""
+}
+
+func (m *VlanInfo) GetVlanId() string {
+	if m != nil {
+		return m.VlanId
+	}
+	return ""
+}
+
+func (m *VlanInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type IPSpaceRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uuid                 string   `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	NewName              string   `protobuf:"bytes,3,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IPSpaceRequest) Reset()         { *m = IPSpaceRequest{} }
+func (m *IPSpaceRequest) String() string { return proto.CompactTextString(m) }
+func (*IPSpaceRequest) ProtoMessage()    {}
+func (*IPSpaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{26}
+}
+
+func (m *IPSpaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IPSpaceRequest.Unmarshal(m, b)
+}
+func (m *IPSpaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IPSpaceRequest.Marshal(b, m, deterministic)
+}
+func (m *IPSpaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IPSpaceRequest.Merge(m, src)
+}
+func (m *IPSpaceRequest) XXX_Size() int {
+	return xxx_messageInfo_IPSpaceRequest.Size(m)
+}
+func (m *IPSpaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IPSpaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IPSpaceRequest proto.InternalMessageInfo
+
+func (m *IPSpaceRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *IPSpaceRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *IPSpaceRequest) GetNewName() string {
+	if m != nil {
+		return m.NewName
+	}
+	return ""
+}
+
+type IPSpaceInfo struct {
+	NonExist             bool     `protobuf:"varint,1,opt,name=non_exist,json=nonExist,proto3" json:"non_exist,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Uuid                 string   `protobuf:"bytes,3,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	BcDomains            []string `protobuf:"bytes,4,rep,name=bc_domains,json=bcDomains,proto3" json:"bc_domains,omitempty"`
+	Ports                []string `protobuf:"bytes,5,rep,name=ports,proto3" json:"ports,omitempty"`
+	Vservers             []string `protobuf:"bytes,6,rep,name=vservers,proto3" json:"vservers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IPSpaceInfo) Reset()         { *m = IPSpaceInfo{} }
+func (m *IPSpaceInfo) String() string { return proto.CompactTextString(m) }
+func (*IPSpaceInfo) ProtoMessage()    {}
+func (*IPSpaceInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{27}
+}
+
+func (m *IPSpaceInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IPSpaceInfo.Unmarshal(m, b)
+}
+func (m *IPSpaceInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IPSpaceInfo.Marshal(b, m, deterministic)
+}
+func (m *IPSpaceInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IPSpaceInfo.Merge(m, src)
+}
+func (m *IPSpaceInfo) XXX_Size() int {
+	return xxx_messageInfo_IPSpaceInfo.Size(m)
+}
+func (m *IPSpaceInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_IPSpaceInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IPSpaceInfo proto.InternalMessageInfo
+
+func (m *IPSpaceInfo) GetNonExist() bool {
+	if m != nil {
+		return m.NonExist
+	}
+	return false
+}
+
+func (m *IPSpaceInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *IPSpaceInfo) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *IPSpaceInfo) GetBcDomains() []string {
+	if m != nil {
+		return m.BcDomains
+	}
+	return nil
+}
+
+func (m *IPSpaceInfo) GetPorts() []string {
+	if m != nil {
+		return m.Ports
+	}
+	return nil
+}
+
+func (m *IPSpaceInfo) GetVservers() []string {
+	if m != nil {
+		return m.Vservers
+	}
+	return nil
+}
+
+type BcDomainRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NewName              string   `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	Mtu                  string   `protobuf:"bytes,3,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	Ipspace              string   `protobuf:"bytes,4,opt,name=ipspace,proto3" json:"ipspace,omitempty"`
+	Ports                []string `protobuf:"bytes,5,rep,name=ports,proto3" json:"ports,omitempty"`
+	Statusonly           string   `protobuf:"bytes,6,opt,name=statusonly,proto3" json:"statusonly,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BcDomainRequest) Reset()         { *m = BcDomainRequest{} }
+func (m *BcDomainRequest) String() string { return proto.CompactTextString(m) }
+func (*BcDomainRequest) ProtoMessage()    {}
+func (*BcDomainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{28}
+}
+
+func (m *BcDomainRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BcDomainRequest.Unmarshal(m, b)
+}
+func (m *BcDomainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BcDomainRequest.Marshal(b, m, deterministic)
+}
+func (m *BcDomainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BcDomainRequest.Merge(m, src)
+}
+func (m *BcDomainRequest) XXX_Size() int {
+	return xxx_messageInfo_BcDomainRequest.Size(m)
+}
+func (m *BcDomainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BcDomainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BcDomainRequest proto.InternalMessageInfo
+
+func (m *BcDomainRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *BcDomainRequest) GetNewName() string {
+	if m != nil {
+		return m.NewName
+	}
+	return ""
+}
+
+func (m *BcDomainRequest) GetMtu() string {
+	if m != nil {
+		return m.Mtu
+	}
+	return ""
+}
+
+func (m *BcDomainRequest) GetIpspace() string {
+	if m != nil {
+		return m.Ipspace
+	}
+	return ""
+}
+
+func (m *BcDomainRequest) GetPorts() []string {
+	if m != nil {
+		return m.Ports
+	}
+	return nil
+}
+
+func (m *BcDomainRequest) GetStatusonly() string {
+	if m != nil {
+		return m.Statusonly
+	}
+	return ""
+}
+
+type BcDomainPortInfo struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	UpdateStatus         string   `protobuf:"bytes,2,opt,name=update_status,json=updateStatus,proto3" json:"update_status,omitempty"`
+	StatusDetail         string   `protobuf:"bytes,3,opt,name=status_detail,json=statusDetail,proto3" json:"status_detail,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BcDomainPortInfo) Reset()         { *m = BcDomainPortInfo{} }
+func (m *BcDomainPortInfo) String() string { return proto.CompactTextString(m) }
+func (*BcDomainPortInfo) ProtoMessage()    {}
+func (*BcDomainPortInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{29}
+}
+
+func (m *BcDomainPortInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BcDomainPortInfo.Unmarshal(m, b)
+}
+func (m *BcDomainPortInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BcDomainPortInfo.Marshal(b, m, deterministic)
+}
+func (m *BcDomainPortInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BcDomainPortInfo.Merge(m, src)
+}
+func (m *BcDomainPortInfo) XXX_Size() int {
+	return xxx_messageInfo_BcDomainPortInfo.Size(m)
+}
+func (m *BcDomainPortInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BcDomainPortInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BcDomainPortInfo proto.InternalMessageInfo
+
+func (m *BcDomainPortInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *BcDomainPortInfo) GetUpdateStatus() string {
+	if m != nil {
+		return m.UpdateStatus
+	}
+	return ""
+}
+
+func (m *BcDomainPortInfo) GetStatusDetail() string {
+	if m != nil {
+		return m.StatusDetail
+	}
+	return ""
+}
+
+type BcDomainInfo struct {
+	NonExist             bool                `protobuf:"varint,1,opt,name=non_exist,json=nonExist,proto3" json:"non_exist,omitempty"`
+	Name                 string              `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Failovergrps         []string            `protobuf:"bytes,3,rep,name=failovergrps,proto3" json:"failovergrps,omitempty"`
+	Ipspace              string              `protobuf:"bytes,4,opt,name=ipspace,proto3" json:"ipspace,omitempty"`
+	Mtu                  string              `protobuf:"bytes,5,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	UpdateStatus         string              `protobuf:"bytes,6,opt,name=update_status,json=updateStatus,proto3" json:"update_status,omitempty"`
+	Ports                []*BcDomainPortInfo `protobuf:"bytes,7,rep,name=ports,proto3" json:"ports,omitempty"`
+	Subnets              []string            `protobuf:"bytes,8,rep,name=subnets,proto3" json:"subnets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *BcDomainInfo) Reset()         { *m = BcDomainInfo{} }
+func (m *BcDomainInfo) String() string { return proto.CompactTextString(m) }
+func (*BcDomainInfo) ProtoMessage()    {}
+func (*BcDomainInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{30}
+}
+
+func (m *BcDomainInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BcDomainInfo.Unmarshal(m, b)
+}
+func (m *BcDomainInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BcDomainInfo.Marshal(b, m, deterministic)
+}
+func (m *BcDomainInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BcDomainInfo.Merge(m, src)
+}
+func (m *BcDomainInfo) XXX_Size() int {
+	return xxx_messageInfo_BcDomainInfo.Size(m)
+}
+func (m *BcDomainInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BcDomainInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BcDomainInfo proto.InternalMessageInfo
+
+func (m *BcDomainInfo) GetNonExist() bool {
+	if m != nil {
+		return m.NonExist
+	}
+	return false
+}
+
+func (m *BcDomainInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *BcDomainInfo) GetFailovergrps() []string {
+	if m != nil {
+		return m.Failovergrps
+	}
+	return nil
+}
+
+func (m *BcDomainInfo) GetIpspace() string {
+	if m != nil {
+		return m.Ipspace
+	}
+	return ""
+}
+
+func (m *BcDomainInfo) GetMtu() string {
+	if m != nil {
+		return m.Mtu
+	}
+	return ""
+}
+
+func (m *BcDomainInfo) GetUpdateStatus() string {
+	if m != nil {
+		return m.UpdateStatus
+	}
+	return ""
+}
+
+func (m *BcDomainInfo) GetPorts() []*BcDomainPortInfo {
+	if m != nil {
+		return m.Ports
+	}
+	return nil
+}
+
+func (m *BcDomainInfo) GetSubnets() []string {
+	if m != nil {
+		return m.Subnets
+	}
+	return nil
+}
+
+type SubnetRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NewName              string   `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	BcDomain             string   `protobuf:"bytes,3,opt,name=bc_domain,json=bcDomain,proto3" json:"bc_domain,omitempty"`
+	Ipspace              string   `protobuf:"bytes,4,opt,name=ipspace,proto3" json:"ipspace,omitempty"`
+	Subnet               string   `protobuf:"bytes,5,opt,name=subnet,proto3" json:"subnet,omitempty"`
+	Gateway              string   `protobuf:"bytes,6,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	IpRanges             []string `protobuf:"bytes,7,rep,name=ip_ranges,json=ipRanges,proto3" json:"ip_ranges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubnetRequest) Reset()         { *m = SubnetRequest{} }
+func (m *SubnetRequest) String() string { return proto.CompactTextString(m) }
+func (*SubnetRequest) ProtoMessage()    {}
+func (*SubnetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{31}
+}
+
+func (m *SubnetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubnetRequest.Unmarshal(m, b)
+}
+func (m *SubnetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubnetRequest.Marshal(b, m, deterministic)
+}
+func (m *SubnetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubnetRequest.Merge(m, src)
+}
+func (m *SubnetRequest) XXX_Size() int {
+	return xxx_messageInfo_SubnetRequest.Size(m)
+}
+func (m *SubnetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubnetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubnetRequest proto.InternalMessageInfo
+
+func (m *SubnetRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SubnetRequest) GetNewName() string {
+	if m != nil {
+		return m.NewName
+	}
+	return ""
+}
+
+func (m *SubnetRequest) GetBcDomain() string {
+	if m != nil {
+		return m.BcDomain
+	}
+	return ""
+}
+
+func (m *SubnetRequest) GetIpspace() string {
+	if m != nil {
+		return m.Ipspace
+	}
+	return ""
+}
+
+func (m *SubnetRequest) GetSubnet() string {
+	if m != nil {
+		return m.Subnet
+	}
+	return ""
+}
+
+func (m *SubnetRequest) GetGateway() string {
+	if m != nil {
+		return m.Gateway
+	}
+	return ""
+}
+
+func (m *SubnetRequest) GetIpRanges() []string {
+	if m != nil {
+		return m.IpRanges
+	}
+	return nil
+}
+
+type SubnetInfo struct {
+	NonExist             bool     `protobuf:"varint,1,opt,name=non_exist,json=nonExist,proto3" json:"non_exist,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	BcDomain             string   `protobuf:"bytes,3,opt,name=bc_domain,json=bcDomain,proto3" json:"bc_domain,omitempty"`
+	Ipspace              string   `protobuf:"bytes,4,opt,name=ipspace,proto3" json:"ipspace,omitempty"`
+	Subnet               string   `protobuf:"bytes,5,opt,name=subnet,proto3" json:"subnet,omitempty"`
+	Gateway              string   `protobuf:"bytes,6,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	IpRanges             []string `protobuf:"bytes,7,rep,name=ip_ranges,json=ipRanges,proto3" json:"ip_ranges,omitempty"`
+	IpCount              int64    `protobuf:"varint,8,opt,name=ip_count,json=ipCount,proto3" json:"ip_count,omitempty"`
+	IpUsed               int64    `protobuf:"varint,9,opt,name=ip_used,json=ipUsed,proto3" json:"ip_used,omitempty"`
+	IpAvail              int64    `protobuf:"varint,10,opt,name=ip_avail,json=ipAvail,proto3" json:"ip_avail,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubnetInfo) Reset()         { *m = SubnetInfo{} }
+func (m *SubnetInfo) String() string { return proto.CompactTextString(m) }
+func (*SubnetInfo) ProtoMessage()    {}
+func (*SubnetInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{32}
+}
+
+func (m *SubnetInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubnetInfo.Unmarshal(m, b)
+}
+func (m *SubnetInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubnetInfo.Marshal(b, m, deterministic)
+}
+func (m *SubnetInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubnetInfo.Merge(m, src)
+}
+func (m *SubnetInfo) XXX_Size() int {
+	return xxx_messageInfo_SubnetInfo.Size(m)
+}
+func (m *SubnetInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubnetInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubnetInfo proto.InternalMessageInfo
+
+func (m *SubnetInfo) GetNonExist() bool {
+	if m != nil {
+		return m.NonExist
+	}
+	return false
+}
+
+func (m *SubnetInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SubnetInfo) GetBcDomain() string {
+	if m != nil {
+		return m.BcDomain
+	}
+	return ""
+}
+
+func (m *SubnetInfo) GetIpspace() string {
+	if m != nil {
+		return m.Ipspace
+	}
+	return ""
+}
+
+func (m *SubnetInfo) GetSubnet() string {
+	if m != nil {
+		return m.Subnet
+	}
+	return ""
+}
+
+func (m *SubnetInfo) GetGateway() string {
+	if m != nil {
+		return m.Gateway
+	}
+	return ""
+}
+
+func (m *SubnetInfo) GetIpRanges() []string {
+	if m != nil {
+		return m.IpRanges
+	}
+	return nil
+}
+
+func (m *SubnetInfo) GetIpCount() int64 {
+	if m != nil {
+		return m.IpCount
+	}
+	return 0
+}
+
+func (m *SubnetInfo) GetIpUsed() int64 {
+	if m != nil {
+		return m.IpUsed
+	}
+	return 0
+}
+
+func (m *SubnetInfo) GetIpAvail() int64 {
+	if m != nil {
+		return m.IpAvail
+	}
+	return 0
+}
+
+type SvmRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NewName              string   `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	Force                string   `protobuf:"bytes,3,opt,name=force,proto3" json:"force,omitempty"`
+	Uuid                 string   `protobuf:"bytes,4,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Ipspace              string   `protobuf:"bytes,5,opt,name=ipspace,proto3" json:"ipspace,omitempty"`
+	RootAggr             string   `protobuf:"bytes,6,opt,name=root_aggr,json=rootAggr,proto3" json:"root_aggr,omitempty"`
+	RootSecStyle         string   `protobuf:"bytes,7,opt,name=root_sec_style,json=rootSecStyle,proto3" json:"root_sec_style,omitempty"`
+	RootName             string   `protobuf:"bytes,8,opt,name=root_name,json=rootName,proto3" json:"root_name,omitempty"`
+	RootRetent           string   `protobuf:"bytes,9,opt,name=root_retent,json=rootRetent,proto3" json:"root_retent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SvmRequest) Reset()         { *m = SvmRequest{} }
+func (m *SvmRequest) String() string { return proto.CompactTextString(m) }
+func (*SvmRequest) ProtoMessage()    {}
+func (*SvmRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{33}
+}
+
+func (m *SvmRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SvmRequest.Unmarshal(m, b)
+}
+func (m *SvmRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SvmRequest.Marshal(b, m, deterministic)
+}
+func (m *SvmRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SvmRequest.Merge(m, src)
+}
+func (m *SvmRequest) XXX_Size() int {
+	return xxx_messageInfo_SvmRequest.Size(m)
+}
+func (m *SvmRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SvmRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SvmRequest proto.InternalMessageInfo
+
+func (m *SvmRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SvmRequest) GetNewName() string {
+	if m != nil {
+		return m.NewName
+	}
+	return ""
+}
+
+func (m *SvmRequest) GetForce() string {
+	if m != nil {
+		return m.Force
+	}
+	return ""
+}
+
+func (m *SvmRequest) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *SvmRequest) GetIpspace() string {
+	if m != nil {
+		return m.Ipspace
+	}
+	return ""
+}
+
+func (m *SvmRequest) GetRootAggr() string {
+	if m != nil {
+		return m.RootAggr
+	}
+	return ""
+}
+
+func (m *SvmRequest) GetRootSecStyle() string {
+	if m != nil {
+		return m.RootSecStyle
+	}
+	return ""
+}
+
+func (m *SvmRequest) GetRootName() string {
+	if m != nil {
+		return m.RootName
+	}
+	return ""
+}
+
+func (m *SvmRequest) GetRootRetent() string {
+	if m != nil {
+		return m.RootRetent
+	}
+	return ""
+}
+
+type SvmInfo struct {
+	NonExist             bool     `protobuf:"varint,1,opt,name=non_exist,json=nonExist,proto3" json:"non_exist,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Uuid                 string   `protobuf:"bytes,3,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Ipspace              string   `protobuf:"bytes,4,opt,name=ipspace,proto3" json:"ipspace,omitempty"`
+	RootAggr             string   `protobuf:"bytes,5,opt,name=root_aggr,json=rootAggr,proto3" json:"root_aggr,omitempty"`
+	RootSecStyle         string   `protobuf:"bytes,6,opt,name=root_sec_style,json=rootSecStyle,proto3" json:"root_sec_style,omitempty"`
+	RootName             string   `protobuf:"bytes,7,opt,name=root_name,json=rootName,proto3" json:"root_name,omitempty"`
+	RootRetent           string   `protobuf:"bytes,8,opt,name=root_retent,json=rootRetent,proto3" json:"root_retent,omitempty"`
+	Locked               bool     `protobuf:"varint,9,opt,name=locked,proto3" json:"locked,omitempty"`
+	OperState            string   `protobuf:"bytes,10,opt,name=oper_state,json=operState,proto3" json:"oper_state,omitempty"`
+	SvmState             string   `protobuf:"bytes,11,opt,name=svm_state,json=svmState,proto3" json:"svm_state,omitempty"`
+	ProtoEnabled         []string `protobuf:"bytes,12,rep,name=proto_enabled,json=protoEnabled,proto3" json:"proto_enabled,omitempty"`
+	ProtoInactive        []string `protobuf:"bytes,13,rep,name=proto_inactive,json=protoInactive,proto3" json:"proto_inactive,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SvmInfo) Reset()         { *m = SvmInfo{} }
+func (m *SvmInfo) String() string { return proto.CompactTextString(m) }
+func (*SvmInfo) ProtoMessage()    {}
+func (*SvmInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{34}
+}
+
+func (m *SvmInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SvmInfo.Unmarshal(m, b)
+}
+func (m *SvmInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SvmInfo.Marshal(b, m, deterministic)
+}
+func (m *SvmInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SvmInfo.Merge(m, src)
+}
+func (m *SvmInfo) XXX_Size() int {
+	return xxx_messageInfo_SvmInfo.Size(m)
+}
+func (m *SvmInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SvmInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SvmInfo proto.InternalMessageInfo
+
+func (m *SvmInfo) GetNonExist() bool {
+	if m != nil {
+		return m.NonExist
+	}
+	return false
+}
+
+func (m *SvmInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SvmInfo) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *SvmInfo) GetIpspace() string {
+	if m != nil {
+		return m.Ipspace
+	}
+	return ""
+}
+
+func (m *SvmInfo) GetRootAggr() string {
+	if m != nil {
+		return m.RootAggr
+	}
+	return ""
+}
+
+func (m *SvmInfo) GetRootSecStyle() string {
+	if m != nil {
+		return m.RootSecStyle
+	}
+	return ""
+}
+
+func (m *SvmInfo) GetRootName() string {
+	if m != nil {
+		return m.RootName
+	}
+	return ""
+}
+
+func (m *SvmInfo) GetRootRetent() string {
+	if m != nil {
+		return m.RootRetent
+	}
+	return ""
+}
+
+func (m *SvmInfo) GetLocked() bool {
+	if m != nil {
+		return m.Locked
+	}
+	return false
+}
+
+func (m *SvmInfo) GetOperState() string {
+	if m != nil {
+		return m.OperState
+	}
+	return ""
+}
+
+func (m *SvmInfo) GetSvmState() string {
+	if m != nil {
+		return m.SvmState
+	}
+	return ""
+}
+
+func (m *SvmInfo) GetProtoEnabled() []string {
+	if m != nil {
+		return m.ProtoEnabled
+	}
+	return nil
+}
+
+func (m *SvmInfo) GetProtoInactive() []string {
+	if m != nil {
+		return m.ProtoInactive
+	}
+	return nil
+}
+
+// SvmJobResult is the SVM info with the result of the async SVM job
+type SvmJobResult struct {
+	NonExist             bool     `protobuf:"varint,1,opt,name=non_exist,json=nonExist,proto3" json:"non_exist,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Uuid                 string   `protobuf:"bytes,3,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Ipspace              string   `protobuf:"bytes,4,opt,name=ipspace,proto3" json:"ipspace,omitempty"`
+	RootAggr             string   `protobuf:"bytes,5,opt,name=root_aggr,json=rootAggr,proto3" json:"root_aggr,omitempty"`
+	RootSecStyle         string   `protobuf:"bytes,6,opt,name=root_sec_style,json=rootSecStyle,proto3" json:"root_sec_style,omitempty"`
+	RootName             string   `protobuf:"bytes,7,opt,name=root_name,json=rootName,proto3" json:"root_name,omitempty"`
+	RootRetent           string   `protobuf:"bytes,8,opt,name=root_retent,json=rootRetent,proto3" json:"root_retent,omitempty"`
+	Locked               bool     `protobuf:"varint,9,opt,name=locked,proto3" json:"locked,omitempty"`
+	OperState            string   `protobuf:"bytes,10,opt,name=oper_state,json=operState,proto3" json:"oper_state,omitempty"`
+	SvmState             string   `protobuf:"bytes,11,opt,name=svm_state,json=svmState,proto3" json:"svm_state,omitempty"`
+	ProtoEnabled         []string `protobuf:"bytes,12,rep,name=proto_enabled,json=protoEnabled,proto3" json:"proto_enabled,omitempty"`
+	ProtoInactive        []string `protobuf:"bytes,13,rep,name=proto_inactive,json=protoInactive,proto3" json:"proto_inactive,omitempty"`
+	Status               string   `protobuf:"bytes,14,opt,name=status,proto3" json:"status,omitempty"`
+	Jobid                int64    `protobuf:"varint,15,opt,name=jobid,proto3" json:"jobid,omitempty"`
+	Errno                int64    `protobuf:"varint,16,opt,name=errno,proto3" json:"errno,omitempty"`
+	Errmsg               string   `protobuf:"bytes,17,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SvmJobResult) Reset()         { *m = SvmJobResult{} }
+func (m *SvmJobResult) String() string { return proto.CompactTextString(m) }
+func (*SvmJobResult) ProtoMessage()    {}
+func (*SvmJobResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{35}
+}
+
+func (m *SvmJobResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SvmJobResult.Unmarshal(m, b)
+}
+func (m *SvmJobResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SvmJobResult.Marshal(b, m, deterministic)
+}
+func (m *SvmJobResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SvmJobResult.Merge(m, src)
+}
+func (m *SvmJobResult) XXX_Size() int {
+	return xxx_messageInfo_SvmJobResult.Size(m)
+}
+func (m *SvmJobResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SvmJobResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SvmJobResult proto.InternalMessageInfo
+
+func (m *SvmJobResult) GetNonExist() bool {
+	if m != nil {
+		return m.NonExist
+	}
+	return false
+}
+
+func (m *SvmJobResult) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SvmJobResult) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *SvmJobResult) GetIpspace() string {
+	if m != nil {
+		return m.Ipspace
+	}
+	return ""
+}
+
+func (m *SvmJobResult) GetRootAggr() string {
+	if m != nil {
+		return m.RootAggr
+	}
+	return ""
+}
+
+func (m *SvmJobResult) GetRootSecStyle() string {
+	if m != nil {
+		return m.RootSecStyle
+	}
+	return ""
+}
+
+func (m *SvmJobResult) GetRootName() string {
+	if m != nil {
+		return m.RootName
+	}
+	return ""
+}
+
+func (m *SvmJobResult) GetRootRetent() string {
+	if m != nil {
+		return m.RootRetent
+	}
+	return ""
+}
+
+func (m *SvmJobResult) GetLocked() bool {
+	if m != nil {
+		return m.Locked
+	}
+	return false
+}
+
+func (m *SvmJobResult) GetOperState() string {
+	if m != nil {
+		return m.OperState
+	}
+	return ""
+}
+
+func (m *SvmJobResult) GetSvmState() string {
+	if m != nil {
+		return m.SvmState
+	}
+	return ""
+}
+
+func (m *SvmJobResult) GetProtoEnabled() []string {
+	if m != nil {
+		return m.ProtoEnabled
+	}
+	return nil
+}
+
+func (m *SvmJobResult) GetProtoInactive() []string {
+	if m != nil {
+		return m.ProtoInactive
+	}
+	return nil
+}
+
+func (m *SvmJobResult) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *SvmJobResult) GetJobid() int64 {
+	if m != nil {
+		return m.Jobid
+	}
+	return 0
+}
+
+func (m *SvmJobResult) GetErrno() int64 {
+	if m != nil {
+		return m.Errno
+	}
+	return 0
+}
+
+func (m *SvmJobResult) GetErrmsg() string {
+	if m != nil {
+		return m.Errmsg
+	}
+	return ""
+}
+
+type VolumeRequest struct {
+	SvmName              string   `protobuf:"bytes,1,opt,name=svm_name,json=svmName,proto3" json:"svm_name,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Size                 string   `protobuf:"bytes,3,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VolumeRequest) Reset()         { *m = VolumeRequest{} }
+func (m *VolumeRequest) String() string { return proto.CompactTextString(m) }
+func (*VolumeRequest) ProtoMessage()    {}
+func (*VolumeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{36}
+}
+
+func (m *VolumeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VolumeRequest.Unmarshal(m, b)
+}
+func (m *VolumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VolumeRequest.Marshal(b, m, deterministic)
+}
+func (m *VolumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolumeRequest.Merge(m, src)
+}
+func (m *VolumeRequest) XXX_Size() int {
+	return xxx_messageInfo_VolumeRequest.Size(m)
+}
+func (m *VolumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolumeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VolumeRequest proto.InternalMessageInfo
+
+func (m *VolumeRequest) GetSvmName() string {
+	if m != nil {
+		return m.SvmName
+	}
+	return ""
+}
+
+func (m *VolumeRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *VolumeRequest) GetSize() string {
+	if m != nil {
+		return m.Size
+	}
+	return ""
+}
+
+type VolumeInfo struct {
+	NonExist             bool     `protobuf:"varint,1,opt,name=non_exist,json=nonExist,proto3" json:"non_exist,omitempty"`
+	SvmName              string   `protobuf:"bytes,2,opt,name=svm_name,json=svmName,proto3" json:"svm_name,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Size                 string   `protobuf:"bytes,4,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VolumeInfo) Reset()         { *m = VolumeInfo{} }
+func (m *VolumeInfo) String() string { return proto.CompactTextString(m) }
+func (*VolumeInfo) ProtoMessage()    {}
+func (*VolumeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7b78476b7b33751, []int{37}
+}
+
+func (m *VolumeInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VolumeInfo.Unmarshal(m, b)
+}
+func (m *VolumeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VolumeInfo.Marshal(b, m, deterministic)
+}
+func (m *VolumeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolumeInfo.Merge(m, src)
+}
+func (m *VolumeInfo) XXX_Size() int {
+	return xxx_messageInfo_VolumeInfo.Size(m)
+}
+func (m *VolumeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolumeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VolumeInfo proto.InternalMessageInfo
+
+func (m *VolumeInfo) GetNonExist() bool {
+	if m != nil {
+		return m.NonExist
+	}
+	return false
+}
+
+func (m *VolumeInfo) GetSvmName() string {
+	if m != nil {
+		return m.SvmName
+	}
+	return ""
+}
+
+func (m *VolumeInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *VolumeInfo) GetSize() string {
+	if m != nil {
+		return m.Size
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*CallRequest)(nil), "grpcapi.CallRequest")
+	proto.RegisterType((*CallResponse)(nil), "grpcapi.CallResponse")
+	proto.RegisterType((*BatchRequest)(nil), "grpcapi.BatchRequest")
+	proto.RegisterType((*BatchResponse)(nil), "grpcapi.BatchResponse")
+	proto.RegisterType((*ShutdownRequest)(nil), "grpcapi.ShutdownRequest")
+	proto.RegisterType((*ShutdownResponse)(nil), "grpcapi.ShutdownResponse")
+	proto.RegisterType((*VersionRequest)(nil), "grpcapi.VersionRequest")
+	proto.RegisterType((*VersionResponse)(nil), "grpcapi.VersionResponse")
+	proto.RegisterType((*EmptyResponse)(nil), "grpcapi.EmptyResponse")
+	proto.RegisterType((*LogRequest)(nil), "grpcapi.LogRequest")
+	proto.RegisterType((*LogRecord)(nil), "grpcapi.LogRecord")
+	proto.RegisterType((*NodeRequest)(nil), "grpcapi.NodeRequest")
+	proto.RegisterType((*NodeInfo)(nil), "grpcapi.NodeInfo")
+	proto.RegisterType((*PortRequest)(nil), "grpcapi.PortRequest")
+	proto.RegisterType((*PortInfo)(nil), "grpcapi.PortInfo")
+	proto.RegisterType((*PortModifyRequest)(nil), "grpcapi.PortModifyRequest")
+	proto.RegisterType((*PortFindResponse)(nil), "grpcapi.PortFindResponse")
+	proto.RegisterType((*PortGroupRequest)(nil), "grpcapi.PortGroupRequest")
+	proto.RegisterType((*PortGroupInfo)(nil), "grpcapi.PortGroupInfo")
+	proto.RegisterType((*AggrRequest)(nil), "grpcapi.AggrRequest")
+	proto.RegisterType((*AggrInfo)(nil), "grpcapi.AggrInfo")
+	proto.RegisterType((*JobRequest)(nil), "grpcapi.JobRequest")
+	proto.RegisterType((*JobInfo)(nil), "grpcapi.JobInfo")
+	proto.RegisterType((*WatchJobRequest)(nil), "grpcapi.WatchJobRequest")
+	proto.RegisterType((*VlanRequest)(nil), "grpcapi.VlanRequest")
+	proto.RegisterType((*VlanInfo)(nil), "grpcapi.VlanInfo")
+	proto.RegisterType((*IPSpaceRequest)(nil), "grpcapi.IPSpaceRequest")
+	proto.RegisterType((*IPSpaceInfo)(nil), "grpcapi.IPSpaceInfo")
+	proto.RegisterType((*BcDomainRequest)(nil), "grpcapi.BcDomainRequest")
+	proto.RegisterType((*BcDomainPortInfo)(nil), "grpcapi.BcDomainPortInfo")
+	proto.RegisterType((*BcDomainInfo)(nil), "grpcapi.BcDomainInfo")
+	proto.RegisterType((*SubnetRequest)(nil), "grpcapi.SubnetRequest")
+	proto.RegisterType((*SubnetInfo)(nil), "grpcapi.SubnetInfo")
+	proto.RegisterType((*SvmRequest)(nil), "grpcapi.SvmRequest")
+	proto.RegisterType((*SvmInfo)(nil), "grpcapi.SvmInfo")
+	proto.RegisterType((*SvmJobResult)(nil), "grpcapi.SvmJobResult")
+	proto.RegisterType((*VolumeRequest)(nil), "grpcapi.VolumeRequest")
+	proto.RegisterType((*VolumeInfo)(nil), "grpcapi.VolumeInfo")
+}
+
+func init() { proto.RegisterFile("grpcapi.proto", fileDescriptor_a7b78476b7b33751) }
+
+var fileDescriptor_a7b78476b7b33751 = []byte{
+	// 2518 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x39, 0x4b, 0x73, 0xdc, 0xc6,
+	0xd1, 0xb5, 0x8b, 0x7d, 0x60, 0x7b, 0x77, 0xf9, 0x00, 0x29, 0x0a, 0x5c, 0x95, 0x3f, 0xeb, 0x83,
+	0x93, 0x2a, 0xc5, 0x55, 0x91, 0x52, 0x52, 0x25, 0x56, 0xe4, 0x2a, 0x59, 0x7c, 0x59, 0x96, 0x62,
+	0xcb, 0x2c, 0x6c, 0xa4, 0x1c, 0xb7, 0x40, 0x60, 0xb8, 0x84, 0x03, 0x60, 0x26, 0xc0, 0x60, 0x29,
+	0xfa, 0x94, 0x6b, 0xae, 0xfe, 0x09, 0x49, 0x0e, 0xf9, 0x0d, 0xb9, 0x26, 0xa7, 0xfc, 0x8a, 0x9c,
+	0xf3, 0x0b, 0x72, 0x4b, 0xaa, 0xe7, 0x81, 0x07, 0xb9, 0x4b, 0x6a, 0x57, 0xae, 0x4a, 0x0e, 0xb9,
+	0xa1, 0x7b, 0xfa, 0x3d, 0x3d, 0x3d, 0xd3, 0x0d, 0x18, 0x4e, 0x53, 0xe6, 0x7b, 0x2c, 0xbc, 0xcf,
+	0x52, 0xca, 0xa9, 0xd5, 0x55, 0xa0, 0xf3, 0x08, 0xfa, 0x07, 0x5e, 0x14, 0xb9, 0xe4, 0x37, 0x39,
+	0xc9, 0xb8, 0xb5, 0x01, 0x86, 0x1f, 0x07, 0x76, 0xe3, 0x6e, 0xe3, 0x5e, 0xcf, 0xc5, 0x4f, 0xcb,
+	0x82, 0x56, 0xe0, 0x71, 0xcf, 0x6e, 0xde, 0x6d, 0xdc, 0x1b, 0xb8, 0xe2, 0xdb, 0xf9, 0x06, 0x06,
+	0x92, 0x29, 0x63, 0x34, 0xc9, 0x88, 0x65, 0x43, 0x37, 0xcb, 0x7d, 0x9f, 0x64, 0x99, 0xe0, 0x34,
+	0x5d, 0x0d, 0x5a, 0x3b, 0xd0, 0x21, 0x69, 0x1a, 0x67, 0x53, 0xc1, 0xdf, 0x73, 0x15, 0x54, 0x48,
+	0x35, 0x4a, 0xa9, 0xd6, 0x36, 0xb4, 0x49, 0x9a, 0x26, 0xd4, 0x6e, 0xdd, 0x6d, 0xdc, 0x6b, 0xbb,
+	0x12, 0x70, 0x9e, 0xc0, 0x60, 0xdf, 0xe3, 0xfe, 0x99, 0xb6, 0xf0, 0x63, 0x68, 0xfb, 0x5e, 0x14,
+	0xa1, 0x26, 0xe3, 0x5e, 0xff, 0xe1, 0xf6, 0x7d, 0xed, 0x58, 0xc5, 0x0d, 0x57, 0x92, 0x38, 0xcf,
+	0x60, 0xa8, 0x78, 0x95, 0xa1, 0x0f, 0xa0, 0x9b, 0x92, 0x2c, 0x8f, 0xb8, 0x66, 0xbf, 0x75, 0x89,
+	0x5d, 0xd2, 0xb9, 0x9a, 0xca, 0xf9, 0x31, 0xac, 0x8f, 0xcf, 0x72, 0x1e, 0xd0, 0xf3, 0x44, 0x1b,
+	0x30, 0x02, 0xd3, 0x8f, 0x42, 0x92, 0xf0, 0x50, 0xc7, 0xa9, 0x80, 0x9d, 0x8f, 0x61, 0xa3, 0x24,
+	0x57, 0x3a, 0x77, 0xa0, 0x23, 0xa5, 0xa9, 0xd8, 0x28, 0xc8, 0xd9, 0x80, 0xb5, 0x37, 0x24, 0xcd,
+	0x42, 0xaa, 0x25, 0x3b, 0x07, 0xb0, 0x5e, 0x60, 0x14, 0xf3, 0x36, 0xb4, 0x4f, 0xf2, 0x30, 0xd2,
+	0x9a, 0x24, 0x20, 0x4c, 0xa0, 0x71, 0xec, 0x25, 0x41, 0x66, 0x37, 0xef, 0x1a, 0xc2, 0x04, 0x05,
+	0x3b, 0xfb, 0x30, 0x3c, 0x8a, 0x19, 0xbf, 0x28, 0x44, 0xdc, 0x81, 0x5e, 0x42, 0x93, 0x09, 0x79,
+	0x1b, 0x66, 0xda, 0x04, 0x33, 0xa1, 0xc9, 0x11, 0xc2, 0x28, 0x3f, 0xc8, 0xe3, 0xf8, 0x42, 0x6c,
+	0x8f, 0xe1, 0x4a, 0xc0, 0x71, 0x00, 0xbe, 0xa4, 0x53, 0xed, 0xf0, 0x36, 0xb4, 0x23, 0x32, 0x23,
+	0x91, 0xb6, 0x41, 0x00, 0x4e, 0x08, 0x3d, 0x41, 0xe3, 0xd3, 0x34, 0x98, 0x4f, 0x82, 0x9b, 0x9c,
+	0x78, 0x31, 0x51, 0x5b, 0x2f, 0xbe, 0x31, 0x55, 0x62, 0x92, 0x65, 0xde, 0x94, 0x88, 0xbd, 0xef,
+	0xb9, 0x1a, 0xc4, 0x95, 0x8c, 0x64, 0xe8, 0xbd, 0x48, 0x80, 0x9e, 0xab, 0x41, 0xe7, 0xa7, 0xd0,
+	0x7f, 0x45, 0x03, 0xa2, 0xed, 0xd1, 0x62, 0x1b, 0x15, 0xb1, 0x16, 0xb4, 0xf2, 0x3c, 0x0c, 0xb4,
+	0x2a, 0xfc, 0x76, 0xfe, 0xd2, 0x00, 0x13, 0xf9, 0x5e, 0x24, 0xa7, 0xf4, 0xfa, 0x28, 0xcc, 0x33,
+	0x74, 0x07, 0x3a, 0x19, 0x49, 0x43, 0x2f, 0x52, 0x76, 0x2a, 0xc8, 0x5a, 0x83, 0x66, 0x18, 0x28,
+	0x0b, 0x9b, 0x61, 0x50, 0x68, 0x6e, 0x97, 0x9a, 0xd1, 0x95, 0x99, 0xdc, 0x48, 0xbb, 0x23, 0x5d,
+	0x51, 0x20, 0xae, 0x9c, 0x11, 0x2f, 0xe2, 0x67, 0x17, 0x76, 0x57, 0x9e, 0x14, 0x05, 0xa2, 0xbe,
+	0x9c, 0xf1, 0x30, 0x26, 0xb6, 0x29, 0xb6, 0x42, 0x41, 0xe8, 0xfc, 0x31, 0x4d, 0x79, 0xd5, 0x79,
+	0x1a, 0x94, 0xce, 0xd3, 0x40, 0x38, 0xcf, 0x68, 0xca, 0xb5, 0xf9, 0xf8, 0xed, 0xfc, 0xb9, 0x0d,
+	0x26, 0xf2, 0xbd, 0x9b, 0xf3, 0x28, 0xb1, 0x39, 0x47, 0xa2, 0x51, 0x4a, 0xb4, 0x7e, 0x00, 0x6b,
+	0x5e, 0xce, 0xe9, 0x24, 0x25, 0xb3, 0x49, 0x40, 0x22, 0xef, 0x42, 0x05, 0x61, 0x80, 0x58, 0x97,
+	0xcc, 0x0e, 0x11, 0x67, 0x7d, 0x08, 0xfd, 0x70, 0x9a, 0xa4, 0x13, 0xe9, 0x96, 0x8a, 0x0a, 0x20,
+	0xea, 0x0b, 0x81, 0xc1, 0x08, 0x84, 0x2c, 0x63, 0x9e, 0x4f, 0x74, 0x6c, 0x14, 0x88, 0x4a, 0x53,
+	0x1a, 0x11, 0x11, 0x98, 0x9e, 0x2b, 0xbe, 0xad, 0x5d, 0x30, 0xbd, 0x20, 0x0e, 0x93, 0x49, 0xce,
+	0x44, 0x5c, 0x7a, 0x6e, 0x57, 0xc0, 0xaf, 0x19, 0x3a, 0x25, 0x97, 0x62, 0x9e, 0xdb, 0x3d, 0x79,
+	0x10, 0x05, 0xe2, 0x2b, 0x9e, 0x5b, 0x1f, 0x00, 0xc8, 0x45, 0x34, 0xce, 0x06, 0xb1, 0x2a, 0xc9,
+	0xf7, 0x72, 0x4e, 0xd1, 0x4a, 0xb9, 0x9c, 0x31, 0x42, 0x02, 0xbb, 0x2f, 0xad, 0x14, 0xa8, 0x31,
+	0x62, 0xac, 0xff, 0x87, 0x81, 0x24, 0x08, 0x72, 0x16, 0x91, 0xb7, 0xf6, 0x40, 0x50, 0x48, 0xa6,
+	0x43, 0x81, 0x2a, 0x55, 0x9c, 0x46, 0xf4, 0xdc, 0x1e, 0x56, 0x54, 0x7c, 0x1e, 0xd1, 0x73, 0x91,
+	0x3f, 0xdc, 0xe3, 0x79, 0x66, 0xaf, 0xa9, 0xfc, 0x11, 0x10, 0xe2, 0x55, 0x6c, 0xd6, 0x25, 0x5e,
+	0x42, 0x58, 0x79, 0x63, 0xcf, 0xb7, 0x37, 0x64, 0xe5, 0x8d, 0x3d, 0xdf, 0xfa, 0x11, 0x6c, 0x9c,
+	0xa4, 0xd4, 0x0b, 0x7c, 0x2f, 0xe3, 0x93, 0x80, 0xc6, 0x5e, 0x98, 0xd8, 0x9b, 0x62, 0x79, 0xbd,
+	0xc0, 0x1f, 0x0a, 0xb4, 0x60, 0xe6, 0xb9, 0x6d, 0x29, 0x66, 0x9e, 0x63, 0x30, 0x85, 0xeb, 0x5b,
+	0x32, 0x98, 0xf8, 0x8d, 0xa7, 0x54, 0xfa, 0xbb, 0x2d, 0x4f, 0xa9, 0x00, 0xd0, 0x20, 0xe5, 0xe4,
+	0x2d, 0x69, 0x90, 0x84, 0x50, 0x82, 0xf0, 0x6c, 0x47, 0x4a, 0xc0, 0x6f, 0xc4, 0xf1, 0x0b, 0x46,
+	0xec, 0xdb, 0x12, 0x87, 0xdf, 0xd6, 0x6d, 0xe8, 0xce, 0x22, 0x2f, 0x99, 0x84, 0x81, 0x6d, 0x4b,
+	0x01, 0x08, 0xbe, 0x08, 0x70, 0x83, 0xc4, 0x82, 0xc8, 0xae, 0x5d, 0xb9, 0x41, 0x88, 0xc0, 0x33,
+	0x59, 0x2c, 0x8a, 0x34, 0x1b, 0x95, 0x8b, 0x98, 0xb3, 0xce, 0x9f, 0x9a, 0xb0, 0x89, 0x1f, 0x5f,
+	0xd1, 0x20, 0x3c, 0xbd, 0x58, 0x32, 0xf5, 0xf1, 0x84, 0xe6, 0x4c, 0xa5, 0x6e, 0x33, 0x67, 0x3a,
+	0x38, 0xad, 0xab, 0xc1, 0x69, 0x57, 0x82, 0x53, 0x86, 0xa1, 0x33, 0x37, 0x0c, 0xdd, 0x4a, 0x18,
+	0x8a, 0x40, 0x9a, 0xd5, 0x40, 0x5e, 0x3d, 0x20, 0xbd, 0x9b, 0x0f, 0x08, 0x5c, 0x77, 0x40, 0xfa,
+	0xf3, 0x0f, 0xc8, 0xa0, 0x3c, 0x20, 0xce, 0x11, 0x6c, 0x60, 0xa4, 0x3e, 0x0f, 0x93, 0xe0, 0x9d,
+	0x2b, 0x3e, 0x46, 0x49, 0x5f, 0x1c, 0x12, 0x70, 0xde, 0x4a, 0x31, 0xcf, 0x53, 0x9a, 0xb3, 0x1b,
+	0xe2, 0x7d, 0xa5, 0x52, 0x5a, 0xd0, 0x8a, 0x91, 0x4e, 0x15, 0x8b, 0x58, 0xd1, 0x05, 0xa8, 0x5d,
+	0x06, 0xbd, 0x15, 0xd4, 0x34, 0xb7, 0xab, 0x9a, 0xff, 0xde, 0x80, 0x61, 0xa1, 0x7a, 0xe5, 0x6a,
+	0x25, 0x8c, 0x32, 0xe6, 0x18, 0xd5, 0x9a, 0x63, 0x54, 0x7b, 0x9e, 0x51, 0x9d, 0x8a, 0x51, 0x48,
+	0xc9, 0xbc, 0x94, 0xeb, 0x4d, 0xc7, 0x6f, 0x3c, 0xef, 0x62, 0x71, 0x82, 0xb7, 0xbb, 0x6d, 0x0a,
+	0xf2, 0x9e, 0xc0, 0x1c, 0xd2, 0xf3, 0x04, 0x2b, 0x95, 0x5c, 0xce, 0x99, 0xdd, 0x13, 0x8b, 0x5d,
+	0x01, 0xbf, 0x66, 0xce, 0x2f, 0xa0, 0xbf, 0x37, 0x9d, 0xa6, 0x4b, 0xde, 0x5f, 0x68, 0x1a, 0xba,
+	0x97, 0xd9, 0x86, 0x34, 0x4d, 0x00, 0xce, 0xdf, 0x9a, 0x60, 0xa2, 0xb4, 0xd5, 0x6e, 0x35, 0xad,
+	0xc7, 0x98, 0xa7, 0xa7, 0x55, 0xd1, 0x83, 0x79, 0x7a, 0x1a, 0x91, 0xb7, 0x33, 0x1a, 0x4d, 0xfc,
+	0x44, 0xc6, 0xcc, 0x70, 0x41, 0xa1, 0x0e, 0x12, 0x6e, 0xdd, 0x85, 0x01, 0xf3, 0xf9, 0x24, 0xcf,
+	0x48, 0x30, 0xf1, 0x3d, 0x26, 0x8e, 0x8d, 0xe1, 0x02, 0xf3, 0xf9, 0xeb, 0x8c, 0x04, 0x07, 0x1e,
+	0xb3, 0x1c, 0x18, 0x16, 0x14, 0xec, 0xec, 0x22, 0x13, 0xe1, 0x34, 0xdc, 0xbe, 0x22, 0x39, 0x3e,
+	0xbb, 0xc8, 0x30, 0xaa, 0x59, 0xf8, 0x2d, 0x99, 0x70, 0xca, 0xbd, 0x48, 0x5d, 0x7d, 0x3d, 0xc4,
+	0xfc, 0x12, 0x11, 0xe8, 0xa0, 0x58, 0x46, 0x19, 0xe2, 0x38, 0x19, 0xae, 0x89, 0x08, 0xe4, 0x2f,
+	0x78, 0xbd, 0x99, 0x17, 0x46, 0x36, 0x94, 0xbc, 0x7b, 0x88, 0xc0, 0x1a, 0x2e, 0x96, 0x53, 0x92,
+	0x91, 0x74, 0x26, 0x4f, 0x93, 0xe1, 0xf6, 0x11, 0xe7, 0x4a, 0x94, 0x73, 0x1f, 0xe0, 0x25, 0x3d,
+	0xd1, 0x1b, 0x23, 0xaf, 0xf6, 0x86, 0x20, 0xc3, 0xab, 0x7d, 0x03, 0x8c, 0x6c, 0x16, 0xab, 0xf8,
+	0xe1, 0xa7, 0xf3, 0xc7, 0x06, 0x74, 0x5f, 0xd2, 0x93, 0x9b, 0x63, 0x2f, 0x45, 0x35, 0x2f, 0x8b,
+	0x32, 0x0a, 0x51, 0x88, 0xc1, 0x67, 0xb1, 0xae, 0x4a, 0xd9, 0xb4, 0x72, 0x63, 0xb4, 0x6b, 0x37,
+	0x46, 0xf1, 0x2e, 0x96, 0x11, 0x96, 0x00, 0xbe, 0x01, 0x59, 0x4a, 0xa7, 0x29, 0xc9, 0x64, 0x5c,
+	0x7b, 0x6e, 0x01, 0x3b, 0x8f, 0x60, 0xfd, 0x57, 0xf8, 0xee, 0x5d, 0xca, 0xb7, 0x00, 0xfa, 0x6f,
+	0x22, 0xaf, 0x78, 0xe6, 0x0a, 0xf7, 0x02, 0x32, 0xa9, 0xa4, 0xaa, 0x89, 0x88, 0x57, 0x98, 0x46,
+	0x1f, 0x42, 0x9f, 0x79, 0x29, 0x49, 0xf8, 0xa4, 0x92, 0x61, 0x20, 0x51, 0x82, 0xa0, 0x72, 0x29,
+	0x18, 0xd5, 0x4b, 0xc1, 0xf9, 0xae, 0x01, 0x26, 0xaa, 0xb9, 0x39, 0x84, 0x35, 0x03, 0x9a, 0xd7,
+	0x1b, 0x60, 0x5c, 0x67, 0x40, 0xab, 0x76, 0x2b, 0xe9, 0x53, 0xd1, 0x2e, 0x4f, 0x85, 0x33, 0x86,
+	0xb5, 0x17, 0xc7, 0x63, 0xac, 0xb1, 0xcb, 0x9e, 0xd1, 0x5d, 0x30, 0x13, 0x72, 0x5e, 0x35, 0xa2,
+	0x9b, 0x90, 0x73, 0xb4, 0xc0, 0xf9, 0x43, 0x03, 0xfa, 0x4a, 0xea, 0xf7, 0x77, 0x56, 0x3f, 0x00,
+	0x38, 0xf1, 0xd5, 0x63, 0x40, 0x1f, 0xd8, 0xde, 0x89, 0x2f, 0x9f, 0x01, 0xd9, 0xfc, 0x12, 0x8b,
+	0xa9, 0x32, 0x13, 0xf9, 0x9e, 0xea, 0x32, 0x57, 0xc0, 0xce, 0xef, 0x1b, 0xb0, 0xbe, 0xaf, 0xf8,
+	0xaf, 0x73, 0xbe, 0xea, 0x68, 0xb3, 0xe6, 0xa8, 0xbe, 0x5f, 0x8d, 0xf2, 0x7e, 0xad, 0x5c, 0x61,
+	0xad, 0xfa, 0x15, 0x36, 0xdf, 0xc0, 0xff, 0x03, 0x90, 0xb9, 0x4e, 0x93, 0xe8, 0x42, 0xdd, 0xbf,
+	0x15, 0x8c, 0xc3, 0x60, 0x43, 0xdb, 0x58, 0xbc, 0x69, 0xe7, 0x19, 0xf9, 0x11, 0x0c, 0x73, 0x16,
+	0x78, 0x9c, 0x4c, 0xd4, 0x41, 0x92, 0x96, 0x0e, 0x24, 0x72, 0x2c, 0x70, 0x48, 0x24, 0x57, 0x27,
+	0x01, 0xe1, 0x58, 0x38, 0xa4, 0xe1, 0x03, 0x89, 0x3c, 0x14, 0x38, 0xe7, 0x5f, 0x0d, 0x18, 0x68,
+	0x95, 0xab, 0xed, 0x9e, 0x03, 0x83, 0x53, 0x2f, 0x8c, 0xe8, 0x8c, 0xa4, 0xd3, 0x94, 0xe9, 0x22,
+	0x5e, 0xc3, 0x5d, 0x13, 0x27, 0x15, 0xd3, 0x76, 0x19, 0xd3, 0x2b, 0xbe, 0x75, 0xe6, 0xf8, 0xf6,
+	0x40, 0x87, 0xb7, 0x2b, 0xba, 0xdb, 0xdd, 0xa2, 0xbb, 0xbd, 0x1c, 0x3e, 0x1d, 0x79, 0xd1, 0xb9,
+	0x9f, 0x24, 0x84, 0x67, 0xea, 0x46, 0xd3, 0xa0, 0xf3, 0xd7, 0x06, 0x0c, 0xc7, 0xe2, 0x7b, 0xc5,
+	0xb4, 0xb8, 0x03, 0xbd, 0x22, 0x55, 0x55, 0x8c, 0x4d, 0x9d, 0xa9, 0xd7, 0x78, 0x8e, 0x55, 0x50,
+	0xa8, 0x2d, 0xaa, 0xa0, 0x80, 0x90, 0x63, 0xea, 0x71, 0x72, 0xee, 0xe9, 0x04, 0xd1, 0x20, 0x2a,
+	0x0a, 0xd9, 0x24, 0xf5, 0x92, 0x29, 0x91, 0x8e, 0xf7, 0x5c, 0x33, 0x64, 0xae, 0x80, 0x9d, 0xef,
+	0x9a, 0x00, 0xd2, 0x8d, 0xd5, 0xb6, 0xf1, 0xbf, 0xc2, 0x0b, 0x0c, 0x73, 0xc8, 0x26, 0x3e, 0xcd,
+	0x13, 0xae, 0xee, 0xc8, 0x6e, 0xc8, 0x0e, 0x10, 0xc4, 0x42, 0x17, 0xb2, 0xea, 0xfd, 0xd8, 0x09,
+	0x99, 0xb8, 0x1d, 0x25, 0x4f, 0xf5, 0x6e, 0xec, 0x86, 0x4c, 0xdc, 0x8c, 0xce, 0x6f, 0x31, 0x28,
+	0xb3, 0x78, 0xc5, 0x8d, 0xdd, 0x86, 0xf6, 0x29, 0x4d, 0x7d, 0x5d, 0xf0, 0x24, 0x50, 0x54, 0xab,
+	0x56, 0xbd, 0x0f, 0xd6, 0xf1, 0x69, 0xd7, 0xe3, 0x73, 0x07, 0x7a, 0x29, 0xa5, 0x7c, 0xe2, 0x4d,
+	0xa7, 0xa9, 0x8a, 0x84, 0x89, 0x08, 0x7c, 0xd9, 0xe0, 0x43, 0x5a, 0x2c, 0x66, 0xc4, 0x9f, 0x64,
+	0xfc, 0xa2, 0x68, 0x09, 0x07, 0x88, 0x1d, 0x13, 0x7f, 0x8c, 0xb8, 0x42, 0x84, 0x30, 0xd1, 0x2c,
+	0x45, 0xe8, 0xfb, 0x41, 0x2c, 0xa6, 0x84, 0x93, 0x84, 0xab, 0x87, 0x38, 0x20, 0xca, 0x15, 0x18,
+	0xe7, 0x77, 0x06, 0x74, 0xc7, 0xb3, 0xf8, 0xfb, 0xab, 0xcc, 0x8b, 0x73, 0xa1, 0xe6, 0x6b, 0xfb,
+	0x46, 0x5f, 0x3b, 0x37, 0xf9, 0xda, 0xbd, 0xde, 0x57, 0xf3, 0xb2, 0xaf, 0x98, 0x8c, 0x11, 0xf5,
+	0x7f, 0xad, 0x32, 0xc4, 0x74, 0x15, 0x84, 0x97, 0x09, 0x65, 0x24, 0x15, 0x05, 0x85, 0xe8, 0x26,
+	0x19, 0x31, 0x58, 0x4d, 0x84, 0xd2, 0x6c, 0x16, 0xab, 0x55, 0xd9, 0x8a, 0x98, 0xd9, 0x2c, 0x96,
+	0x8b, 0x1f, 0xc1, 0x50, 0x4c, 0x12, 0x27, 0x24, 0xf1, 0x4e, 0x22, 0x12, 0xd8, 0x03, 0x59, 0xdf,
+	0x04, 0xf2, 0x48, 0xe2, 0xac, 0x1f, 0xc2, 0x9a, 0x24, 0x0a, 0x13, 0xcf, 0xe7, 0xe1, 0x8c, 0xd8,
+	0x43, 0x41, 0x25, 0x59, 0x5f, 0x28, 0xa4, 0xf3, 0x0f, 0x03, 0x06, 0xe3, 0x59, 0x2c, 0x5e, 0x2b,
+	0x38, 0x1a, 0xfb, 0xdf, 0x86, 0xfc, 0x67, 0x37, 0x64, 0xe1, 0xec, 0x62, 0x1b, 0xda, 0xdf, 0xd0,
+	0x93, 0x30, 0x10, 0xa3, 0x0b, 0xc3, 0x95, 0x40, 0xf9, 0x3e, 0xdd, 0xa8, 0xbe, 0x4f, 0xcb, 0xc9,
+	0xef, 0x66, 0x75, 0xf2, 0xeb, 0xb8, 0x30, 0x7c, 0x43, 0xa3, 0x3c, 0x2e, 0x9e, 0x5a, 0xbb, 0x80,
+	0x4e, 0x54, 0xdf, 0x99, 0xdd, 0x6c, 0x16, 0xbf, 0x52, 0xdb, 0x3a, 0x6f, 0xab, 0xf1, 0x05, 0xaf,
+	0xb7, 0x1a, 0xbf, 0x9d, 0x08, 0x40, 0xca, 0xbc, 0xf9, 0x38, 0x57, 0xb5, 0x35, 0xe7, 0x6b, 0x33,
+	0xe6, 0x68, 0x6b, 0x95, 0xda, 0x1e, 0xfe, 0xd3, 0x86, 0xe1, 0x73, 0xf7, 0xf8, 0xe0, 0x15, 0xe1,
+	0x7b, 0x8c, 0xed, 0xb1, 0xd0, 0x7a, 0x04, 0x2d, 0x1c, 0x1f, 0x5b, 0x73, 0x87, 0xd1, 0xa3, 0xf9,
+	0x33, 0x66, 0xeb, 0x09, 0xf4, 0x10, 0x16, 0x03, 0x6a, 0xab, 0xa4, 0xa9, 0x0e, 0xbb, 0x47, 0x3b,
+	0x97, 0xd1, 0x8a, 0xf7, 0x33, 0x30, 0xf5, 0x9c, 0xd9, 0xb2, 0x0b, 0x9a, 0x4b, 0x93, 0xea, 0xd1,
+	0xee, 0x9c, 0x95, 0x42, 0x00, 0x3c, 0x27, 0x5c, 0x4d, 0x9b, 0xad, 0xdb, 0x05, 0x61, 0x7d, 0x22,
+	0x3d, 0xb2, 0xaf, 0x2e, 0x28, 0x01, 0x9f, 0x00, 0x8c, 0x79, 0x4a, 0xbc, 0xf8, 0x4b, 0x3a, 0xcd,
+	0xac, 0xad, 0x82, 0xae, 0x9c, 0x1b, 0x8f, 0xac, 0x3a, 0x12, 0x07, 0xc5, 0x3f, 0x69, 0x58, 0x0f,
+	0xa1, 0x8b, 0x03, 0xa0, 0xe7, 0x84, 0x57, 0xc2, 0x55, 0x19, 0xef, 0x8e, 0x36, 0x6b, 0x58, 0xb1,
+	0xa3, 0x0f, 0xa1, 0x2b, 0x46, 0x04, 0x35, 0x9e, 0xca, 0x54, 0x74, 0xb4, 0x59, 0xc3, 0x0a, 0x9e,
+	0x67, 0x00, 0xe5, 0x08, 0xc9, 0x1a, 0xd5, 0x08, 0x6a, 0x73, 0xa5, 0x4a, 0x90, 0xeb, 0x83, 0xf3,
+	0x7d, 0x58, 0xd7, 0xa3, 0x95, 0x63, 0x8f, 0x73, 0x92, 0x26, 0x0b, 0xb4, 0xef, 0xd6, 0xb0, 0xb5,
+	0x51, 0xcc, 0x1e, 0x0c, 0x8a, 0xe1, 0x06, 0x9a, 0x5f, 0x27, 0xad, 0x8e, 0x5b, 0x46, 0x3b, 0x57,
+	0x97, 0x84, 0x23, 0x87, 0xb0, 0x5e, 0x20, 0x0e, 0x52, 0x82, 0x67, 0xfd, 0x9d, 0xa4, 0xd4, 0x9d,
+	0x39, 0xaa, 0x0c, 0x78, 0xf0, 0x63, 0x2f, 0x08, 0x56, 0x11, 0xf3, 0x05, 0x6c, 0xd5, 0xc4, 0xb8,
+	0x24, 0xa6, 0xb3, 0x95, 0x0c, 0xaa, 0xba, 0x75, 0x48, 0x22, 0xb2, 0x9a, 0x5b, 0x0f, 0xa1, 0x8b,
+	0x55, 0xbb, 0x9e, 0x19, 0x95, 0x61, 0xcb, 0x68, 0xb3, 0x86, 0x15, 0x01, 0x7d, 0x00, 0x9d, 0x97,
+	0xf4, 0x04, 0x59, 0xca, 0xb4, 0x2d, 0x3b, 0xe5, 0xd1, 0x46, 0x15, 0x29, 0x18, 0x1e, 0x83, 0xa9,
+	0xdb, 0xe9, 0xca, 0x69, 0xbb, 0xd4, 0x61, 0x5f, 0xe5, 0x93, 0xc9, 0x8e, 0xcd, 0x6e, 0xdd, 0xbc,
+	0x4a, 0x97, 0x3d, 0xda, 0xac, 0x61, 0x85, 0xb6, 0x27, 0x00, 0xf8, 0xad, 0xb6, 0x7a, 0x3e, 0xdb,
+	0xa2, 0x70, 0x28, 0x5e, 0x15, 0xcf, 0xe5, 0x78, 0x3f, 0x05, 0x50, 0xed, 0x2a, 0x9a, 0x5b, 0x96,
+	0x84, 0x7a, 0x67, 0x3c, 0xda, 0xbe, 0xbc, 0x20, 0x8c, 0x7e, 0x0a, 0x43, 0x05, 0x2a, 0xbb, 0x97,
+	0xe4, 0x7f, 0x56, 0xf0, 0xbf, 0x66, 0xc1, 0xb5, 0xfc, 0x8b, 0xcc, 0x2f, 0x25, 0x28, 0xef, 0x97,
+	0x96, 0xf0, 0x14, 0xfa, 0xba, 0x4d, 0xc2, 0x08, 0xd8, 0x57, 0x9a, 0xa7, 0xab, 0x05, 0xbd, 0xd6,
+	0x22, 0xee, 0xc1, 0x9a, 0x86, 0x55, 0x3b, 0xf6, 0x3e, 0x22, 0x54, 0x1c, 0x97, 0x16, 0xb1, 0x5f,
+	0x8a, 0x70, 0x89, 0xfc, 0xe5, 0xb6, 0x50, 0xc4, 0x35, 0x95, 0xaf, 0xda, 0x30, 0x62, 0xad, 0x58,
+	0xda, 0x8e, 0x23, 0xb0, 0xaa, 0x32, 0x54, 0xa1, 0x78, 0x9f, 0x88, 0xa8, 0xcc, 0x78, 0x1f, 0x11,
+	0x2a, 0x35, 0x96, 0x16, 0xf1, 0x18, 0x7a, 0xb2, 0x89, 0xc4, 0xc4, 0x28, 0xa3, 0x56, 0xeb, 0x8f,
+	0x47, 0x5b, 0x97, 0xf0, 0x82, 0xf3, 0x53, 0x18, 0x48, 0x48, 0xed, 0xe7, 0x52, 0xcc, 0x4f, 0x35,
+	0xb3, 0xb2, 0x7b, 0x11, 0xf3, 0xe2, 0x8c, 0x1e, 0x68, 0x42, 0xf9, 0x4f, 0x73, 0x49, 0xfe, 0x7d,
+	0xd8, 0x50, 0xd6, 0x1c, 0x8b, 0x46, 0x14, 0x13, 0x61, 0x59, 0x19, 0x47, 0xb0, 0x55, 0x93, 0xa1,
+	0x12, 0x61, 0x65, 0x57, 0xd4, 0x85, 0xbe, 0x2c, 0xff, 0x03, 0xe8, 0x8c, 0x67, 0x71, 0xbd, 0xe8,
+	0x97, 0x2d, 0x70, 0xa5, 0x78, 0xeb, 0xa6, 0xf0, 0x13, 0xe8, 0x8d, 0x67, 0xb1, 0xda, 0xb5, 0xb9,
+	0x3c, 0xb7, 0xaa, 0xc8, 0xb2, 0x79, 0x91, 0x8c, 0x6a, 0xc7, 0x96, 0x63, 0x34, 0xc7, 0xe2, 0x35,
+	0x9f, 0x2e, 0x30, 0x72, 0x91, 0x6f, 0x3f, 0x13, 0xad, 0xec, 0x98, 0x53, 0xb6, 0x1c, 0xdf, 0x63,
+	0x61, 0xe9, 0xeb, 0x04, 0xbb, 0x8f, 0x55, 0x38, 0x55, 0x56, 0x2d, 0xc5, 0xf9, 0x14, 0x06, 0xf2,
+	0xa9, 0xfe, 0x75, 0x12, 0x85, 0x49, 0x35, 0x0f, 0x6a, 0x5d, 0xc1, 0x42, 0xfe, 0xcf, 0x74, 0xfb,
+	0xf0, 0xf5, 0xe9, 0xe9, 0x4a, 0x02, 0x9e, 0xc1, 0x9a, 0x26, 0xcc, 0x78, 0x1a, 0xfa, 0x7c, 0x69,
+	0x09, 0x85, 0x0b, 0x57, 0x4e, 0xe5, 0xbb, 0xf1, 0xff, 0x5c, 0x77, 0x2b, 0xe3, 0xf0, 0xdb, 0xc5,
+	0xdc, 0x5b, 0x97, 0xf0, 0x98, 0x94, 0x27, 0x1d, 0xd1, 0xa7, 0x3d, 0xfa, 0xf7, 0x00, 0xd2, 0xfc,
+	0x3e, 0x01, 0xce, 0x23, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// GRPCNetAppApiClient is the client API for GRPCNetAppApi service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GRPCNetAppApiClient interface {
+	// generic JSON call, used for commands without typed RPC
+	Call(ctx context.Context, in *CallRequest, opts ...grpc.CallOption) (*CallResponse, error)
+	// generic JSON calls in one round trip, e.g. the reads of a refresh
+	CallBatch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	Shutdown(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*ShutdownResponse, error)
+	// build and supported commands of the API server
+	GetVersion(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
+	// pushes the log records of the API server until the client cancels
+	StreamLogs(ctx context.Context, in *LogRequest, opts ...grpc.CallOption) (GRPCNetAppApi_StreamLogsClient, error)
+	// SYS.NODE.GET
+	NodeGet(ctx context.Context, in *NodeRequest, opts ...grpc.CallOption) (*NodeInfo, error)
+	// SYS.PORT.GET
+	PortGet(ctx context.Context, in *PortRequest, opts ...grpc.CallOption) (*PortInfo, error)
+	// SYS.PORT.MODIFY
+	PortModify(ctx context.Context, in *PortModifyRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// SYS.PORT.FIND.PATTERN
+	PortFindPattern(ctx context.Context, in *PortRequest, opts ...grpc.CallOption) (*PortFindResponse, error)
+	// SYS.PORTGROUP.GET
+	PortGroupGet(ctx context.Context, in *PortGroupRequest, opts ...grpc.CallOption) (*PortGroupInfo, error)
+	// SYS.PORTGROUP.CREATE
+	PortGroupCreate(ctx context.Context, in *PortGroupRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// SYS.PORTGROUP.PORT.ADD
+	PortGroupPortAdd(ctx context.Context, in *PortGroupRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// SYS.PORTGROUP.PORT.REMOVE
+	PortGroupPortRemove(ctx context.Context, in *PortGroupRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// SYS.PORTGROUP.DELETE
+	PortGroupDelete(ctx context.Context, in *PortGroupRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// SYS.AGGR.GET
+	AggrGet(ctx context.Context, in *AggrRequest, opts ...grpc.CallOption) (*AggrInfo, error)
+	// SYS.JOB.GET
+	JobGet(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*JobInfo, error)
+	// pushes the job info on every job state or progress change, the
+	// stream ends once the job ended
+	WatchJob(ctx context.Context, in *WatchJobRequest, opts ...grpc.CallOption) (GRPCNetAppApi_WatchJobClient, error)
+	// NW.VLAN.GET
+	VlanGet(ctx context.Context, in *VlanRequest, opts ...grpc.CallOption) (*VlanInfo, error)
+	// NW.VLAN.CREATE
+	VlanCreate(ctx context.Context, in *VlanRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// NW.VLAN.DELETE
+	VlanDelete(ctx context.Context, in *VlanRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// NW.IPSPACE.GET
+	IPSpaceGet(ctx context.Context, in *IPSpaceRequest, opts ...grpc.CallOption) (*IPSpaceInfo, error)
+	// NW.IPSPACE.CREATE
+	IPSpaceCreate(ctx context.Context, in *IPSpaceRequest, opts ...grpc.CallOption) (*IPSpaceInfo, error)
+	// NW.IPSPACE.UPDATE
+	IPSpaceUpdate(ctx context.Context, in *IPSpaceRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// NW.IPSPACE.DELETE
+	IPSpaceDelete(ctx context.Context, in *IPSpaceRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// NW.BRCDOM.GET
+	BcDomainGet(ctx context.Context, in *BcDomainRequest, opts ...grpc.CallOption) (*BcDomainInfo, error)
+	// NW.BRCDOM.STATUS
+	BcDomainStatus(ctx context.Context, in *BcDomainRequest, opts ...grpc.CallOption) (*BcDomainInfo, error)
+	// NW.BRCDOM.CREATE
+	BcDomainCreate(ctx context.Context, in *BcDomainRequest, opts ...grpc.CallOption) (*BcDomainInfo, error)
+	// NW.BRCDOM.RENAME
+	BcDomainRename(ctx context.Context, in *BcDomainRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// NW.BRCDOM.PORT.ADD
+	BcDomainPortAdd(ctx context.Context, in *BcDomainRequest, opts ...grpc.CallOption) (*BcDomainInfo, error)
+	// NW.BRCDOM.PORT.REMOVE
+	BcDomainPortRemove(ctx context.Context, in *BcDomainRequest, opts ...grpc.CallOption) (*BcDomainInfo, error)
+	// NW.BRCDOM.UPDATE
+	BcDomainUpdate(ctx context.Context, in *BcDomainRequest, opts ...grpc.CallOption) (*BcDomainInfo, error)
+	// NW.BRCDOM.DELETE
+	BcDomainDelete(ctx context.Context, in *BcDomainRequest, opts ...grpc.CallOption) (*BcDomainInfo, error)
+	// NW.SUBNET.GET
+	SubnetGet(ctx context.Context, in *SubnetRequest, opts ...grpc.CallOption) (*SubnetInfo, error)
+	// NW.SUBNET.CREATE
+	SubnetCreate(ctx context.Context, in *SubnetRequest, opts ...grpc.CallOption) (*SubnetInfo, error)
+	// NW.SUBNET.DELETE
+	SubnetDelete(ctx context.Context, in *SubnetRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// NW.SUBNET.RENAME
+	SubnetRename(ctx context.Context, in *SubnetRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// NW.SUBNET.IPR.ADD
+	SubnetIPRangeAdd(ctx context.Context, in *SubnetRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// NW.SUBNET.IPR.REMOVE
+	SubnetIPRangeRemove(ctx context.Context, in *SubnetRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// NW.SUBNET.MODIFY
+	SubnetModify(ctx context.Context, in *SubnetRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// SVM.GET
+	SvmGet(ctx context.Context, in *SvmRequest, opts ...grpc.CallOption) (*SvmInfo, error)
+	// SVM.CREATE
+	SvmCreate(ctx context.Context, in *SvmRequest, opts ...grpc.CallOption) (*SvmJobResult, error)
+	// SVM.DELETE
+	SvmDelete(ctx context.Context, in *SvmRequest, opts ...grpc.CallOption) (*SvmJobResult, error)
+	// SVM.START
+	SvmStart(ctx context.Context, in *SvmRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// SVM.STOP
+	SvmStop(ctx context.Context, in *SvmRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// SVM.UNLOCK
+	SvmUnlock(ctx context.Context, in *SvmRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// SVM.RENAME
+	SvmRename(ctx context.Context, in *SvmRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// SVM.VOL.ONLINE
+	VolumeOnline(ctx context.Context, in *VolumeRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// SVM.VOL.OFFLINE
+	VolumeOffline(ctx context.Context, in *VolumeRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// SVM.VOL.RESTRICT
+	VolumeRestrict(ctx context.Context, in *VolumeRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// SVM.VOL.DELETE
+	VolumeDelete(ctx context.Context, in *VolumeRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// SVM.VOL.SIZE
+	VolumeSize(ctx context.Context, in *VolumeRequest, opts ...grpc.CallOption) (*VolumeInfo, error)
+}
+
+type gRPCNetAppApiClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGRPCNetAppApiClient(cc *grpc.ClientConn) GRPCNetAppApiClient {
+	return &gRPCNetAppApiClient{cc}
+}
+
+func (c *gRPCNetAppApiClient) Call(ctx context.Context, in *CallRequest, opts ...grpc.CallOption) (*CallResponse, error) {
+	out := new(CallResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/Call", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) CallBatch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/CallBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) Shutdown(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*ShutdownResponse, error) {
+	out := new(ShutdownResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/Shutdown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) GetVersion(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error) {
+	out := new(VersionResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/GetVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) StreamLogs(ctx context.Context, in *LogRequest, opts ...grpc.CallOption) (GRPCNetAppApi_StreamLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GRPCNetAppApi_serviceDesc.Streams[0], "/grpcapi.GRPCNetAppApi/StreamLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gRPCNetAppApiStreamLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GRPCNetAppApi_StreamLogsClient interface {
+	Recv() (*LogRecord, error)
+	grpc.ClientStream
+}
+
+type gRPCNetAppApiStreamLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *gRPCNetAppApiStreamLogsClient) Recv() (*LogRecord, error) {
+	m := new(LogRecord)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gRPCNetAppApiClient) NodeGet(ctx context.Context, in *NodeRequest, opts ...grpc.CallOption) (*NodeInfo, error) {
+	out := new(NodeInfo)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/NodeGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) PortGet(ctx context.Context, in *PortRequest, opts ...grpc.CallOption) (*PortInfo, error) {
+	out := new(PortInfo)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/PortGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) PortModify(ctx context.Context, in *PortModifyRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/PortModify", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) PortFindPattern(ctx context.Context, in *PortRequest, opts ...grpc.CallOption) (*PortFindResponse, error) {
+	out := new(PortFindResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/PortFindPattern", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) PortGroupGet(ctx context.Context, in *PortGroupRequest, opts ...grpc.CallOption) (*PortGroupInfo, error) {
+	out := new(PortGroupInfo)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/PortGroupGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) PortGroupCreate(ctx context.Context, in *PortGroupRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/PortGroupCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) PortGroupPortAdd(ctx context.Context, in *PortGroupRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/PortGroupPortAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) PortGroupPortRemove(ctx context.Context, in *PortGroupRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/PortGroupPortRemove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) PortGroupDelete(ctx context.Context, in *PortGroupRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/PortGroupDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) AggrGet(ctx context.Context, in *AggrRequest, opts ...grpc.CallOption) (*AggrInfo, error) {
+	out := new(AggrInfo)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/AggrGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) JobGet(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*JobInfo, error) {
+	out := new(JobInfo)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/JobGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) WatchJob(ctx context.Context, in *WatchJobRequest, opts ...grpc.CallOption) (GRPCNetAppApi_WatchJobClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GRPCNetAppApi_serviceDesc.Streams[1], "/grpcapi.GRPCNetAppApi/WatchJob", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gRPCNetAppApiWatchJobClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GRPCNetAppApi_WatchJobClient interface {
+	Recv() (*JobInfo, error)
+	grpc.ClientStream
+}
+
+type gRPCNetAppApiWatchJobClient struct {
+	grpc.ClientStream
+}
+
+func (x *gRPCNetAppApiWatchJobClient) Recv() (*JobInfo, error) {
+	m := new(JobInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gRPCNetAppApiClient) VlanGet(ctx context.Context, in *VlanRequest, opts ...grpc.CallOption) (*VlanInfo, error) {
+	out := new(VlanInfo)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/VlanGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) VlanCreate(ctx context.Context, in *VlanRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/VlanCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) VlanDelete(ctx context.Context, in *VlanRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/VlanDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) IPSpaceGet(ctx context.Context, in *IPSpaceRequest, opts ...grpc.CallOption) (*IPSpaceInfo, error) {
+	out := new(IPSpaceInfo)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/IPSpaceGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) IPSpaceCreate(ctx context.Context, in *IPSpaceRequest, opts ...grpc.CallOption) (*IPSpaceInfo, error) {
+	out := new(IPSpaceInfo)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/IPSpaceCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) IPSpaceUpdate(ctx context.Context, in *IPSpaceRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/IPSpaceUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) IPSpaceDelete(ctx context.Context, in *IPSpaceRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/IPSpaceDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) BcDomainGet(ctx context.Context, in *BcDomainRequest, opts ...grpc.CallOption) (*BcDomainInfo, error) {
+	out := new(BcDomainInfo)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/BcDomainGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) BcDomainStatus(ctx context.Context, in *BcDomainRequest, opts ...grpc.CallOption) (*BcDomainInfo, error) {
+	out := new(BcDomainInfo)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/BcDomainStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) BcDomainCreate(ctx context.Context, in *BcDomainRequest, opts ...grpc.CallOption) (*BcDomainInfo, error) {
+	out := new(BcDomainInfo)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/BcDomainCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) BcDomainRename(ctx context.Context, in *BcDomainRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/BcDomainRename", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) BcDomainPortAdd(ctx context.Context, in *BcDomainRequest, opts ...grpc.CallOption) (*BcDomainInfo, error) {
+	out := new(BcDomainInfo)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/BcDomainPortAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) BcDomainPortRemove(ctx context.Context, in *BcDomainRequest, opts ...grpc.CallOption) (*BcDomainInfo, error) {
+	out := new(BcDomainInfo)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/BcDomainPortRemove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) BcDomainUpdate(ctx context.Context, in *BcDomainRequest, opts ...grpc.CallOption) (*BcDomainInfo, error) {
+	out := new(BcDomainInfo)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/BcDomainUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) BcDomainDelete(ctx context.Context, in *BcDomainRequest, opts ...grpc.CallOption) (*BcDomainInfo, error) {
+	out := new(BcDomainInfo)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/BcDomainDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) SubnetGet(ctx context.Context, in *SubnetRequest, opts ...grpc.CallOption) (*SubnetInfo, error) {
+	out := new(SubnetInfo)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/SubnetGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) SubnetCreate(ctx context.Context, in *SubnetRequest, opts ...grpc.CallOption) (*SubnetInfo, error) {
+	out := new(SubnetInfo)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/SubnetCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) SubnetDelete(ctx context.Context, in *SubnetRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/SubnetDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) SubnetRename(ctx context.Context, in *SubnetRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/SubnetRename", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) SubnetIPRangeAdd(ctx context.Context, in *SubnetRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/SubnetIPRangeAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) SubnetIPRangeRemove(ctx context.Context, in *SubnetRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/SubnetIPRangeRemove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) SubnetModify(ctx context.Context, in *SubnetRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/SubnetModify", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) SvmGet(ctx context.Context, in *SvmRequest, opts ...grpc.CallOption) (*SvmInfo, error) {
+	out := new(SvmInfo)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/SvmGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) SvmCreate(ctx context.Context, in *SvmRequest, opts ...grpc.CallOption) (*SvmJobResult, error) {
+	out := new(SvmJobResult)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/SvmCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) SvmDelete(ctx context.Context, in *SvmRequest, opts ...grpc.CallOption) (*SvmJobResult, error) {
+	out := new(SvmJobResult)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/SvmDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) SvmStart(ctx context.Context, in *SvmRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/SvmStart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) SvmStop(ctx context.Context, in *SvmRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/SvmStop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) SvmUnlock(ctx context.Context, in *SvmRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/SvmUnlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) SvmRename(ctx context.Context, in *SvmRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/SvmRename", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) VolumeOnline(ctx context.Context, in *VolumeRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/VolumeOnline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) VolumeOffline(ctx context.Context, in *VolumeRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/VolumeOffline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) VolumeRestrict(ctx context.Context, in *VolumeRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/VolumeRestrict", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) VolumeDelete(ctx context.Context, in *VolumeRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/VolumeDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gRPCNetAppApiClient) VolumeSize(ctx context.Context, in *VolumeRequest, opts ...grpc.CallOption) (*VolumeInfo, error) {
+	out := new(VolumeInfo)
+	err := c.cc.Invoke(ctx, "/grpcapi.GRPCNetAppApi/VolumeSize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GRPCNetAppApiServer is the server API for GRPCNetAppApi service.
+type GRPCNetAppApiServer interface {
+	// generic JSON call, used for commands without typed RPC
+	Call(context.Context, *CallRequest) (*CallResponse, error)
+	// generic JSON calls in one round trip, e.g. the reads of a refresh
+	CallBatch(context.Context, *BatchRequest) (*BatchResponse, error)
+	Shutdown(context.Context, *ShutdownRequest) (*ShutdownResponse, error)
+	// build and supported commands of the API server
+	GetVersion(context.Context, *VersionRequest) (*VersionResponse, error)
+	// pushes the log records of the API server until the client cancels
+	StreamLogs(*LogRequest, GRPCNetAppApi_StreamLogsServer) error
+	// SYS.NODE.GET
+	NodeGet(context.Context, *NodeRequest) (*NodeInfo, error)
+	// SYS.PORT.GET
+	PortGet(context.Context, *PortRequest) (*PortInfo, error)
+	// SYS.PORT.MODIFY
+	PortModify(context.Context, *PortModifyRequest) (*EmptyResponse, error)
+	// SYS.PORT.FIND.PATTERN
+	PortFindPattern(context.Context, *PortRequest) (*PortFindResponse, error)
+	// SYS.PORTGROUP.GET
+	PortGroupGet(context.Context, *PortGroupRequest) (*PortGroupInfo, error)
+	// SYS.PORTGROUP.CREATE
+	PortGroupCreate(context.Context, *PortGroupRequest) (*EmptyResponse, error)
+	// SYS.PORTGROUP.PORT.ADD
+	PortGroupPortAdd(context.Context, *PortGroupRequest) (*EmptyResponse, error)
+	// SYS.PORTGROUP.PORT.REMOVE
+	PortGroupPortRemove(context.Context, *PortGroupRequest) (*EmptyResponse, error)
+	// SYS.PORTGROUP.DELETE
+	PortGroupDelete(context.Context, *PortGroupRequest) (*EmptyResponse, error)
+	// SYS.AGGR.GET
+	AggrGet(context.Context, *AggrRequest) (*AggrInfo, error)
+	// SYS.JOB.GET
+	JobGet(context.Context, *JobRequest) (*JobInfo, error)
+	// pushes the job info on every job state or progress change, the
+	// stream ends once the job ended
+	WatchJob(*WatchJobRequest, GRPCNetAppApi_WatchJobServer) error
+	// NW.VLAN.GET
+	VlanGet(context.Context, *VlanRequest) (*VlanInfo, error)
+	// NW.VLAN.CREATE
+	VlanCreate(context.Context, *VlanRequest) (*EmptyResponse, error)
+	// NW.VLAN.DELETE
+	VlanDelete(context.Context, *VlanRequest) (*EmptyResponse, error)
+	// NW.IPSPACE.GET
+	IPSpaceGet(context.Context, *IPSpaceRequest) (*IPSpaceInfo, error)
+	// NW.IPSPACE.CREATE
+	IPSpaceCreate(context.Context, *IPSpaceRequest) (*IPSpaceInfo, error)
+	// NW.IPSPACE.UPDATE
+	IPSpaceUpdate(context.Context, *IPSpaceRequest) (*EmptyResponse, error)
+	// NW.IPSPACE.DELETE
+	IPSpaceDelete(context.Context, *IPSpaceRequest) (*EmptyResponse, error)
+	// NW.BRCDOM.GET
+	BcDomainGet(context.Context, *BcDomainRequest) (*BcDomainInfo, error)
+	// NW.BRCDOM.STATUS
+	BcDomainStatus(context.Context, *BcDomainRequest) (*BcDomainInfo, error)
+	// NW.BRCDOM.CREATE
+	BcDomainCreate(context.Context, *BcDomainRequest) (*BcDomainInfo, error)
+	// NW.BRCDOM.RENAME
+	BcDomainRename(context.Context, *BcDomainRequest) (*EmptyResponse, error)
+	// NW.BRCDOM.PORT.ADD
+	BcDomainPortAdd(context.Context, *BcDomainRequest) (*BcDomainInfo, error)
+	// NW.BRCDOM.PORT.REMOVE
+	BcDomainPortRemove(context.Context, *BcDomainRequest) (*BcDomainInfo, error)
+	// NW.BRCDOM.UPDATE
+	BcDomainUpdate(context.Context, *BcDomainRequest) (*BcDomainInfo, error)
+	// NW.BRCDOM.DELETE
+	BcDomainDelete(context.Context, *BcDomainRequest) (*BcDomainInfo, error)
+	// NW.SUBNET.GET
+	SubnetGet(context.Context, *SubnetRequest) (*SubnetInfo, error)
+	// NW.SUBNET.CREATE
+	SubnetCreate(context.Context, *SubnetRequest) (*SubnetInfo, error)
+	// NW.SUBNET.DELETE
+	SubnetDelete(context.Context, *SubnetRequest) (*EmptyResponse, error)
+	// NW.SUBNET.RENAME
+	SubnetRename(context.Context, *SubnetRequest) (*EmptyResponse, error)
+	// NW.SUBNET.IPR.ADD
+	SubnetIPRangeAdd(context.Context, *SubnetRequest) (*EmptyResponse, error)
+	// NW.SUBNET.IPR.REMOVE
+	SubnetIPRangeRemove(context.Context, *SubnetRequest) (*EmptyResponse, error)
+	// NW.SUBNET.MODIFY
+	SubnetModify(context.Context, *SubnetRequest) (*EmptyResponse, error)
+	// SVM.GET
+	SvmGet(context.Context, *SvmRequest) (*SvmInfo, error)
+	// SVM.CREATE
+	SvmCreate(context.Context, *SvmRequest) (*SvmJobResult, error)
+	// SVM.DELETE
+	SvmDelete(context.Context, *SvmRequest) (*SvmJobResult, error)
+	// SVM.START
+	SvmStart(context.Context, *SvmRequest) (*EmptyResponse, error)
+	// SVM.STOP
+	SvmStop(context.Context, *SvmRequest) (*EmptyResponse, error)
+	// SVM.UNLOCK
+	SvmUnlock(context.Context, *SvmRequest) (*EmptyResponse, error)
+	// SVM.RENAME
+	SvmRename(context.Context, *SvmRequest) (*EmptyResponse, error)
+	// SVM.VOL.ONLINE
+	VolumeOnline(context.Context, *VolumeRequest) (*EmptyResponse, error)
+	// SVM.VOL.OFFLINE
+	VolumeOffline(context.Context, *VolumeRequest) (*EmptyResponse, error)
+	// SVM.VOL.RESTRICT
+	VolumeRestrict(context.Context, *VolumeRequest) (*EmptyResponse, error)
+	// SVM.VOL.DELETE
+	VolumeDelete(context.Context, *VolumeRequest) (*EmptyResponse, error)
+	// SVM.VOL.SIZE
+	VolumeSize(context.Context, *VolumeRequest) (*VolumeInfo, error)
+}
+
+func RegisterGRPCNetAppApiServer(s *grpc.Server, srv GRPCNetAppApiServer) {
+	s.RegisterService(&_GRPCNetAppApi_serviceDesc, srv)
+}
+
+func _GRPCNetAppApi_Call_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).Call(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/Call",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).Call(ctx, req.(*CallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_CallBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).CallBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/CallBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).CallBatch(ctx, req.(*BatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_Shutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShutdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).Shutdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/Shutdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).Shutdown(ctx, req.(*ShutdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).GetVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/GetVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).GetVersion(ctx, req.(*VersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_StreamLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GRPCNetAppApiServer).StreamLogs(m, &gRPCNetAppApiStreamLogsServer{stream})
+}
+
+type GRPCNetAppApi_StreamLogsServer interface {
+	Send(*LogRecord) error
+	grpc.ServerStream
+}
+
+type gRPCNetAppApiStreamLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *gRPCNetAppApiStreamLogsServer) Send(m *LogRecord) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GRPCNetAppApi_NodeGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).NodeGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/NodeGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).NodeGet(ctx, req.(*NodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_PortGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).PortGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/PortGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).PortGet(ctx, req.(*PortRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_PortModify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortModifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).PortModify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/PortModify",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).PortModify(ctx, req.(*PortModifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_PortFindPattern_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).PortFindPattern(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/PortFindPattern",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).PortFindPattern(ctx, req.(*PortRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_PortGroupGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).PortGroupGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/PortGroupGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).PortGroupGet(ctx, req.(*PortGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_PortGroupCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).PortGroupCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/PortGroupCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).PortGroupCreate(ctx, req.(*PortGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_PortGroupPortAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).PortGroupPortAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/PortGroupPortAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).PortGroupPortAdd(ctx, req.(*PortGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_PortGroupPortRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).PortGroupPortRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/PortGroupPortRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).PortGroupPortRemove(ctx, req.(*PortGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_PortGroupDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).PortGroupDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/PortGroupDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).PortGroupDelete(ctx, req.(*PortGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_AggrGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).AggrGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/AggrGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).AggrGet(ctx, req.(*AggrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_JobGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).JobGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/JobGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).JobGet(ctx, req.(*JobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_WatchJob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchJobRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GRPCNetAppApiServer).WatchJob(m, &gRPCNetAppApiWatchJobServer{stream})
+}
+
+type GRPCNetAppApi_WatchJobServer interface {
+	Send(*JobInfo) error
+	grpc.ServerStream
+}
+
+type gRPCNetAppApiWatchJobServer struct {
+	grpc.ServerStream
+}
+
+func (x *gRPCNetAppApiWatchJobServer) Send(m *JobInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GRPCNetAppApi_VlanGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).VlanGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/VlanGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).VlanGet(ctx, req.(*VlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_VlanCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).VlanCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/VlanCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).VlanCreate(ctx, req.(*VlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_VlanDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).VlanDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/VlanDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).VlanDelete(ctx, req.(*VlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_IPSpaceGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IPSpaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).IPSpaceGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/IPSpaceGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).IPSpaceGet(ctx, req.(*IPSpaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_IPSpaceCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IPSpaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).IPSpaceCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/IPSpaceCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).IPSpaceCreate(ctx, req.(*IPSpaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_IPSpaceUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IPSpaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).IPSpaceUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/IPSpaceUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).IPSpaceUpdate(ctx, req.(*IPSpaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_IPSpaceDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IPSpaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).IPSpaceDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/IPSpaceDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).IPSpaceDelete(ctx, req.(*IPSpaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_BcDomainGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BcDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).BcDomainGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/BcDomainGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).BcDomainGet(ctx, req.(*BcDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_BcDomainStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BcDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).BcDomainStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/BcDomainStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).BcDomainStatus(ctx, req.(*BcDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_BcDomainCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BcDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).BcDomainCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/BcDomainCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).BcDomainCreate(ctx, req.(*BcDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_BcDomainRename_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BcDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).BcDomainRename(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/BcDomainRename",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).BcDomainRename(ctx, req.(*BcDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_BcDomainPortAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BcDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).BcDomainPortAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/BcDomainPortAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).BcDomainPortAdd(ctx, req.(*BcDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_BcDomainPortRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BcDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).BcDomainPortRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/BcDomainPortRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).BcDomainPortRemove(ctx, req.(*BcDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_BcDomainUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BcDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).BcDomainUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/BcDomainUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).BcDomainUpdate(ctx, req.(*BcDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_BcDomainDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BcDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).BcDomainDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/BcDomainDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).BcDomainDelete(ctx, req.(*BcDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_SubnetGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubnetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).SubnetGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/SubnetGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).SubnetGet(ctx, req.(*SubnetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_SubnetCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubnetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).SubnetCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/SubnetCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).SubnetCreate(ctx, req.(*SubnetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_SubnetDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubnetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).SubnetDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/SubnetDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).SubnetDelete(ctx, req.(*SubnetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_SubnetRename_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubnetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).SubnetRename(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/SubnetRename",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).SubnetRename(ctx, req.(*SubnetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_SubnetIPRangeAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubnetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).SubnetIPRangeAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/SubnetIPRangeAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).SubnetIPRangeAdd(ctx, req.(*SubnetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_SubnetIPRangeRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubnetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).SubnetIPRangeRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/SubnetIPRangeRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).SubnetIPRangeRemove(ctx, req.(*SubnetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_SubnetModify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubnetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).SubnetModify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/SubnetModify",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).SubnetModify(ctx, req.(*SubnetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_SvmGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SvmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).SvmGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/SvmGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).SvmGet(ctx, req.(*SvmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_SvmCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SvmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).SvmCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/SvmCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).SvmCreate(ctx, req.(*SvmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_SvmDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SvmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).SvmDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/SvmDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).SvmDelete(ctx, req.(*SvmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_SvmStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SvmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).SvmStart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/SvmStart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).SvmStart(ctx, req.(*SvmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_SvmStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SvmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).SvmStop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/SvmStop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).SvmStop(ctx, req.(*SvmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_SvmUnlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SvmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).SvmUnlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/SvmUnlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).SvmUnlock(ctx, req.(*SvmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_SvmRename_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SvmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).SvmRename(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/SvmRename",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).SvmRename(ctx, req.(*SvmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_VolumeOnline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).VolumeOnline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/VolumeOnline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).VolumeOnline(ctx, req.(*VolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_VolumeOffline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).VolumeOffline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/VolumeOffline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).VolumeOffline(ctx, req.(*VolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_VolumeRestrict_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).VolumeRestrict(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/VolumeRestrict",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).VolumeRestrict(ctx, req.(*VolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_VolumeDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).VolumeDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/VolumeDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).VolumeDelete(ctx, req.(*VolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GRPCNetAppApi_VolumeSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GRPCNetAppApiServer).VolumeSize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.GRPCNetAppApi/VolumeSize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GRPCNetAppApiServer).VolumeSize(ctx, req.(*VolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _GRPCNetAppApi_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "grpcapi.GRPCNetAppApi",
+	HandlerType: (*GRPCNetAppApiServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Call",
+			Handler:    _GRPCNetAppApi_Call_Handler,
+		},
+		{
+			MethodName: "CallBatch",
+			Handler:    _GRPCNetAppApi_CallBatch_Handler,
+		},
+		{
+			MethodName: "Shutdown",
+			Handler:    _GRPCNetAppApi_Shutdown_Handler,
+		},
+		{
+			MethodName: "GetVersion",
+			Handler:    _GRPCNetAppApi_GetVersion_Handler,
+		},
+		{
+			MethodName: "NodeGet",
+			Handler:    _GRPCNetAppApi_NodeGet_Handler,
+		},
+		{
+			MethodName: "PortGet",
+			Handler:    _GRPCNetAppApi_PortGet_Handler,
+		},
+		{
+			MethodName: "PortModify",
+			Handler:    _GRPCNetAppApi_PortModify_Handler,
+		},
+		{
+			MethodName: "PortFindPattern",
+			Handler:    _GRPCNetAppApi_PortFindPattern_Handler,
+		},
+		{
+			MethodName: "PortGroupGet",
+			Handler:    _GRPCNetAppApi_PortGroupGet_Handler,
+		},
+		{
+			MethodName: "PortGroupCreate",
+			Handler:    _GRPCNetAppApi_PortGroupCreate_Handler,
+		},
+		{
+			MethodName: "PortGroupPortAdd",
+			Handler:    _GRPCNetAppApi_PortGroupPortAdd_Handler,
+		},
+		{
+			MethodName: "PortGroupPortRemove",
+			Handler:    _GRPCNetAppApi_PortGroupPortRemove_Handler,
+		},
+		{
+			MethodName: "PortGroupDelete",
+			Handler:    _GRPCNetAppApi_PortGroupDelete_Handler,
+		},
+		{
+			MethodName: "AggrGet",
+			Handler:    _GRPCNetAppApi_AggrGet_Handler,
+		},
+		{
+			MethodName: "JobGet",
+			Handler:    _GRPCNetAppApi_JobGet_Handler,
+		},
+		{
+			MethodName: "VlanGet",
+			Handler:    _GRPCNetAppApi_VlanGet_Handler,
+		},
+		{
+			MethodName: "VlanCreate",
+			Handler:    _GRPCNetAppApi_VlanCreate_Handler,
+		},
+		{
+			MethodName: "VlanDelete",
+			Handler:    _GRPCNetAppApi_VlanDelete_Handler,
+		},
+		{
+			MethodName: "IPSpaceGet",
+			Handler:    _GRPCNetAppApi_IPSpaceGet_Handler,
+		},
+		{
+			MethodName: "IPSpaceCreate",
+			Handler:    _GRPCNetAppApi_IPSpaceCreate_Handler,
+		},
+		{
+			MethodName: "IPSpaceUpdate",
+			Handler:    _GRPCNetAppApi_IPSpaceUpdate_Handler,
+		},
+		{
+			MethodName: "IPSpaceDelete",
+			Handler:    _GRPCNetAppApi_IPSpaceDelete_Handler,
+		},
+		{
+			MethodName: "BcDomainGet",
+			Handler:    _GRPCNetAppApi_BcDomainGet_Handler,
+		},
+		{
+			MethodName: "BcDomainStatus",
+			Handler:    _GRPCNetAppApi_BcDomainStatus_Handler,
+		},
+		{
+			MethodName: "BcDomainCreate",
+			Handler:    _GRPCNetAppApi_BcDomainCreate_Handler,
+		},
+		{
+			MethodName: "BcDomainRename",
+			Handler:    _GRPCNetAppApi_BcDomainRename_Handler,
+		},
+		{
+			MethodName: "BcDomainPortAdd",
+			Handler:    _GRPCNetAppApi_BcDomainPortAdd_Handler,
+		},
+		{
+			MethodName: "BcDomainPortRemove",
+			Handler:    _GRPCNetAppApi_BcDomainPortRemove_Handler,
+		},
+		{
+			MethodName: "BcDomainUpdate",
+			Handler:    _GRPCNetAppApi_BcDomainUpdate_Handler,
+		},
+		{
+			MethodName: "BcDomainDelete",
+			Handler:    _GRPCNetAppApi_BcDomainDelete_Handler,
+		},
+		{
+			MethodName: "SubnetGet",
+			Handler:    _GRPCNetAppApi_SubnetGet_Handler,
+		},
+		{
+			MethodName: "SubnetCreate",
+			Handler:    _GRPCNetAppApi_SubnetCreate_Handler,
+		},
+		{
+			MethodName: "SubnetDelete",
+			Handler:    _GRPCNetAppApi_SubnetDelete_Handler,
+		},
+		{
+			MethodName: "SubnetRename",
+			Handler:    _GRPCNetAppApi_SubnetRename_Handler,
+		},
+		{
+			MethodName: "SubnetIPRangeAdd",
+			Handler:    _GRPCNetAppApi_SubnetIPRangeAdd_Handler,
+		},
+		{
+			MethodName: "SubnetIPRangeRemove",
+			Handler:    _GRPCNetAppApi_SubnetIPRangeRemove_Handler,
+		},
+		{
+			MethodName: "SubnetModify",
+			Handler:    _GRPCNetAppApi_SubnetModify_Handler,
+		},
+		{
+			MethodName: "SvmGet",
+			Handler:    _GRPCNetAppApi_SvmGet_Handler,
+		},
+		{
+			MethodName: "SvmCreate",
+			Handler:    _GRPCNetAppApi_SvmCreate_Handler,
+		},
+		{
+			MethodName: "SvmDelete",
+			Handler:    _GRPCNetAppApi_SvmDelete_Handler,
+		},
+		{
+			MethodName: "SvmStart",
+			Handler:    _GRPCNetAppApi_SvmStart_Handler,
+		},
+		{
+			MethodName: "SvmStop",
+			Handler:    _GRPCNetAppApi_SvmStop_Handler,
+		},
+		{
+			MethodName: "SvmUnlock",
+			Handler:    _GRPCNetAppApi_SvmUnlock_Handler,
+		},
+		{
+			MethodName: "SvmRename",
+			Handler:    _GRPCNetAppApi_SvmRename_Handler,
+		},
+		{
+			MethodName: "VolumeOnline",
+			Handler:    _GRPCNetAppApi_VolumeOnline_Handler,
+		},
+		{
+			MethodName: "VolumeOffline",
+			Handler:    _GRPCNetAppApi_VolumeOffline_Handler,
+		},
+		{
+			MethodName: "VolumeRestrict",
+			Handler:    _GRPCNetAppApi_VolumeRestrict_Handler,
+		},
+		{
+			MethodName: "VolumeDelete",
+			Handler:    _GRPCNetAppApi_VolumeDelete_Handler,
+		},
+		{
+			MethodName: "VolumeSize",
+			Handler:    _GRPCNetAppApi_VolumeSize_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamLogs",
+			Handler:       _GRPCNetAppApi_StreamLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchJob",
+			Handler:       _GRPCNetAppApi_WatchJob_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "grpcapi.proto",
 }
@@ -0,0 +1,51 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrBatchUnsupported is returned by batch calls of APIs without batches,
+// the calls must be made one by one
+var ErrBatchUnsupported = errors.New("batch call not supported by the API")
+
+// Batcher is implemented by APIs executing several calls in one round
+// trip. The calls are executed in order, there is one response per call
+type Batcher interface {
+	CallBatch(ctx context.Context, calls []*CallRequest) ([]*CallResponse, error)
+}
+
+func (m *gRPCClient) CallBatch(
+	ctx context.Context, calls []*CallRequest) ([]*CallResponse, error) {
+
+	resp, err := m.client.CallBatch(ctx, &BatchRequest{Calls: calls})
+	if status.Code(err) == codes.Unimplemented {
+		return nil, ErrBatchUnsupported
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Results, nil
+}
+
+// CallBatch executes the calls with Impl, which must be a Batcher
+func (m *gRPCServer) CallBatch(
+	ctx context.Context, req *BatchRequest) (*BatchResponse, error) {
+
+	batcher, ok := m.Impl.(Batcher)
+	if !ok {
+		return nil, status.Errorf(
+			codes.Unimplemented, "batch call not supported by %T", m.Impl)
+	}
+
+	results, err := batcher.CallBatch(ctx, req.Calls)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BatchResponse{Results: results}, nil
+}
@@ -0,0 +1,44 @@
+package grpcapi
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// ErrnoMetadataKey is the gRPC trailer metadata key of the ZAPI errno of
+// a typed RPC aborted by a failed command, the generic Call returns the
+// errno with its CallResponse
+const ErrnoMetadataKey = "netapp-errno"
+
+// setErrnoTrailer sets the errno trailer of the aborted typed RPC
+func setErrnoTrailer(ctx context.Context, errno int32) {
+	if errno == 0 {
+		return
+	}
+
+	err := grpc.SetTrailer(ctx, metadata.Pairs(
+		ErrnoMetadataKey, strconv.Itoa(int(errno))))
+	if err != nil {
+		log.Printf("[WARN] could not set errno trailer, got: %s", err)
+	}
+}
+
+// trailerErrno returns the errno of the trailer, zero if not set
+func trailerErrno(trailer metadata.MD) int32 {
+	values := trailer.Get(ErrnoMetadataKey)
+	if len(values) == 0 {
+		return 0
+	}
+
+	errno, err := strconv.Atoi(values[0])
+	if err != nil {
+		log.Printf("[WARN] invalid errno trailer [%s], got: %s", values[0], err)
+		return 0
+	}
+
+	return int32(errno)
+}
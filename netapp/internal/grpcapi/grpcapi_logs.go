@@ -0,0 +1,66 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrLogsUnsupported is returned by log streams of APIs without log records
+var ErrLogsUnsupported = errors.New("log stream not supported by the API")
+
+// LogStreamer is implemented by APIs forwarding their log records. The
+// records of level and above are passed to record until record fails or
+// the context is done
+type LogStreamer interface {
+	StreamLogs(ctx context.Context, level string,
+		record func(*LogRecord) error) error
+}
+
+// StreamLogs consumes the log record stream of the StreamLogs RPC
+func (m *gRPCClient) StreamLogs(
+	ctx context.Context, level string,
+	record func(*LogRecord) error) error {
+
+	// cancel ends the stream on the server as well if record fails
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := m.client.StreamLogs(ctx, &LogRequest{Level: level})
+	if err != nil {
+		return err
+	}
+
+	for {
+		rec, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			if status.Code(err) == codes.Unimplemented {
+				return ErrLogsUnsupported
+			}
+			return err
+		}
+
+		if err := record(rec); err != nil {
+			return err
+		}
+	}
+}
+
+// StreamLogs streams the log records of Impl, which must be a LogStreamer
+func (m *gRPCServer) StreamLogs(
+	req *LogRequest, stream GRPCNetAppApi_StreamLogsServer) error {
+
+	streamer, ok := m.Impl.(LogStreamer)
+	if !ok {
+		return status.Errorf(
+			codes.Unimplemented, "log stream not supported by %T", m.Impl)
+	}
+
+	return streamer.StreamLogs(stream.Context(), req.Level, stream.Send)
+}
@@ -0,0 +1,33 @@
+package grpcapi
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// SessionMetadataKey is the gRPC metadata key of the client session, the
+// API server keeps one cluster connection per session. Several provider
+// aliases sharing the API server can so connect to different clusters
+const SessionMetadataKey = "netapp-session"
+
+// WithSession returns the context passing the session with its calls
+func WithSession(ctx context.Context, session string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, SessionMetadataKey, session)
+}
+
+// SessionFromContext returns the session of an incoming call, empty if
+// the client did not pass one
+func SessionFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(SessionMetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
@@ -0,0 +1,581 @@
+package grpcapi_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/grpcapi"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/network"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/pythonapi"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/simapi"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/svm"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/system"
+)
+
+// typedMessages are the helper request/response of each typed command and
+// the proto messages of its RPC
+var typedMessages = []struct {
+	cmd                     string
+	helperReq, helperResp   interface{}
+	messageReq, messageResp interface{}
+}{
+	{"SYS.NODE.GET", system.NodeGetRequest{}, system.NodeInfo{}, grpcapi.NodeRequest{}, grpcapi.NodeInfo{}},
+	{"SYS.PORT.GET", system.PortGetRequest{}, system.PortInfo{}, grpcapi.PortRequest{}, grpcapi.PortInfo{}},
+	{"SYS.PORT.MODIFY", system.PortModifyRequest{}, pythonapi.EmptyResponse{}, grpcapi.PortModifyRequest{}, grpcapi.EmptyResponse{}},
+	{"SYS.PORT.FIND.PATTERN", system.PortGetRequest{}, system.PortFindResult{}, grpcapi.PortRequest{}, grpcapi.PortFindResponse{}},
+	{"SYS.PORTGROUP.GET", system.PortGroupGetRequest{}, system.PortGroupInfo{}, grpcapi.PortGroupRequest{}, grpcapi.PortGroupInfo{}},
+	{"SYS.PORTGROUP.CREATE", system.PortGroupModifyRequest{}, pythonapi.EmptyResponse{}, grpcapi.PortGroupRequest{}, grpcapi.EmptyResponse{}},
+	{"SYS.PORTGROUP.PORT.ADD", system.PortGroupModifyRequest{}, pythonapi.EmptyResponse{}, grpcapi.PortGroupRequest{}, grpcapi.EmptyResponse{}},
+	{"SYS.PORTGROUP.PORT.REMOVE", system.PortGroupModifyRequest{}, pythonapi.EmptyResponse{}, grpcapi.PortGroupRequest{}, grpcapi.EmptyResponse{}},
+	{"SYS.PORTGROUP.DELETE", system.PortGroupGetRequest{}, pythonapi.EmptyResponse{}, grpcapi.PortGroupRequest{}, grpcapi.EmptyResponse{}},
+	{"SYS.AGGR.GET", system.AggrGetRequest{}, system.AggrInfo{}, grpcapi.AggrRequest{}, grpcapi.AggrInfo{}},
+	{"SYS.JOB.GET", system.JobGetRequest{}, system.JobInfo{}, grpcapi.JobRequest{}, grpcapi.JobInfo{}},
+
+	{"NW.VLAN.GET", network.VlanRequest{}, network.VlanInfo{}, grpcapi.VlanRequest{}, grpcapi.VlanInfo{}},
+	{"NW.VLAN.CREATE", network.VlanRequest{}, pythonapi.EmptyResponse{}, grpcapi.VlanRequest{}, grpcapi.EmptyResponse{}},
+	{"NW.VLAN.DELETE", network.VlanRequest{}, pythonapi.EmptyResponse{}, grpcapi.VlanRequest{}, grpcapi.EmptyResponse{}},
+	{"NW.IPSPACE.GET", network.IPSpaceRequest{}, network.IPSpaceInfo{}, grpcapi.IPSpaceRequest{}, grpcapi.IPSpaceInfo{}},
+	{"NW.IPSPACE.CREATE", network.IPSpaceRequest{}, network.IPSpaceInfo{}, grpcapi.IPSpaceRequest{}, grpcapi.IPSpaceInfo{}},
+	{"NW.IPSPACE.UPDATE", network.IPSpaceRequest{}, pythonapi.EmptyResponse{}, grpcapi.IPSpaceRequest{}, grpcapi.EmptyResponse{}},
+	{"NW.IPSPACE.DELETE", network.IPSpaceRequest{}, pythonapi.EmptyResponse{}, grpcapi.IPSpaceRequest{}, grpcapi.EmptyResponse{}},
+	{"NW.BRCDOM.GET", network.BcDomainRequest{}, network.BcDomainInfo{}, grpcapi.BcDomainRequest{}, grpcapi.BcDomainInfo{}},
+	{"NW.BRCDOM.STATUS", network.BcDomainRequest{}, network.BcDomainInfo{}, grpcapi.BcDomainRequest{}, grpcapi.BcDomainInfo{}},
+	{"NW.BRCDOM.CREATE", network.BcDomainRequest{}, network.BcDomainInfo{}, grpcapi.BcDomainRequest{}, grpcapi.BcDomainInfo{}},
+	{"NW.BRCDOM.RENAME", network.BcDomainRequest{}, pythonapi.EmptyResponse{}, grpcapi.BcDomainRequest{}, grpcapi.EmptyResponse{}},
+	{"NW.BRCDOM.PORT.ADD", network.BcDomainRequest{}, network.BcDomainInfo{}, grpcapi.BcDomainRequest{}, grpcapi.BcDomainInfo{}},
+	{"NW.BRCDOM.PORT.REMOVE", network.BcDomainRequest{}, network.BcDomainInfo{}, grpcapi.BcDomainRequest{}, grpcapi.BcDomainInfo{}},
+	{"NW.BRCDOM.UPDATE", network.BcDomainRequest{}, network.BcDomainInfo{}, grpcapi.BcDomainRequest{}, grpcapi.BcDomainInfo{}},
+	{"NW.BRCDOM.DELETE", network.BcDomainRequest{}, network.BcDomainInfo{}, grpcapi.BcDomainRequest{}, grpcapi.BcDomainInfo{}},
+	{"NW.SUBNET.GET", network.SubnetRequest{}, network.SubnetInfo{}, grpcapi.SubnetRequest{}, grpcapi.SubnetInfo{}},
+	{"NW.SUBNET.CREATE", network.SubnetRequest{}, network.SubnetInfo{}, grpcapi.SubnetRequest{}, grpcapi.SubnetInfo{}},
+	{"NW.SUBNET.DELETE", network.SubnetRequest{}, pythonapi.EmptyResponse{}, grpcapi.SubnetRequest{}, grpcapi.EmptyResponse{}},
+	{"NW.SUBNET.RENAME", network.SubnetRequest{}, pythonapi.EmptyResponse{}, grpcapi.SubnetRequest{}, grpcapi.EmptyResponse{}},
+	{"NW.SUBNET.IPR.ADD", network.SubnetRequest{}, pythonapi.EmptyResponse{}, grpcapi.SubnetRequest{}, grpcapi.EmptyResponse{}},
+	{"NW.SUBNET.IPR.REMOVE", network.SubnetRequest{}, pythonapi.EmptyResponse{}, grpcapi.SubnetRequest{}, grpcapi.EmptyResponse{}},
+	{"NW.SUBNET.MODIFY", network.SubnetRequest{}, pythonapi.EmptyResponse{}, grpcapi.SubnetRequest{}, grpcapi.EmptyResponse{}},
+
+	{"SVM.GET", svm.Request{}, svm.Info{}, grpcapi.SvmRequest{}, grpcapi.SvmInfo{}},
+	{"SVM.CREATE", svm.Request{}, svm.JobResult{}, grpcapi.SvmRequest{}, grpcapi.SvmJobResult{}},
+	{"SVM.DELETE", svm.Request{}, svm.JobResult{}, grpcapi.SvmRequest{}, grpcapi.SvmJobResult{}},
+	{"SVM.START", svm.Request{}, pythonapi.EmptyResponse{}, grpcapi.SvmRequest{}, grpcapi.EmptyResponse{}},
+	{"SVM.STOP", svm.Request{}, pythonapi.EmptyResponse{}, grpcapi.SvmRequest{}, grpcapi.EmptyResponse{}},
+	{"SVM.UNLOCK", svm.Request{}, pythonapi.EmptyResponse{}, grpcapi.SvmRequest{}, grpcapi.EmptyResponse{}},
+	{"SVM.RENAME", svm.Request{}, pythonapi.EmptyResponse{}, grpcapi.SvmRequest{}, grpcapi.EmptyResponse{}},
+	{"SVM.VOL.ONLINE", svm.VolumeRequest{}, pythonapi.EmptyResponse{}, grpcapi.VolumeRequest{}, grpcapi.EmptyResponse{}},
+	{"SVM.VOL.OFFLINE", svm.VolumeRequest{}, pythonapi.EmptyResponse{}, grpcapi.VolumeRequest{}, grpcapi.EmptyResponse{}},
+	{"SVM.VOL.RESTRICT", svm.VolumeRequest{}, pythonapi.EmptyResponse{}, grpcapi.VolumeRequest{}, grpcapi.EmptyResponse{}},
+	{"SVM.VOL.DELETE", svm.VolumeRequest{}, pythonapi.EmptyResponse{}, grpcapi.VolumeRequest{}, grpcapi.EmptyResponse{}},
+	{"SVM.VOL.SIZE", svm.VolumeRequest{}, svm.VolumeInfo{}, grpcapi.VolumeRequest{}, grpcapi.VolumeInfo{}},
+}
+
+// responseOptional are keys the proto responses may leave out, the
+// non-exist flag is not part of every helper response and the request
+// keys are only part of helper responses embedding the request
+var responseOptional = map[string]bool{"non_exist": true, "new_name": true, "force": true}
+
+// jsonKeys returns the sorted JSON keys of the struct value, fields of
+// embedded structs included
+func jsonKeys(value interface{}) []string {
+	keys := []string{}
+	var collect func(typ reflect.Type)
+	collect = func(typ reflect.Type) {
+		for idx := 0; idx < typ.NumField(); idx++ {
+			field := typ.Field(idx)
+			tag := field.Tag.Get("json")
+			if field.Anonymous && tag == "" {
+				collect(field.Type)
+				continue
+			}
+
+			name := strings.Split(tag, ",")[0]
+			if name != "-" && field.PkgPath == "" {
+				keys = append(keys, name)
+			}
+		}
+	}
+	collect(reflect.TypeOf(value))
+	sort.Strings(keys)
+
+	return keys
+}
+
+func withoutOptional(keys []string) []string {
+	result := []string{}
+	for _, key := range keys {
+		if !responseOptional[key] {
+			result = append(result, key)
+		}
+	}
+
+	return result
+}
+
+func Test_TypedRPC_MessageKeys(t *testing.T) {
+	r := require.New(t)
+
+	commands := []string{}
+	for _, tm := range typedMessages {
+		commands = append(commands, tm.cmd)
+
+		// every helper request key must be known to the proto request,
+		// the request would fail on the strict decode otherwise
+		r.Subset(jsonKeys(tm.messageReq), jsonKeys(tm.helperReq), tm.cmd)
+		r.Equal(
+			withoutOptional(jsonKeys(tm.helperResp)),
+			withoutOptional(jsonKeys(tm.messageResp)), tm.cmd)
+	}
+	r.Equal(jsonKeys(network.BcDomainPortInfo{}), jsonKeys(grpcapi.BcDomainPortInfo{}))
+
+	sort.Strings(commands)
+	r.Equal(grpcapi.TypedCommands(), commands)
+}
+
+// testAPI serves a simulated cluster over gRPC and returns the connected
+// NetApp API talking to it
+func testAPI(t *testing.T) (*simapi.Cluster, *pythonapi.NetAppAPI) {
+	c := simapi.NewCluster()
+	c.JobPolls = 0
+	c.AddNode("node1")
+	c.AddPort("node1", "e0c")
+	c.AddPort("node1", "e0d")
+	c.AddAggr("aggr1", "node1")
+
+	return c, serveAPI(t, c.Impl())
+}
+
+// serveAPI serves impl over gRPC and returns the connected API client
+func serveAPI(t *testing.T, impl grpcapi.GRPCNetAppAPI) *pythonapi.NetAppAPI {
+	client, server := plugin.TestPluginGRPCConn(t, grpcapi.NewPluginMap(impl))
+	t.Cleanup(func() {
+		client.Close()
+		server.Stop()
+	})
+
+	raw, err := client.Dispense("grpcapi")
+	require.NoError(t, err)
+	api := pythonapi.NewNetAppAPI(raw.(grpcapi.PythonAPI))
+
+	// SYS.CONNECT has no typed RPC and uses the generic call
+	_, err = system.Connect(api, &system.ConnectRequest{Host: "sim", User: "admin", Password: "pwd"})
+	require.NoError(t, err)
+
+	return api
+}
+
+func Test_TypedRPC_Simulator(t *testing.T) {
+	r := require.New(t)
+	c, api := testAPI(t)
+
+	node, err := system.NodeGetByName(api, "node1")
+	r.NoError(err)
+	r.Equal("node1", node.Name)
+	r.False(node.NonExist)
+
+	node, err = system.NodeGetByName(api, "node2")
+	r.NoError(err)
+	r.True(node.NonExist)
+
+	aggr, err := system.AggrGetByName(api, "aggr1")
+	r.NoError(err)
+	r.Equal([]string{"node1"}, aggr.Nodes)
+
+	_, err = network.IPSpaceCreate(api, "ips1")
+	r.NoError(err)
+	_, err = network.BcDomainCreate(api, &network.BcDomainRequest{
+		Name: "bcd1", Mtu: "1500", IPSpace: "ips1",
+		Ports: []string{"node1:e0c"}})
+	r.NoError(err)
+
+	bcInfo, err := network.BcDomainGet(api, "bcd1")
+	r.NoError(err)
+	r.Len(bcInfo.Ports, 1)
+	r.Equal("node1:e0c", bcInfo.Ports[0].Name)
+
+	// command failures are passed on with the command error message
+	_, err = network.SubnetCreate(api, &network.SubnetRequest{
+		Name: "sn1", BroadCastDomain: "bcd1", IPSpace: "ips1",
+		Subnet: "10.0.0.0/24", IPRanges: []string{"10.0.1.1"}})
+	r.Error(err)
+	r.Contains(err.Error(), "api call [NW.SUBNET.CREATE] failed with msg")
+	r.Contains(err.Error(), "not within subnet")
+
+	snInfo, err := network.SubnetCreate(api, &network.SubnetRequest{
+		Name: "sn1", BroadCastDomain: "bcd1", IPSpace: "ips1",
+		Subnet: "10.0.0.0/24", IPRanges: []string{"10.0.0.10-10.0.0.19"}})
+	r.NoError(err)
+	r.Equal(10, snInfo.IPCount)
+	r.Equal(0, snInfo.IPUsed)
+	r.Equal(10, snInfo.IPAvailable)
+	r.Equal(1, c.CommandCount("NW.SUBNET.CREATE"))
+
+	jobRes, err := svm.Create(api, &svm.Request{
+		Name: "svm1", IPSpace: "ips1", RootAggr: "aggr1"})
+	r.NoError(err)
+	r.NotZero(jobRes.JobID)
+
+	job, err := system.JobGetByID(api, jobRes.JobID)
+	r.NoError(err)
+	r.Equal(jobRes.JobID, job.ID)
+	r.Equal("success", job.Status)
+
+	info, err := svm.GetByName(api, "svm1")
+	r.NoError(err)
+	r.Equal("ips1", info.IPSpace)
+	r.Equal("aggr1", info.RootAggr)
+}
+
+func Test_TypedRPC_RequestMismatch(t *testing.T) {
+	r := require.New(t)
+	c, api := testAPI(t)
+
+	err := api.Call(context.Background(), "NW.VLAN.GET",
+		map[string]string{"node": "node1"}, &network.VlanInfo{})
+	r.Error(err)
+	r.Contains(err.Error(), "request does not match")
+	r.Contains(err.Error(), "grpcapi.VlanRequest")
+	r.Equal(0, c.CommandCount("NW.VLAN.GET"))
+}
+
+// dataImpl records the command data the implementation gets
+type dataImpl struct {
+	grpcapi.GRPCNetAppAPI
+	data map[string]string
+}
+
+func (i *dataImpl) Call(
+	ctx context.Context, cmd string, data []byte) (*grpcapi.CallResponse, error) {
+	i.data[cmd] = string(data)
+	return i.GRPCNetAppAPI.Call(ctx, cmd, data)
+}
+
+func Test_TypedRPC_ZeroValues(t *testing.T) {
+	r := require.New(t)
+	c := simapi.NewCluster()
+	c.JobPolls = 0
+	c.AddNode("node1")
+	c.AddAggr("aggr1", "node1")
+	impl := &dataImpl{GRPCNetAppAPI: c.Impl(), data: map[string]string{}}
+	api := serveAPI(t, impl)
+
+	// the empty ipspace reaches the command instead of being dropped
+	_, err := svm.Create(api, &svm.Request{Name: "svm1", RootAggr: "aggr1"})
+	r.Error(err)
+	r.Contains(impl.data["SVM.CREATE"], `"ipspace":""`)
+
+	// requests without zero values use the typed RPC
+	_, err = system.NodeGetByName(api, "node1")
+	r.NoError(err)
+	r.Equal(`{"name":"node1"}`, impl.data["SYS.NODE.GET"])
+}
+
+func Test_TypedRPC_Deadline(t *testing.T) {
+	r := require.New(t)
+	c, api := testAPI(t)
+	c.Delays = map[string]time.Duration{"SYS.NODE.GET": time.Minute}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// the deadline is an RPC error, not a failed command
+	err := api.Call(ctx, "SYS.NODE.GET",
+		&system.NodeGetRequest{Name: "node1"}, &system.NodeInfo{})
+	r.Error(err)
+	r.NotContains(err.Error(), "failed with msg")
+	r.Contains(err.Error(), "DeadlineExceeded")
+}
+
+// errnoImpl connects but fails all other commands with the errno
+type errnoImpl struct {
+	errno int32
+}
+
+func (i *errnoImpl) Call(
+	ctx context.Context, cmd string, data []byte) (*grpcapi.CallResponse, error) {
+	if cmd == "SYS.CONNECT" {
+		return &grpcapi.CallResponse{Success: true, Data: []byte("{}")}, nil
+	}
+
+	return &grpcapi.CallResponse{Errmsg: "failed cmd [" + cmd + "]", Errno: i.errno}, nil
+}
+
+func (i *errnoImpl) Shutdown(ctx context.Context, clientID string) (bool, error) {
+	return true, nil
+}
+
+func Test_Call_Errno(t *testing.T) {
+	r := require.New(t)
+	api := serveAPI(t, &errnoImpl{errno: pythonapi.ErrnoBusy})
+
+	// generic call with the errno of its CallResponse
+	err := api.Call(context.Background(), "TEST.KEYVALUE",
+		map[string]string{"key": "k"}, &map[string]string{})
+	r.EqualError(err, "api call [TEST.KEYVALUE] failed with msg: failed cmd [TEST.KEYVALUE]")
+	r.True(errors.Is(err, pythonapi.ErrBusy))
+	r.Equal(pythonapi.ErrnoBusy, pythonapi.Errno(err))
+
+	// typed RPC with the errno of the trailer
+	_, err = system.NodeGetByName(api, "node1")
+	r.True(errors.Is(err, pythonapi.ErrBusy))
+	r.False(errors.Is(err, pythonapi.ErrNotFound))
+	r.Equal(pythonapi.ErrnoBusy, pythonapi.Errno(err))
+}
+
+func Test_TypedRPC_Errno(t *testing.T) {
+	r := require.New(t)
+	c, api := testAPI(t)
+
+	_, err := network.IPSpaceCreate(api, "ips1")
+	r.NoError(err)
+	_, err = network.IPSpaceCreate(api, "ips1")
+	r.True(errors.Is(err, pythonapi.ErrAlreadyExists))
+	r.Contains(err.Error(), "duplicate entry")
+
+	c.Faults = map[string]int{"SYS.NODE.GET": pythonapi.ErrnoObjectNotFound}
+	_, err = system.NodeGetByName(api, "node1")
+	r.True(errors.Is(err, pythonapi.ErrNotFound))
+
+	// errno values without error kind only match the APIError
+	err = network.IPSpaceDelete(api, "Default")
+	r.Error(err)
+	r.NotZero(pythonapi.Errno(err))
+	for _, kind := range []error{
+		pythonapi.ErrNotFound, pythonapi.ErrAlreadyExists,
+		pythonapi.ErrBusy, pythonapi.ErrAuth} {
+		r.False(errors.Is(err, kind))
+	}
+}
+
+// createSvmJob starts the SVM create job on the cluster
+func createSvmJob(t *testing.T, api *pythonapi.NetAppAPI) int {
+	jobRes, err := svm.Create(api, &svm.Request{
+		Name: "svm1", IPSpace: "Default", RootAggr: "aggr1", RootName: "svm1_root"})
+	require.NoError(t, err)
+	require.Equal(t, "in_progress", jobRes.Status)
+
+	return jobRes.JobID
+}
+
+func Test_WatchJob_Progress(t *testing.T) {
+	r := require.New(t)
+	c, api := testAPI(t)
+	c.JobPolls = 2
+	jobID := createSvmJob(t, api)
+
+	var states []string
+	job, err := system.JobWatch(context.Background(), api, jobID,
+		func(jInfo *system.JobInfo) {
+			states = append(states, jInfo.Status+":"+jInfo.Progress)
+		})
+	r.NoError(err)
+	r.Equal("success", job.Status)
+	r.Equal("Complete: Succeeded", job.Message)
+	r.Equal([]string{
+		"running:step 1 of 2", "running:step 2 of 2", "success:"}, states)
+
+	info, err := svm.GetByName(api, "svm1")
+	r.NoError(err)
+	r.Equal("running", info.SvmState)
+}
+
+func Test_WatchJob_Unsupported(t *testing.T) {
+	r := require.New(t)
+	c, api := testAPI(t)
+
+	// the embedded interface hides WatchJob of the simulator
+	api = serveAPI(t, struct{ grpcapi.GRPCNetAppAPI }{c.Impl()})
+	c.JobPolls = 1
+	jobID := createSvmJob(t, api)
+
+	err := api.WatchJob(context.Background(),
+		&system.JobGetRequest{ID: jobID}, &system.JobInfo{},
+		func() error { return nil })
+	r.Equal(pythonapi.ErrWatchUnsupported, err)
+
+	// falls back to polling
+	job, err := system.JobWatch(context.Background(), api, jobID, nil)
+	r.NoError(err)
+	r.Equal("success", job.Status)
+}
+
+func Test_WatchJob_Failure(t *testing.T) {
+	r := require.New(t)
+	_, api := testAPI(t)
+
+	_, err := system.JobWatch(context.Background(), api, 42, nil)
+	r.Error(err)
+	r.Contains(err.Error(), "job watch failed with msg")
+	r.Contains(err.Error(), "job [42] does not exist")
+}
+
+func Test_WatchJob_Timeout(t *testing.T) {
+	r := require.New(t)
+	c, api := testAPI(t)
+	c.JobPolls = 1
+	jobID := createSvmJob(t, api)
+	c.Delays = map[string]time.Duration{"SYS.JOB.GET": time.Minute}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := system.JobWatch(ctx, api, jobID, nil)
+	r.Error(err)
+	r.Contains(err.Error(), "DeadlineExceeded")
+	r.True(time.Since(start) < 10*time.Second)
+}
+
+func Test_Session_Clusters(t *testing.T) {
+	r := require.New(t)
+	c := simapi.NewCluster()
+	c.AddNode("node1")
+	impl := c.Impl()
+
+	// two provider aliases sharing the API server
+	prod := serveAPI(t, impl)
+	dr := serveAPI(t, impl)
+	_, err := system.Connect(dr, &system.ConnectRequest{Host: "dr", User: "backup", Password: "pwd"})
+	r.NoError(err)
+
+	info, err := system.GetConnectInfo(prod)
+	r.NoError(err)
+	r.True(info.Connected)
+	r.Equal("sim", info.Host)
+	r.Equal("admin", info.User)
+
+	info, err = system.GetConnectInfo(dr)
+	r.NoError(err)
+	r.True(info.Connected)
+	r.Equal("dr", info.Host)
+	r.Equal("backup", info.User)
+
+	// shutdown of one client closes its session only
+	r.NoError(dr.Stop())
+	_, err = system.NodeGetByName(dr, "node1")
+	r.Error(err)
+	r.Contains(err.Error(), "API not connected")
+
+	node, err := system.NodeGetByName(prod, "node1")
+	r.NoError(err)
+	r.Equal("node1", node.Name)
+}
+
+// versionImpl is the simulator reporting a build
+type versionImpl struct {
+	grpcapi.GRPCNetAppAPI
+}
+
+func (versionImpl) GetVersion(ctx context.Context) (*grpcapi.VersionResponse, error) {
+	return &grpcapi.VersionResponse{Build: "build", Commands: []string{"SYS.NODE.GET"}}, nil
+}
+
+func Test_GetVersion(t *testing.T) {
+	r := require.New(t)
+
+	for _, test := range []struct {
+		impl grpcapi.GRPCNetAppAPI
+		err  error
+	}{
+		{versionImpl{simapi.NewCluster().Impl()}, nil},
+		{simapi.NewCluster().Impl(), grpcapi.ErrVersionUnsupported},
+	} {
+		client, server := plugin.TestPluginGRPCConn(t, grpcapi.NewPluginMap(test.impl))
+		defer client.Close()
+		defer server.Stop()
+
+		raw, err := client.Dispense("grpcapi")
+		r.NoError(err)
+
+		version, err := raw.(grpcapi.Versioned).GetVersion(context.Background())
+		if test.err != nil {
+			r.Equal(test.err, err)
+			continue
+		}
+
+		r.NoError(err)
+		r.Equal("build", version.Build)
+		r.Equal([]string{"SYS.NODE.GET"}, version.Commands)
+	}
+}
+
+// logImpl is the simulator streaming one log record
+type logImpl struct {
+	grpcapi.GRPCNetAppAPI
+}
+
+func (logImpl) StreamLogs(
+	ctx context.Context, level string,
+	record func(*grpcapi.LogRecord) error) error {
+	return record(&grpcapi.LogRecord{Level: level, Name: "sim", Message: "hello"})
+}
+
+func Test_StreamLogs(t *testing.T) {
+	r := require.New(t)
+
+	for _, test := range []struct {
+		impl grpcapi.GRPCNetAppAPI
+		err  error
+	}{
+		{logImpl{simapi.NewCluster().Impl()}, nil},
+		{simapi.NewCluster().Impl(), grpcapi.ErrLogsUnsupported},
+	} {
+		client, server := plugin.TestPluginGRPCConn(t, grpcapi.NewPluginMap(test.impl))
+		defer client.Close()
+		defer server.Stop()
+
+		raw, err := client.Dispense("grpcapi")
+		r.NoError(err)
+
+		var records []*grpcapi.LogRecord
+		err = raw.(grpcapi.LogStreamer).StreamLogs(context.Background(), "INFO",
+			func(rec *grpcapi.LogRecord) error {
+				records = append(records, rec)
+				return nil
+			})
+		if test.err != nil {
+			r.Equal(test.err, err)
+			continue
+		}
+
+		r.NoError(err)
+		r.Len(records, 1)
+		r.Equal("INFO", records[0].Level)
+		r.Equal("hello", records[0].Message)
+	}
+}
+
+func Test_CallBatch(t *testing.T) {
+	r := require.New(t)
+	c, api := testAPI(t)
+
+	infos, errs := system.PortGetBatch(api, []*system.PortGetRequest{
+		{NodeName: "node1", PortName: "e0c"},
+		{NodeName: "node1", PortName: "e0x"},
+		{NodeName: "node1", PortName: "e0d"},
+	})
+	r.Equal(1, c.BatchCount())
+	r.NoError(errs[0])
+	r.Equal("e0c", infos[0].PortName)
+	r.NoError(errs[1])
+	r.True(infos[1].NonExist)
+	r.NoError(errs[2])
+	r.Equal("e0d", infos[2].PortName)
+
+	// API server without batches, one call per port
+	api = serveAPI(t, struct{ grpcapi.GRPCNetAppAPI }{c.Impl()})
+	infos, errs = system.PortGetBatch(api, []*system.PortGetRequest{
+		{NodeName: "node1", PortName: "e0c"},
+		{NodeName: "node1", PortName: "e0d"},
+	})
+	r.Equal(1, c.BatchCount())
+	r.Equal([]error{nil, nil}, errs)
+	r.Equal("e0c", infos[0].PortName)
+	r.Equal("e0d", infos[1].PortName)
+}
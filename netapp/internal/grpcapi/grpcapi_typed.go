@@ -0,0 +1,441 @@
+package grpcapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// typedRPC executes the command with its typed RPC, the JSON request data
+// is decoded into the typed request and the typed response JSON encoded
+type typedRPC func(
+	ctx context.Context, client GRPCNetAppApiClient,
+	cmd string, data []byte) (*CallResponse, error)
+
+// typedRPCs are the commands with a typed RPC, all others, e.g.
+// SYS.CONNECT or the TEST commands, use the generic JSON Call
+var typedRPCs = map[string]typedRPC{
+	"SYS.NODE.GET":              typed(GRPCNetAppApiClient.NodeGet),
+	"SYS.PORT.GET":              typed(GRPCNetAppApiClient.PortGet),
+	"SYS.PORT.MODIFY":           typed(GRPCNetAppApiClient.PortModify),
+	"SYS.PORT.FIND.PATTERN":     typed(GRPCNetAppApiClient.PortFindPattern),
+	"SYS.PORTGROUP.GET":         typed(GRPCNetAppApiClient.PortGroupGet),
+	"SYS.PORTGROUP.CREATE":      typed(GRPCNetAppApiClient.PortGroupCreate),
+	"SYS.PORTGROUP.PORT.ADD":    typed(GRPCNetAppApiClient.PortGroupPortAdd),
+	"SYS.PORTGROUP.PORT.REMOVE": typed(GRPCNetAppApiClient.PortGroupPortRemove),
+	"SYS.PORTGROUP.DELETE":      typed(GRPCNetAppApiClient.PortGroupDelete),
+	"SYS.AGGR.GET":              typed(GRPCNetAppApiClient.AggrGet),
+	"SYS.JOB.GET":               typed(GRPCNetAppApiClient.JobGet),
+
+	"NW.VLAN.GET":           typed(GRPCNetAppApiClient.VlanGet),
+	"NW.VLAN.CREATE":        typed(GRPCNetAppApiClient.VlanCreate),
+	"NW.VLAN.DELETE":        typed(GRPCNetAppApiClient.VlanDelete),
+	"NW.IPSPACE.GET":        typed(GRPCNetAppApiClient.IPSpaceGet),
+	"NW.IPSPACE.CREATE":     typed(GRPCNetAppApiClient.IPSpaceCreate),
+	"NW.IPSPACE.UPDATE":     typed(GRPCNetAppApiClient.IPSpaceUpdate),
+	"NW.IPSPACE.DELETE":     typed(GRPCNetAppApiClient.IPSpaceDelete),
+	"NW.BRCDOM.GET":         typed(GRPCNetAppApiClient.BcDomainGet),
+	"NW.BRCDOM.STATUS":      typed(GRPCNetAppApiClient.BcDomainStatus),
+	"NW.BRCDOM.CREATE":      typed(GRPCNetAppApiClient.BcDomainCreate),
+	"NW.BRCDOM.RENAME":      typed(GRPCNetAppApiClient.BcDomainRename),
+	"NW.BRCDOM.PORT.ADD":    typed(GRPCNetAppApiClient.BcDomainPortAdd),
+	"NW.BRCDOM.PORT.REMOVE": typed(GRPCNetAppApiClient.BcDomainPortRemove),
+	"NW.BRCDOM.UPDATE":      typed(GRPCNetAppApiClient.BcDomainUpdate),
+	"NW.BRCDOM.DELETE":      typed(GRPCNetAppApiClient.BcDomainDelete),
+	"NW.SUBNET.GET":         typed(GRPCNetAppApiClient.SubnetGet),
+	"NW.SUBNET.CREATE":      typed(GRPCNetAppApiClient.SubnetCreate),
+	"NW.SUBNET.DELETE":      typed(GRPCNetAppApiClient.SubnetDelete),
+	"NW.SUBNET.RENAME":      typed(GRPCNetAppApiClient.SubnetRename),
+	"NW.SUBNET.IPR.ADD":     typed(GRPCNetAppApiClient.SubnetIPRangeAdd),
+	"NW.SUBNET.IPR.REMOVE":  typed(GRPCNetAppApiClient.SubnetIPRangeRemove),
+	"NW.SUBNET.MODIFY":      typed(GRPCNetAppApiClient.SubnetModify),
+
+	"SVM.GET":          typed(GRPCNetAppApiClient.SvmGet),
+	"SVM.CREATE":       typed(GRPCNetAppApiClient.SvmCreate),
+	"SVM.DELETE":       typed(GRPCNetAppApiClient.SvmDelete),
+	"SVM.START":        typed(GRPCNetAppApiClient.SvmStart),
+	"SVM.STOP":         typed(GRPCNetAppApiClient.SvmStop),
+	"SVM.UNLOCK":       typed(GRPCNetAppApiClient.SvmUnlock),
+	"SVM.RENAME":       typed(GRPCNetAppApiClient.SvmRename),
+	"SVM.VOL.ONLINE":   typed(GRPCNetAppApiClient.VolumeOnline),
+	"SVM.VOL.OFFLINE":  typed(GRPCNetAppApiClient.VolumeOffline),
+	"SVM.VOL.RESTRICT": typed(GRPCNetAppApiClient.VolumeRestrict),
+	"SVM.VOL.DELETE":   typed(GRPCNetAppApiClient.VolumeDelete),
+	"SVM.VOL.SIZE":     typed(GRPCNetAppApiClient.VolumeSize),
+}
+
+// decodeStrict JSON decodes data into value, keys unknown to value are an
+// error instead of being dropped silently
+func decodeStrict(data []byte, value interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(value)
+}
+
+// hasZeroValue reports whether the JSON request sets a key to its zero
+// value, e.g. an empty SVM ipspace. Proto3 does not transmit zero values,
+// the Python commands would not see the key at all
+func hasZeroValue(data []byte) bool {
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return false
+	}
+
+	for _, value := range fields {
+		switch v := value.(type) {
+		case nil:
+			return true
+		case string:
+			if v == "" {
+				return true
+			}
+		case float64:
+			if v == 0 {
+				return true
+			}
+		case bool:
+			if !v {
+				return true
+			}
+		case []interface{}:
+			if len(v) == 0 {
+				return true
+			}
+		case map[string]interface{}:
+			if len(v) == 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+var (
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// typed returns the typedRPC for the generated client method expression,
+// e.g. GRPCNetAppApiClient.NodeGet. A status ABORTED is the failure of the
+// command itself, not of the RPC, with the ZAPI errno in the trailer
+// metadata. Requests with zero values use the generic JSON call
+func typed(method interface{}) typedRPC {
+	fn := reflect.ValueOf(method)
+	if fn.Kind() != reflect.Func || fn.Type().NumIn() != 4 ||
+		fn.Type().In(1) != contextType || fn.Type().In(2).Kind() != reflect.Ptr ||
+		fn.Type().NumOut() != 2 || fn.Type().Out(1) != errorType {
+		panic(fmt.Sprintf("typed RPC needs a client method expression, got: %T", method))
+	}
+	requestType := fn.Type().In(2).Elem()
+
+	return func(
+		ctx context.Context, client GRPCNetAppApiClient,
+		cmd string, data []byte) (*CallResponse, error) {
+
+		request := reflect.New(requestType).Interface()
+		if err := decodeStrict(data, request); err != nil {
+			return nil, fmt.Errorf(
+				"cmd [%s] request does not match %T, got: %s", cmd, request, err)
+		}
+
+		if hasZeroValue(data) {
+			return client.Call(ctx, &CallRequest{Cmd: cmd, Data: data})
+		}
+
+		var trailer metadata.MD
+		out := fn.Call([]reflect.Value{
+			reflect.ValueOf(client), reflect.ValueOf(ctx),
+			reflect.ValueOf(request), reflect.ValueOf(grpc.Trailer(&trailer))})
+		if err, _ := out[1].Interface().(error); err != nil {
+			if st, ok := status.FromError(err); ok && st.Code() == codes.Aborted {
+				return &CallResponse{
+					Errmsg: st.Message(), Errno: trailerErrno(trailer)}, nil
+			}
+			return nil, err
+		}
+
+		resData, err := json.Marshal(out[0].Interface())
+		if err != nil {
+			return nil, fmt.Errorf(
+				"cmd [%s] response marshal error: %s", cmd, err)
+		}
+
+		return &CallResponse{Success: true, Data: resData}, nil
+	}
+}
+
+// serveTyped executes the typed RPC request with the JSON Impl into
+// response, a failed command aborts the RPC with the command error message
+// and errno
+func serveTyped(
+	ctx context.Context, impl GRPCNetAppAPI,
+	cmd string, request, response interface{}) error {
+
+	data, err := json.Marshal(request)
+	if err != nil {
+		return status.Errorf(
+			codes.Internal, "cmd [%s] request marshal error: %s", cmd, err)
+	}
+
+	resp, err := impl.Call(ctx, cmd, data)
+	if err != nil {
+		return err
+	}
+	if !resp.Success || resp.Errmsg != "" {
+		setErrnoTrailer(ctx, resp.Errno)
+		return status.Error(codes.Aborted, resp.Errmsg)
+	}
+
+	if err := decodeStrict(resp.Data, response); err != nil {
+		return status.Errorf(
+			codes.Internal, "cmd [%s] response does not match %T, got: %s",
+			cmd, response, err)
+	}
+
+	return nil
+}
+
+//*****************************************************************************
+// system
+//*****************************************************************************
+
+func (m *gRPCServer) NodeGet(ctx context.Context, req *NodeRequest) (*NodeInfo, error) {
+	resp := &NodeInfo{}
+	return resp, serveTyped(ctx, m.Impl, "SYS.NODE.GET", req, resp)
+}
+
+func (m *gRPCServer) PortGet(ctx context.Context, req *PortRequest) (*PortInfo, error) {
+	resp := &PortInfo{}
+	return resp, serveTyped(ctx, m.Impl, "SYS.PORT.GET", req, resp)
+}
+
+func (m *gRPCServer) PortModify(ctx context.Context, req *PortModifyRequest) (*EmptyResponse, error) {
+	resp := &EmptyResponse{}
+	return resp, serveTyped(ctx, m.Impl, "SYS.PORT.MODIFY", req, resp)
+}
+
+func (m *gRPCServer) PortFindPattern(ctx context.Context, req *PortRequest) (*PortFindResponse, error) {
+	resp := &PortFindResponse{}
+	return resp, serveTyped(ctx, m.Impl, "SYS.PORT.FIND.PATTERN", req, resp)
+}
+
+func (m *gRPCServer) PortGroupGet(ctx context.Context, req *PortGroupRequest) (*PortGroupInfo, error) {
+	resp := &PortGroupInfo{}
+	return resp, serveTyped(ctx, m.Impl, "SYS.PORTGROUP.GET", req, resp)
+}
+
+func (m *gRPCServer) PortGroupCreate(ctx context.Context, req *PortGroupRequest) (*EmptyResponse, error) {
+	resp := &EmptyResponse{}
+	return resp, serveTyped(ctx, m.Impl, "SYS.PORTGROUP.CREATE", req, resp)
+}
+
+func (m *gRPCServer) PortGroupPortAdd(ctx context.Context, req *PortGroupRequest) (*EmptyResponse, error) {
+	resp := &EmptyResponse{}
+	return resp, serveTyped(ctx, m.Impl, "SYS.PORTGROUP.PORT.ADD", req, resp)
+}
+
+func (m *gRPCServer) PortGroupPortRemove(ctx context.Context, req *PortGroupRequest) (*EmptyResponse, error) {
+	resp := &EmptyResponse{}
+	return resp, serveTyped(ctx, m.Impl, "SYS.PORTGROUP.PORT.REMOVE", req, resp)
+}
+
+func (m *gRPCServer) PortGroupDelete(ctx context.Context, req *PortGroupRequest) (*EmptyResponse, error) {
+	resp := &EmptyResponse{}
+	return resp, serveTyped(ctx, m.Impl, "SYS.PORTGROUP.DELETE", req, resp)
+}
+
+func (m *gRPCServer) AggrGet(ctx context.Context, req *AggrRequest) (*AggrInfo, error) {
+	resp := &AggrInfo{}
+	return resp, serveTyped(ctx, m.Impl, "SYS.AGGR.GET", req, resp)
+}
+
+func (m *gRPCServer) JobGet(ctx context.Context, req *JobRequest) (*JobInfo, error) {
+	resp := &JobInfo{}
+	return resp, serveTyped(ctx, m.Impl, "SYS.JOB.GET", req, resp)
+}
+
+//*****************************************************************************
+// network
+//*****************************************************************************
+
+func (m *gRPCServer) VlanGet(ctx context.Context, req *VlanRequest) (*VlanInfo, error) {
+	resp := &VlanInfo{}
+	return resp, serveTyped(ctx, m.Impl, "NW.VLAN.GET", req, resp)
+}
+
+func (m *gRPCServer) VlanCreate(ctx context.Context, req *VlanRequest) (*EmptyResponse, error) {
+	resp := &EmptyResponse{}
+	return resp, serveTyped(ctx, m.Impl, "NW.VLAN.CREATE", req, resp)
+}
+
+func (m *gRPCServer) VlanDelete(ctx context.Context, req *VlanRequest) (*EmptyResponse, error) {
+	resp := &EmptyResponse{}
+	return resp, serveTyped(ctx, m.Impl, "NW.VLAN.DELETE", req, resp)
+}
+
+func (m *gRPCServer) IPSpaceGet(ctx context.Context, req *IPSpaceRequest) (*IPSpaceInfo, error) {
+	resp := &IPSpaceInfo{}
+	return resp, serveTyped(ctx, m.Impl, "NW.IPSPACE.GET", req, resp)
+}
+
+func (m *gRPCServer) IPSpaceCreate(ctx context.Context, req *IPSpaceRequest) (*IPSpaceInfo, error) {
+	resp := &IPSpaceInfo{}
+	return resp, serveTyped(ctx, m.Impl, "NW.IPSPACE.CREATE", req, resp)
+}
+
+func (m *gRPCServer) IPSpaceUpdate(ctx context.Context, req *IPSpaceRequest) (*EmptyResponse, error) {
+	resp := &EmptyResponse{}
+	return resp, serveTyped(ctx, m.Impl, "NW.IPSPACE.UPDATE", req, resp)
+}
+
+func (m *gRPCServer) IPSpaceDelete(ctx context.Context, req *IPSpaceRequest) (*EmptyResponse, error) {
+	resp := &EmptyResponse{}
+	return resp, serveTyped(ctx, m.Impl, "NW.IPSPACE.DELETE", req, resp)
+}
+
+func (m *gRPCServer) BcDomainGet(ctx context.Context, req *BcDomainRequest) (*BcDomainInfo, error) {
+	resp := &BcDomainInfo{}
+	return resp, serveTyped(ctx, m.Impl, "NW.BRCDOM.GET", req, resp)
+}
+
+func (m *gRPCServer) BcDomainStatus(ctx context.Context, req *BcDomainRequest) (*BcDomainInfo, error) {
+	resp := &BcDomainInfo{}
+	return resp, serveTyped(ctx, m.Impl, "NW.BRCDOM.STATUS", req, resp)
+}
+
+func (m *gRPCServer) BcDomainCreate(ctx context.Context, req *BcDomainRequest) (*BcDomainInfo, error) {
+	resp := &BcDomainInfo{}
+	return resp, serveTyped(ctx, m.Impl, "NW.BRCDOM.CREATE", req, resp)
+}
+
+func (m *gRPCServer) BcDomainRename(ctx context.Context, req *BcDomainRequest) (*EmptyResponse, error) {
+	resp := &EmptyResponse{}
+	return resp, serveTyped(ctx, m.Impl, "NW.BRCDOM.RENAME", req, resp)
+}
+
+func (m *gRPCServer) BcDomainPortAdd(ctx context.Context, req *BcDomainRequest) (*BcDomainInfo, error) {
+	resp := &BcDomainInfo{}
+	return resp, serveTyped(ctx, m.Impl, "NW.BRCDOM.PORT.ADD", req, resp)
+}
+
+func (m *gRPCServer) BcDomainPortRemove(ctx context.Context, req *BcDomainRequest) (*BcDomainInfo, error) {
+	resp := &BcDomainInfo{}
+	return resp, serveTyped(ctx, m.Impl, "NW.BRCDOM.PORT.REMOVE", req, resp)
+}
+
+func (m *gRPCServer) BcDomainUpdate(ctx context.Context, req *BcDomainRequest) (*BcDomainInfo, error) {
+	resp := &BcDomainInfo{}
+	return resp, serveTyped(ctx, m.Impl, "NW.BRCDOM.UPDATE", req, resp)
+}
+
+func (m *gRPCServer) BcDomainDelete(ctx context.Context, req *BcDomainRequest) (*BcDomainInfo, error) {
+	resp := &BcDomainInfo{}
+	return resp, serveTyped(ctx, m.Impl, "NW.BRCDOM.DELETE", req, resp)
+}
+
+func (m *gRPCServer) SubnetGet(ctx context.Context, req *SubnetRequest) (*SubnetInfo, error) {
+	resp := &SubnetInfo{}
+	return resp, serveTyped(ctx, m.Impl, "NW.SUBNET.GET", req, resp)
+}
+
+func (m *gRPCServer) SubnetCreate(ctx context.Context, req *SubnetRequest) (*SubnetInfo, error) {
+	resp := &SubnetInfo{}
+	return resp, serveTyped(ctx, m.Impl, "NW.SUBNET.CREATE", req, resp)
+}
+
+func (m *gRPCServer) SubnetDelete(ctx context.Context, req *SubnetRequest) (*EmptyResponse, error) {
+	resp := &EmptyResponse{}
+	return resp, serveTyped(ctx, m.Impl, "NW.SUBNET.DELETE", req, resp)
+}
+
+func (m *gRPCServer) SubnetRename(ctx context.Context, req *SubnetRequest) (*EmptyResponse, error) {
+	resp := &EmptyResponse{}
+	return resp, serveTyped(ctx, m.Impl, "NW.SUBNET.RENAME", req, resp)
+}
+
+func (m *gRPCServer) SubnetIPRangeAdd(ctx context.Context, req *SubnetRequest) (*EmptyResponse, error) {
+	resp := &EmptyResponse{}
+	return resp, serveTyped(ctx, m.Impl, "NW.SUBNET.IPR.ADD", req, resp)
+}
+
+func (m *gRPCServer) SubnetIPRangeRemove(ctx context.Context, req *SubnetRequest) (*EmptyResponse, error) {
+	resp := &EmptyResponse{}
+	return resp, serveTyped(ctx, m.Impl, "NW.SUBNET.IPR.REMOVE", req, resp)
+}
+
+func (m *gRPCServer) SubnetModify(ctx context.Context, req *SubnetRequest) (*EmptyResponse, error) {
+	resp := &EmptyResponse{}
+	return resp, serveTyped(ctx, m.Impl, "NW.SUBNET.MODIFY", req, resp)
+}
+
+//*****************************************************************************
+// SVM
+//*****************************************************************************
+
+func (m *gRPCServer) SvmGet(ctx context.Context, req *SvmRequest) (*SvmInfo, error) {
+	resp := &SvmInfo{}
+	return resp, serveTyped(ctx, m.Impl, "SVM.GET", req, resp)
+}
+
+func (m *gRPCServer) SvmCreate(ctx context.Context, req *SvmRequest) (*SvmJobResult, error) {
+	resp := &SvmJobResult{}
+	return resp, serveTyped(ctx, m.Impl, "SVM.CREATE", req, resp)
+}
+
+func (m *gRPCServer) SvmDelete(ctx context.Context, req *SvmRequest) (*SvmJobResult, error) {
+	resp := &SvmJobResult{}
+	return resp, serveTyped(ctx, m.Impl, "SVM.DELETE", req, resp)
+}
+
+func (m *gRPCServer) SvmStart(ctx context.Context, req *SvmRequest) (*EmptyResponse, error) {
+	resp := &EmptyResponse{}
+	return resp, serveTyped(ctx, m.Impl, "SVM.START", req, resp)
+}
+
+func (m *gRPCServer) SvmStop(ctx context.Context, req *SvmRequest) (*EmptyResponse, error) {
+	resp := &EmptyResponse{}
+	return resp, serveTyped(ctx, m.Impl, "SVM.STOP", req, resp)
+}
+
+func (m *gRPCServer) SvmUnlock(ctx context.Context, req *SvmRequest) (*EmptyResponse, error) {
+	resp := &EmptyResponse{}
+	return resp, serveTyped(ctx, m.Impl, "SVM.UNLOCK", req, resp)
+}
+
+func (m *gRPCServer) SvmRename(ctx context.Context, req *SvmRequest) (*EmptyResponse, error) {
+	resp := &EmptyResponse{}
+	return resp, serveTyped(ctx, m.Impl, "SVM.RENAME", req, resp)
+}
+
+func (m *gRPCServer) VolumeOnline(ctx context.Context, req *VolumeRequest) (*EmptyResponse, error) {
+	resp := &EmptyResponse{}
+	return resp, serveTyped(ctx, m.Impl, "SVM.VOL.ONLINE", req, resp)
+}
+
+func (m *gRPCServer) VolumeOffline(ctx context.Context, req *VolumeRequest) (*EmptyResponse, error) {
+	resp := &EmptyResponse{}
+	return resp, serveTyped(ctx, m.Impl, "SVM.VOL.OFFLINE", req, resp)
+}
+
+func (m *gRPCServer) VolumeRestrict(ctx context.Context, req *VolumeRequest) (*EmptyResponse, error) {
+	resp := &EmptyResponse{}
+	return resp, serveTyped(ctx, m.Impl, "SVM.VOL.RESTRICT", req, resp)
+}
+
+func (m *gRPCServer) VolumeDelete(ctx context.Context, req *VolumeRequest) (*EmptyResponse, error) {
+	resp := &EmptyResponse{}
+	return resp, serveTyped(ctx, m.Impl, "SVM.VOL.DELETE", req, resp)
+}
+
+func (m *gRPCServer) VolumeSize(ctx context.Context, req *VolumeRequest) (*VolumeInfo, error) {
+	resp := &VolumeInfo{}
+	return resp, serveTyped(ctx, m.Impl, "SVM.VOL.SIZE", req, resp)
+}
@@ -0,0 +1,48 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrVersionUnsupported is returned by API servers without GetVersion RPC,
+// e.g. of a provider version before the version handshake
+var ErrVersionUnsupported = errors.New("version not supported by the API")
+
+// Versioned is implemented by APIs reporting their build and the commands
+// they execute, see VersionResponse
+type Versioned interface {
+	GetVersion(ctx context.Context) (*VersionResponse, error)
+}
+
+// NewClient returns the API talking over the gRPC connection, e.g. to check
+// a running API server without go-plugin
+func NewClient(conn *grpc.ClientConn) PythonAPI {
+	return &gRPCClient{client: NewGRPCNetAppApiClient(conn)}
+}
+
+func (m *gRPCClient) GetVersion(ctx context.Context) (*VersionResponse, error) {
+	resp, err := m.client.GetVersion(ctx, &VersionRequest{})
+	if status.Code(err) == codes.Unimplemented {
+		return nil, ErrVersionUnsupported
+	}
+
+	return resp, err
+}
+
+// GetVersion returns the version of Impl, which must be Versioned
+func (m *gRPCServer) GetVersion(
+	ctx context.Context, req *VersionRequest) (*VersionResponse, error) {
+
+	versioned, ok := m.Impl.(Versioned)
+	if !ok {
+		return nil, status.Errorf(
+			codes.Unimplemented, "version not supported by %T", m.Impl)
+	}
+
+	return versioned.GetVersion(ctx)
+}
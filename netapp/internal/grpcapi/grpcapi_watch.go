@@ -0,0 +1,109 @@
+package grpcapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrWatchUnsupported is returned by job watches of APIs which can only
+// be polled with SYS.JOB.GET
+var ErrWatchUnsupported = errors.New("job watch not supported by the API")
+
+// JobWatcher is implemented by APIs that push the state changes of long
+// running ONTAP jobs. The data is the JSON job request, update is called
+// with the JSON job info on every job state or progress change until the
+// job ended, update fails or the context is done. Like Call, a failed
+// command is returned as success/errmsg, transport errors as error
+type JobWatcher interface {
+	WatchJob(ctx context.Context, data []byte,
+		update func(data []byte) error) (bool, string, error)
+}
+
+// WatchJob consumes the job info stream of the WatchJob RPC
+func (m *gRPCClient) WatchJob(
+	ctx context.Context, data []byte,
+	update func(data []byte) error) (bool, string, error) {
+
+	request := &WatchJobRequest{}
+	if err := decodeStrict(data, request); err != nil {
+		return false, "", fmt.Errorf(
+			"job watch request does not match %T, got: %s", request, err)
+	}
+
+	// cancel ends the stream on the server as well if update fails
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := m.client.WatchJob(ctx, request)
+	if err != nil {
+		return false, "", err
+	}
+
+	for {
+		info, err := stream.Recv()
+		if err == io.EOF {
+			return true, "", nil
+		}
+		if err != nil {
+			switch status.Code(err) {
+			case codes.Aborted:
+				return false, status.Convert(err).Message(), nil
+			case codes.Unimplemented:
+				return false, "", ErrWatchUnsupported
+			}
+			return false, "", err
+		}
+
+		infoData, err := json.Marshal(info)
+		if err != nil {
+			return false, "", fmt.Errorf("job watch info marshal error: %s", err)
+		}
+
+		if err := update(infoData); err != nil {
+			return false, "", err
+		}
+	}
+}
+
+// WatchJob streams the job info of Impl, which must be a JobWatcher
+func (m *gRPCServer) WatchJob(
+	req *WatchJobRequest, stream GRPCNetAppApi_WatchJobServer) error {
+
+	watcher, ok := m.Impl.(JobWatcher)
+	if !ok {
+		return status.Errorf(
+			codes.Unimplemented, "job watch not supported by %T", m.Impl)
+	}
+
+	data, err := json.Marshal(req)
+	if err != nil {
+		return status.Errorf(
+			codes.Internal, "job watch request marshal error: %s", err)
+	}
+
+	succ, errmsg, err := watcher.WatchJob(
+		stream.Context(), data, func(data []byte) error {
+			info := &JobInfo{}
+			if err := decodeStrict(data, info); err != nil {
+				return status.Errorf(
+					codes.Internal, "job watch info does not match %T, got: %s",
+					info, err)
+			}
+
+			return stream.Send(info)
+		})
+	if err != nil {
+		return err
+	}
+	if !succ || errmsg != "" {
+		return status.Error(codes.Aborted, errmsg)
+	}
+
+	return nil
+}
@@ -0,0 +1,224 @@
+package cassette
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/pythonapi"
+)
+
+const (
+	// ModeRecord writes each API call to the cassette file
+	ModeRecord = "record"
+	// ModeReplay serves API calls from the cassette file
+	ModeReplay = "replay"
+)
+
+// Interaction is a single recorded API call
+type Interaction struct {
+	Command  string          `json:"command"`
+	Request  json.RawMessage `json:"request"`
+	Response json.RawMessage `json:"response,omitempty"`
+	Error    string          `json:"error,omitempty"`
+	Errno    int             `json:"errno,omitempty"` // ZAPI errno of Error
+}
+
+//*****************************************************************************
+// recording
+//*****************************************************************************
+
+// Recorder appends every API call passing through its middleware to
+// the cassette file, one JSON encoded interaction per line
+type Recorder struct {
+	mutex sync.Mutex
+	file  *os.File
+}
+
+// NewRecorder creates/truncates the cassette file for recording
+func NewRecorder(path string) (*Recorder, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("could not create cassette [%s], got: %s", path, err)
+	}
+
+	return &Recorder{file: file}, nil
+}
+
+// Close closes the cassette file
+func (rec *Recorder) Close() error {
+	rec.mutex.Lock()
+	defer rec.mutex.Unlock()
+
+	return rec.file.Close()
+}
+
+func (rec *Recorder) record(inter *Interaction) error {
+	line, err := json.Marshal(inter)
+	if err != nil {
+		return err
+	}
+
+	rec.mutex.Lock()
+	defer rec.mutex.Unlock()
+
+	// write per call, a killed terraform run keeps everything up to here
+	_, err = rec.file.Write(append(line, '\n'))
+	return err
+}
+
+// Middleware records the calls of the wrapped backend, recording
+// failures are logged and never fail the API call itself. Job watches are
+// not passed on, jobs are polled with recorded SYS.JOB.GET calls instead
+// so that the cassette can be replayed
+func (rec *Recorder) Middleware() pythonapi.Middleware {
+	return func(next pythonapi.Backend) pythonapi.Backend {
+		return pythonapi.BackendFunc(func(
+			ctx context.Context, cmdName string,
+			request, response interface{}) error {
+
+			callErr := next.Call(ctx, cmdName, request, response)
+
+			inter := &Interaction{Command: cmdName}
+			var err error
+			inter.Request, err = pythonapi.MarshalRedacted(request)
+			if err == nil {
+				if callErr != nil {
+					inter.Error = callErr.Error()
+					inter.Errno = pythonapi.Errno(callErr)
+				} else {
+					inter.Response, err = pythonapi.MarshalRedacted(response)
+				}
+			}
+			if err == nil {
+				err = rec.record(inter)
+			}
+			if err != nil {
+				log.Printf("[WARN] could not record api call [%s], got: %s", cmdName, err)
+			}
+
+			return callErr
+		})
+	}
+}
+
+//*****************************************************************************
+// replay
+//*****************************************************************************
+
+// Player serves API calls from recorded interactions
+type Player struct {
+	mutex        sync.Mutex
+	interactions []*Interaction
+	used         []bool
+}
+
+// Load reads the cassette file for replay
+func Load(path string) (*Player, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not open cassette [%s], got: %s", path, err)
+	}
+	defer file.Close()
+
+	player := &Player{}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		inter := &Interaction{}
+		if err := json.Unmarshal(line, inter); err != nil {
+			return nil, fmt.Errorf(
+				"invalid interaction in cassette [%s] line %d, got: %s", path, lineNo, err)
+		}
+		// normalize in case the cassette was edited manually
+		if inter.Request, err = pythonapi.Redact(inter.Request); err != nil {
+			return nil, fmt.Errorf(
+				"invalid request in cassette [%s] line %d, got: %s", path, lineNo, err)
+		}
+
+		player.interactions = append(player.interactions, inter)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("could not read cassette [%s], got: %s", path, err)
+	}
+
+	player.used = make([]bool, len(player.interactions))
+	return player, nil
+}
+
+// next returns the first unused interaction matching the command and
+// request, repeated identical calls (e.g. job polls) replay in order
+func (p *Player) next(cmdName string, request json.RawMessage) *Interaction {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	for idx, inter := range p.interactions {
+		if p.used[idx] || inter.Command != cmdName {
+			continue
+		}
+
+		if bytes.Equal(inter.Request, request) {
+			p.used[idx] = true
+			return inter
+		}
+	}
+
+	return nil
+}
+
+// Call serves the API call from the cassette
+func (p *Player) Call(
+	ctx context.Context, cmdName string,
+	request, response interface{}) error {
+
+	reqData, err := pythonapi.MarshalRedacted(request)
+	if err != nil {
+		return fmt.Errorf("api call [%s] request marshal error: %s", cmdName, err)
+	}
+
+	inter := p.next(cmdName, reqData)
+	if inter == nil {
+		return fmt.Errorf(
+			"api call [%s] with request %s not found in cassette", cmdName, reqData)
+	}
+
+	if inter.Errno != 0 {
+		// keeps the error kind, e.g. pythonapi.ErrNotFound
+		return &pythonapi.APIError{Cmd: cmdName, Errno: inter.Errno, Msg: inter.Error}
+	}
+	if inter.Error != "" {
+		return errors.New(inter.Error)
+	}
+
+	if err := json.Unmarshal(inter.Response, response); err != nil {
+		return fmt.Errorf("api call [%s] response unmarshal error: %s", cmdName, err)
+	}
+
+	return nil
+}
+
+// Remaining returns the number of interactions not replayed yet
+func (p *Player) Remaining() int {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	count := 0
+	for _, used := range p.used {
+		if !used {
+			count++
+		}
+	}
+
+	return count
+}
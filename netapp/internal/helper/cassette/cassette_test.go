@@ -0,0 +1,149 @@
+package cassette
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/pythonapi"
+)
+
+type testRequest struct {
+	Name     string            `json:"name"`
+	Password string            `json:"password,omitempty"`
+	Options  map[string]string `json:"options,omitempty"`
+}
+
+type testResponse struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// testBackend answers with the request name and counts the calls
+type testBackend struct {
+	calls int
+}
+
+func (b *testBackend) Call(
+	ctx context.Context, cmdName string,
+	request, response interface{}) error {
+	b.calls++
+	req := request.(*testRequest)
+	if req.Name == "fail" {
+		return errors.New("api call [" + cmdName + "] failed with msg: boom")
+	}
+	if req.Name == "gone" {
+		return pythonapi.NewAPIError(cmdName, pythonapi.ErrnoObjectNotFound, "gone")
+	}
+
+	resp := response.(*testResponse)
+	resp.Name = req.Name
+	resp.Count = b.calls
+	return nil
+}
+
+func testCassettePath(t *testing.T) string {
+	folder, err := ioutil.TempDir("", "cassette")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(folder) })
+
+	return filepath.Join(folder, "test.cassette")
+}
+
+func Test_Cassette_Redact(t *testing.T) {
+	r := require.New(t)
+
+	data, err := pythonapi.Redact([]byte(
+		`{"user":"admin","Password":"secret","pwd":"p","nested":[{"api_token":"t","id":1}]}`))
+	r.NoError(err)
+	r.Equal(
+		`{"Password":"REDACTED","nested":[{"api_token":"REDACTED","id":1}],"pwd":"REDACTED","user":"admin"}`,
+		string(data))
+}
+
+func Test_Cassette_RecordReplay(t *testing.T) {
+	r := require.New(t)
+	path := testCassettePath(t)
+
+	rec, err := NewRecorder(path)
+	r.NoError(err)
+	backend := pythonapi.Wrap(&testBackend{}, rec.Middleware())
+
+	for _, name := range []string{"a", "a", "fail"} {
+		resp := &testResponse{}
+		backend.Call(context.Background(), "TEST.CMD",
+			&testRequest{Name: name, Password: "secret"}, resp)
+	}
+	r.NoError(rec.Close())
+
+	data, err := ioutil.ReadFile(path)
+	r.NoError(err)
+	r.NotContains(string(data), "secret")
+
+	info, err := os.Stat(path)
+	r.NoError(err)
+	r.Equal(os.FileMode(0600), info.Mode().Perm())
+
+	player, err := Load(path)
+	r.NoError(err)
+	r.Equal(3, player.Remaining())
+
+	// identical calls replay in recorded order, password is not compared
+	for _, count := range []int{1, 2} {
+		resp := &testResponse{}
+		err = player.Call(context.Background(), "TEST.CMD",
+			&testRequest{Name: "a", Password: "other"}, resp)
+		r.NoError(err)
+		r.Equal(testResponse{Name: "a", Count: count}, *resp)
+	}
+
+	err = player.Call(context.Background(), "TEST.CMD",
+		&testRequest{Name: "fail", Password: "other"}, &testResponse{})
+	r.EqualError(err, "api call [TEST.CMD] failed with msg: boom")
+	r.Equal(0, player.Remaining())
+
+	err = player.Call(context.Background(), "TEST.CMD",
+		&testRequest{Name: "a"}, &testResponse{})
+	r.Error(err)
+	r.Contains(err.Error(), "not found in cassette")
+}
+
+func Test_Cassette_LoadInvalid(t *testing.T) {
+	r := require.New(t)
+	path := testCassettePath(t)
+
+	_, err := Load(path)
+	r.Error(err)
+
+	r.NoError(ioutil.WriteFile(path, []byte("{\"command\":\"X\",\"request\":{}}\nnot json\n"), 0600))
+	_, err = Load(path)
+	r.Error(err)
+	r.Contains(err.Error(), "line 2")
+}
+
+func Test_Cassette_ReplayErrno(t *testing.T) {
+	r := require.New(t)
+	path := testCassettePath(t)
+
+	rec, err := NewRecorder(path)
+	r.NoError(err)
+	backend := pythonapi.Wrap(&testBackend{}, rec.Middleware())
+	err = backend.Call(context.Background(), "TEST.CMD",
+		&testRequest{Name: "gone"}, &testResponse{})
+	r.True(errors.Is(err, pythonapi.ErrNotFound))
+	r.NoError(rec.Close())
+
+	player, err := Load(path)
+	r.NoError(err)
+
+	err = player.Call(context.Background(), "TEST.CMD",
+		&testRequest{Name: "gone"}, &testResponse{})
+	r.EqualError(err, "api call [TEST.CMD] failed with msg: gone")
+	r.True(errors.Is(err, pythonapi.ErrNotFound))
+	r.Equal(pythonapi.ErrnoObjectNotFound, pythonapi.Errno(err))
+}
@@ -1,6 +1,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"time"
@@ -40,7 +41,7 @@ type VlanInfo struct {
 	Name string `json:"name,omitempty"` // <interface-name>
 }
 
-func VlanGet(client *pythonapi.NetAppAPI, request *VlanRequest) (*VlanInfo, error) {
+func VlanGet(client pythonapi.Backend, request *VlanRequest) (*VlanInfo, error) {
 	resp := VlanInfo{}
 	err := pythonapi.MakeAPICall(client, vlanGetCmd, request, &resp)
 
@@ -49,14 +50,14 @@ func VlanGet(client *pythonapi.NetAppAPI, request *VlanRequest) (*VlanInfo, erro
 
 const vlanCreateCmd = "NW.VLAN.CREATE"
 
-func VlanCreate(client *pythonapi.NetAppAPI, request *VlanRequest) error {
+func VlanCreate(client pythonapi.Backend, request *VlanRequest) error {
 	resp := pythonapi.EmptyResponse{}
 	return pythonapi.MakeAPICall(client, vlanCreateCmd, request, &resp)
 }
 
 const vlanDeleteCmd = "NW.VLAN.DELETE"
 
-func VlanDelete(client *pythonapi.NetAppAPI, request *VlanRequest) error {
+func VlanDelete(client pythonapi.Backend, request *VlanRequest) error {
 	resp := pythonapi.EmptyResponse{}
 	return pythonapi.MakeAPICall(client, vlanDeleteCmd, request, &resp)
 }
@@ -78,7 +79,7 @@ type IPSpaceInfo struct {
 	VServers         []string `json:"vservers"`   // <vservers>
 }
 
-func IPSpaceGetByUUID(client *pythonapi.NetAppAPI, uuid string) (*IPSpaceInfo, error) {
+func IPSpaceGetByUUID(client pythonapi.Backend, uuid string) (*IPSpaceInfo, error) {
 	req := &IPSpaceRequest{UUID: uuid}
 	resp := &IPSpaceInfo{}
 	err := pythonapi.MakeAPICall(client, ipspaceGetCmd, req, resp)
@@ -88,7 +89,7 @@ func IPSpaceGetByUUID(client *pythonapi.NetAppAPI, uuid string) (*IPSpaceInfo, e
 	return resp, nil
 }
 
-func IPSpaceGetByName(client *pythonapi.NetAppAPI, name string) (*IPSpaceInfo, error) {
+func IPSpaceGetByName(client pythonapi.Backend, name string) (*IPSpaceInfo, error) {
 	req := &IPSpaceRequest{Name: name}
 	resp := &IPSpaceInfo{}
 	err := pythonapi.MakeAPICall(client, ipspaceGetCmd, req, resp)
@@ -100,7 +101,7 @@ func IPSpaceGetByName(client *pythonapi.NetAppAPI, name string) (*IPSpaceInfo, e
 
 const ipspaceCreateCmd = "NW.IPSPACE.CREATE"
 
-func IPSpaceCreate(client *pythonapi.NetAppAPI, name string) (string, error) {
+func IPSpaceCreate(client pythonapi.Backend, name string) (string, error) {
 	req := &IPSpaceRequest{Name: name}
 	resp := &IPSpaceInfo{}
 	err := pythonapi.MakeAPICall(client, ipspaceCreateCmd, req, resp)
@@ -113,7 +114,7 @@ func IPSpaceCreate(client *pythonapi.NetAppAPI, name string) (string, error) {
 
 const ipspaceUpdateCmd = "NW.IPSPACE.UPDATE"
 
-func IPSpaceUpdate(client *pythonapi.NetAppAPI, name string, newName string) error {
+func IPSpaceUpdate(client pythonapi.Backend, name string, newName string) error {
 	req := &IPSpaceRequest{Name: name, NewName: newName}
 	resp := &pythonapi.EmptyResponse{}
 	return pythonapi.MakeAPICall(client, ipspaceUpdateCmd, req, resp)
@@ -121,7 +122,7 @@ func IPSpaceUpdate(client *pythonapi.NetAppAPI, name string, newName string) err
 
 const ipspaceDeleteCmd = "NW.IPSPACE.DELETE"
 
-func IPSpaceDelete(client *pythonapi.NetAppAPI, name string) error {
+func IPSpaceDelete(client pythonapi.Backend, name string) error {
 	req := &IPSpaceRequest{Name: name}
 	resp := &pythonapi.EmptyResponse{}
 	return pythonapi.MakeAPICall(client, ipspaceDeleteCmd, req, resp)
@@ -155,7 +156,7 @@ type BcDomainInfo struct {
 
 const bcDomainGetCmd = "NW.BRCDOM.GET"
 
-func BcDomainGet(client *pythonapi.NetAppAPI, name string) (*BcDomainInfo, error) {
+func BcDomainGet(client pythonapi.Backend, name string) (*BcDomainInfo, error) {
 	req := &BcDomainRequest{Name: name}
 	resp := &BcDomainInfo{}
 	err := pythonapi.MakeAPICall(client, bcDomainGetCmd, req, resp)
@@ -167,7 +168,7 @@ func BcDomainGet(client *pythonapi.NetAppAPI, name string) (*BcDomainInfo, error
 
 const bcDomainStatusCmd = "NW.BRCDOM.STATUS"
 
-func BcDomainStatus(client *pythonapi.NetAppAPI, name string) (*BcDomainInfo, error) {
+func BcDomainStatus(client pythonapi.Backend, name string) (*BcDomainInfo, error) {
 	req := &BcDomainRequest{Name: name, StatusOnly: "set"}
 	resp := &BcDomainInfo{}
 	err := pythonapi.MakeAPICall(client, bcDomainStatusCmd, req, resp)
@@ -177,28 +178,41 @@ func BcDomainStatus(client *pythonapi.NetAppAPI, name string) (*BcDomainInfo, er
 	return resp, nil
 }
 
-func BcDomainWaitForInProgressDone(client *pythonapi.NetAppAPI, name string) (string, error) {
-	var bcInfo *BcDomainInfo
-	var err error
-	for true {
-		bcInfo, err = BcDomainStatus(client, name)
+// bcDomainPollInterval between NW.BRCDOM.STATUS polls of a port update
+const bcDomainPollInterval = 500 * time.Millisecond // API timeout currently set to 800ms!
+
+// BcDomainWaitForInProgressDone polls the port update status of the
+// broadcast domain until it is no longer in progress or ctx is done
+func BcDomainWaitForInProgressDone(
+	ctx context.Context, client pythonapi.Backend, name string) (string, error) {
+	ticker := time.NewTicker(bcDomainPollInterval)
+	defer ticker.Stop()
+
+	request := &BcDomainRequest{Name: name, StatusOnly: "set"}
+	for {
+		// wait first, the caller just got the in progress status
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf(
+				"broadcast domain [%s] port update wait ended, got: %s", name, ctx.Err())
+		case <-ticker.C:
+		}
+
+		bcInfo := &BcDomainInfo{}
+		err := client.Call(ctx, bcDomainStatusCmd, request, bcInfo)
 		if err != nil {
 			return "", err
 		}
 
 		if bcInfo.PortUpdateStatus != "in_progress" {
-			break
+			return bcInfo.PortUpdateStatus, nil
 		}
-
-		time.Sleep(500 * time.Millisecond) // API timeout currently set to 800ms!
 	}
-
-	return bcInfo.PortUpdateStatus, nil
 }
 
 const bcDomainCreateCmd = "NW.BRCDOM.CREATE"
 
-func BcDomainCreate(client *pythonapi.NetAppAPI, request *BcDomainRequest) (*BcDomainInfo, error) {
+func BcDomainCreate(client pythonapi.Backend, request *BcDomainRequest) (*BcDomainInfo, error) {
 	resp := &BcDomainInfo{}
 	err := pythonapi.MakeAPICall(client, bcDomainCreateCmd, request, resp)
 	if err != nil {
@@ -211,7 +225,7 @@ func BcDomainCreate(client *pythonapi.NetAppAPI, request *BcDomainRequest) (*BcD
 const bcDomainRenameCmd = "NW.BRCDOM.RENAME"
 
 func BcDomainRename(
-	client *pythonapi.NetAppAPI,
+	client pythonapi.Backend,
 	name string, ipspace string,
 	newName string) error {
 	req := &BcDomainRequest{Name: name, IPSpace: ipspace, NewName: newName}
@@ -223,7 +237,7 @@ const bcDomainPortAddCmd = "NW.BRCDOM.PORT.ADD"
 const bcDomainPortRemoveCmd = "NW.BRCDOM.PORT.REMOVE"
 
 func BcDomainPortsModify(
-	client *pythonapi.NetAppAPI,
+	client pythonapi.Backend,
 	name string, ipspace string,
 	portNames []string,
 	add bool, remove bool) (*BcDomainInfo, error) {
@@ -254,7 +268,7 @@ func BcDomainPortsModify(
 const bcDomainUpdateCmd = "NW.BRCDOM.UPDATE"
 
 func BcDomainUpdate(
-	client *pythonapi.NetAppAPI,
+	client pythonapi.Backend,
 	name string, ipspace string, mtu int) (*BcDomainInfo, error) {
 	req := &BcDomainRequest{Name: name, IPSpace: ipspace, Mtu: strconv.Itoa(mtu)}
 	resp := &BcDomainInfo{}
@@ -268,7 +282,7 @@ func BcDomainUpdate(
 const bcDomainDeleteCmd = "NW.BRCDOM.DELETE"
 
 func BcDomainDelete(
-	client *pythonapi.NetAppAPI,
+	client pythonapi.Backend,
 	name string, ipspace string) (*BcDomainInfo, error) {
 
 	req := &BcDomainRequest{Name: name, IPSpace: ipspace}
@@ -294,14 +308,14 @@ type SubnetInfo struct {
 	pythonapi.ResourceInfo
 	SubnetRequest
 
-	IPCount     int `json:"ip_count"` // <used-count>
-	IPUsed      int `json:"ip_used"`  // <total-count>
+	IPCount     int `json:"ip_count"` // <total-count>
+	IPUsed      int `json:"ip_used"`  // <used-count>
 	IPAvailable int `json:"ip_avail"` // <available-count>
 }
 
 const subnetGetCmd = "NW.SUBNET.GET"
 
-func SubnetGet(client *pythonapi.NetAppAPI, request *SubnetRequest) (*SubnetInfo, error) {
+func SubnetGet(client pythonapi.Backend, request *SubnetRequest) (*SubnetInfo, error) {
 	response := &SubnetInfo{}
 	err := pythonapi.MakeAPICall(client, subnetGetCmd, request, response)
 	if err != nil {
@@ -313,7 +327,7 @@ func SubnetGet(client *pythonapi.NetAppAPI, request *SubnetRequest) (*SubnetInfo
 
 const subnetCreateCmd = "NW.SUBNET.CREATE"
 
-func SubnetCreate(client *pythonapi.NetAppAPI, request *SubnetRequest) (*SubnetInfo, error) {
+func SubnetCreate(client pythonapi.Backend, request *SubnetRequest) (*SubnetInfo, error) {
 	response := &SubnetInfo{}
 	err := pythonapi.MakeAPICall(client, subnetCreateCmd, request, response)
 	if err != nil {
@@ -324,14 +338,14 @@ func SubnetCreate(client *pythonapi.NetAppAPI, request *SubnetRequest) (*SubnetI
 
 const subnetDeleteCmd = "NW.SUBNET.DELETE"
 
-func SubnetDelete(client *pythonapi.NetAppAPI, request *SubnetRequest) error {
+func SubnetDelete(client pythonapi.Backend, request *SubnetRequest) error {
 	response := &pythonapi.EmptyResponse{}
 	return pythonapi.MakeAPICall(client, subnetDeleteCmd, request, response)
 }
 
 const subnetRenameCmd = "NW.SUBNET.RENAME"
 
-func SubnetRename(client *pythonapi.NetAppAPI, request *SubnetRequest) error {
+func SubnetRename(client pythonapi.Backend, request *SubnetRequest) error {
 	response := &pythonapi.EmptyResponse{}
 	return pythonapi.MakeAPICall(client, subnetRenameCmd, request, response)
 }
@@ -340,7 +354,7 @@ const subnetIPRangeAddCmd = "NW.SUBNET.IPR.ADD"
 const subnetIPRangeRemoveCmd = "NW.SUBNET.IPR.REMOVE"
 
 func SubnetIpRangeModify(
-	client *pythonapi.NetAppAPI,
+	client pythonapi.Backend,
 	name string, ipspace string,
 	ipRanges []string,
 	add bool, remove bool) error {
@@ -363,7 +377,7 @@ func SubnetIpRangeModify(
 
 const subnetModifyCmd = "NW.SUBNET.MODIFY"
 
-func SubnetModify(client *pythonapi.NetAppAPI, request *SubnetRequest) error {
+func SubnetModify(client pythonapi.Backend, request *SubnetRequest) error {
 	response := &pythonapi.EmptyResponse{}
 	return pythonapi.MakeAPICall(client, subnetModifyCmd, request, response)
 }
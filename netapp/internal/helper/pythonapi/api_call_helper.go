@@ -1,9 +1,13 @@
 package pythonapi
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
+	"reflect"
+
+	grpcpyapi "github.com/jogam/terraform-provider-netapp/netapp/internal/grpcapi"
 )
 
 // EmptyResponse for API calls without return value
@@ -16,36 +20,165 @@ type ResourceInfo struct {
 	NonExist bool `json:"non_exist,omitempty"` // flag to indicate that resource does not exist
 }
 
-// MakeAPICall realizes the Marshall/Unmarshall and actual API call
+// MakeAPICall executes the API command with the backend
 func MakeAPICall(
-	client *NetAppAPI, cmdName string,
+	client Backend, cmdName string,
+	request, response interface{}) error {
+	return client.Call(context.Background(), cmdName, request, response)
+}
+
+// Call realizes the Marshall/Unmarshall and actual API call
+func (api *NetAppAPI) Call(
+	ctx context.Context, cmdName string,
 	request, response interface{}) error {
 
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("api call [%s] not executed, got: %s", cmdName, err)
+	}
+	if api.commands != nil && !api.commands[cmdName] {
+		return fmt.Errorf("api call [%s] not supported by the python API server", cmdName)
+	}
+
 	byteReq, err := json.Marshal(request)
 	if err != nil {
 		log.Printf(
-			"[ERROR] could not marshal api call [%s] request [%v], got: %s",
+			"[ERROR] could not marshal api call [%s] request [%T], got: %s",
 			cmdName, request, err)
 		return fmt.Errorf("api call [%s] request marshal error: %s", cmdName, err)
 	}
-	succ, errmsg, data, err := client.Call(cmdName, byteReq)
+	// the client ID selects the cluster connection of this client
+	ctx = grpcpyapi.WithSession(ctx, api.session)
+	resp, err := api.impl.Call(ctx, cmdName, byteReq)
 	if err != nil {
 		log.Printf("[ERROR] could not execute API call [%s], got: %s", cmdName, err)
 		return err
 	}
 
-	if !succ || errmsg != "" {
-		log.Printf("[WARN] api call [%s] not successful got: %v", cmdName, errmsg)
-		return fmt.Errorf("api call [%s] failed with msg: %v", cmdName, errmsg)
+	return api.result(cmdName, resp, request, response)
+}
+
+// result unmarshals the response data of a successful call, the error of
+// a failed call is an APIError
+func (api *NetAppAPI) result(
+	cmdName string, resp *grpcpyapi.CallResponse,
+	request, response interface{}) error {
+
+	if !resp.Success || resp.Errmsg != "" {
+		log.Printf("[WARN] api call [%s] not successful, errno [%d] got: %v",
+			cmdName, resp.Errno, resp.Errmsg)
+		return NewAPIError(cmdName, int(resp.Errno), resp.Errmsg)
 	}
 
-	err = json.Unmarshal(data, response)
+	data := resp.Data
+	err := json.Unmarshal(data, response)
 	if err != nil {
 		log.Printf(
-			"[ERROR] could not unmarshal api call [%s] response [%v], got: %s",
-			cmdName, data, err)
+			"[ERROR] could not unmarshal api call [%s] response [%s], got: %s",
+			cmdName, redactedData(data, request, response), err)
 		return fmt.Errorf("api call [%s] request unmarshal error: %s", cmdName, err)
 	}
 
 	return nil
 }
+
+// CallBatch executes the items in one round trip with the API
+// implementation, items which can not be sent fail without the others
+func (api *NetAppAPI) CallBatch(ctx context.Context, items []*BatchItem) error {
+	batcher, ok := api.impl.(grpcpyapi.Batcher)
+	if !ok {
+		return ErrBatchUnsupported
+	}
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("batch call not executed, got: %s", err)
+	}
+
+	// sent are the items of the calls
+	sent := make([]*BatchItem, 0, len(items))
+	calls := make([]*grpcpyapi.CallRequest, 0, len(items))
+	for _, item := range items {
+		item.Err = nil
+		if api.commands != nil && !api.commands[item.Cmd] {
+			item.Err = fmt.Errorf(
+				"api call [%s] not supported by the python API server", item.Cmd)
+			continue
+		}
+
+		byteReq, err := json.Marshal(item.Request)
+		if err != nil {
+			log.Printf(
+				"[ERROR] could not marshal api call [%s] request [%T], got: %s",
+				item.Cmd, item.Request, err)
+			item.Err = fmt.Errorf("api call [%s] request marshal error: %s", item.Cmd, err)
+			continue
+		}
+
+		sent = append(sent, item)
+		calls = append(calls, &grpcpyapi.CallRequest{Cmd: item.Cmd, Data: byteReq})
+	}
+	if len(calls) == 0 {
+		return nil
+	}
+
+	ctx = grpcpyapi.WithSession(ctx, api.session)
+	results, err := batcher.CallBatch(ctx, calls)
+	if err != nil {
+		if err != ErrBatchUnsupported {
+			log.Printf("[ERROR] could not execute batch call of %d calls, got: %s",
+				len(calls), err)
+		}
+		return err
+	}
+	if len(results) != len(calls) {
+		return fmt.Errorf(
+			"batch call returned %d results for %d calls", len(results), len(calls))
+	}
+
+	for idx, item := range sent {
+		item.Err = api.result(item.Cmd, results[idx], item.Request, item.Response)
+	}
+
+	return nil
+}
+
+// WatchJob watches the job with the API implementation, each pushed job
+// state replaces the content of response
+func (api *NetAppAPI) WatchJob(
+	ctx context.Context, request, response interface{},
+	update func() error) error {
+
+	watcher, ok := api.impl.(grpcpyapi.JobWatcher)
+	if !ok {
+		return ErrWatchUnsupported
+	}
+
+	byteReq, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("job watch request marshal error: %s", err)
+	}
+
+	ctx = grpcpyapi.WithSession(ctx, api.session)
+	succ, errmsg, err := watcher.WatchJob(ctx, byteReq, func(data []byte) error {
+		// reset first, omitted keys must not keep the previous state
+		value := reflect.ValueOf(response).Elem()
+		value.Set(reflect.Zero(value.Type()))
+
+		if err := json.Unmarshal(data, response); err != nil {
+			return fmt.Errorf("job watch response unmarshal error: %s", err)
+		}
+
+		return update()
+	})
+	if err != nil {
+		if err != ErrWatchUnsupported {
+			log.Printf("[ERROR] could not watch job, got: %s", err)
+		}
+		return err
+	}
+
+	if !succ || errmsg != "" {
+		log.Printf("[WARN] job watch not successful got: %v", errmsg)
+		return fmt.Errorf("job watch failed with msg: %v", errmsg)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package pythonapi
+
+import (
+	"context"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	grpcpyapi "github.com/jogam/terraform-provider-netapp/netapp/internal/grpcapi"
+)
+
+// LogConfig is the file log of the Python API server, it applies to the
+// server started by the provider, not to a reused one
+type LogConfig struct {
+	File    string // relative to the API folder, python_api.log if empty
+	MaxSize int    // bytes before the file is rotated, 0 never rotates
+}
+
+// env returns the environment passing the config to the API server
+func (c LogConfig) env() []string {
+	var env []string
+	if c.File != "" {
+		env = append(env, "NETAPP_API_LOG_FILE="+c.File)
+	}
+
+	return append(env, "NETAPP_API_LOG_MAX_BYTES="+strconv.Itoa(c.MaxSize))
+}
+
+// forwardLevel returns the Python log level of the records forwarded for
+// TF_LOG, empty if Terraform does not log
+func forwardLevel() string {
+	switch strings.ToUpper(os.Getenv("TF_LOG")) {
+	case "":
+		return ""
+	case "INFO":
+		return "INFO"
+	case "WARN":
+		return "WARNING"
+	case "ERROR":
+		return "ERROR"
+	}
+
+	// TRACE, DEBUG and invalid levels, which Terraform treats as TRACE
+	return "DEBUG"
+}
+
+// providerLevel maps the Python log level to the provider log level
+func providerLevel(level string) string {
+	switch level {
+	case "WARNING":
+		return "WARN"
+	case "ERROR", "CRITICAL":
+		return "ERROR"
+	case "INFO":
+		return "INFO"
+	}
+
+	return "DEBUG"
+}
+
+// forwardLogs logs the API server records of level and above until Stop,
+// records of calls by other clients are skipped
+func (api *NetAppAPI) forwardLogs(level string) {
+	streamer, ok := api.impl.(grpcpyapi.LogStreamer)
+	if !ok || level == "" {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	api.stopLogs = cancel
+
+	go func() {
+		err := streamer.StreamLogs(ctx, level, func(rec *grpcpyapi.LogRecord) error {
+			if rec.Session != "" && rec.Session != api.session {
+				return nil
+			}
+
+			log.Printf("[%s] python API [%s]: %s",
+				providerLevel(rec.Level), rec.Name, rec.Message)
+			return nil
+		})
+		if err != nil && ctx.Err() == nil {
+			log.Printf("[WARN] python API log forwarding stopped, got: %s", err)
+		}
+	}()
+}
@@ -0,0 +1,91 @@
+package pythonapi
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	grpcpyapi "github.com/jogam/terraform-provider-netapp/netapp/internal/grpcapi"
+)
+
+// logImpl streams the records until the stream is cancelled
+type logImpl struct {
+	fakeImpl
+	records []*grpcpyapi.LogRecord
+	level   string
+	done    chan struct{}
+}
+
+func (l *logImpl) StreamLogs(
+	ctx context.Context, level string,
+	record func(*grpcpyapi.LogRecord) error) error {
+	defer close(l.done)
+
+	l.level = level
+	for _, rec := range l.records {
+		if err := record(rec); err != nil {
+			return err
+		}
+	}
+
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func Test_Logs_Forward(t *testing.T) {
+	r := require.New(t)
+
+	var out bytes.Buffer
+	log.SetOutput(&out)
+	defer log.SetOutput(os.Stderr)
+
+	impl := &logImpl{done: make(chan struct{})}
+	api := NewNetAppAPI(impl)
+	impl.records = []*grpcpyapi.LogRecord{
+		{Level: "WARNING", Name: "apicmd", Message: "own call", Session: api.session},
+		{Level: "ERROR", Name: "apicmd", Message: "other client", Session: "other"},
+		{Level: "CRITICAL", Name: "grpcapi", Message: "server record"},
+	}
+
+	api.forwardLogs("WARNING")
+	r.NoError(api.Stop())
+
+	select {
+	case <-impl.done:
+	case <-time.After(5 * time.Second):
+		r.Fail("log stream not cancelled by Stop")
+	}
+
+	r.Equal("WARNING", impl.level)
+	r.Contains(out.String(), "[WARN] python API [apicmd]: own call")
+	r.Contains(out.String(), "[ERROR] python API [grpcapi]: server record")
+	r.NotContains(out.String(), "other client")
+}
+
+func Test_Logs_Levels(t *testing.T) {
+	r := require.New(t)
+	defer os.Setenv("TF_LOG", os.Getenv("TF_LOG"))
+
+	for tfLog, level := range map[string]string{
+		"": "", "TRACE": "DEBUG", "debug": "DEBUG", "INFO": "INFO",
+		"WARN": "WARNING", "ERROR": "ERROR", "1": "DEBUG",
+	} {
+		os.Setenv("TF_LOG", tfLog)
+		r.Equal(level, forwardLevel(), tfLog)
+	}
+
+	// no forwarding without TF_LOG
+	os.Setenv("TF_LOG", "")
+	api := NewNetAppAPI(&logImpl{})
+	api.forwardLogs(forwardLevel())
+	r.Nil(api.stopLogs)
+
+	r.Equal([]string{"NETAPP_API_LOG_FILE=api.log", "NETAPP_API_LOG_MAX_BYTES=1024"},
+		LogConfig{File: "api.log", MaxSize: 1024}.env())
+	r.Equal([]string{"NETAPP_API_LOG_MAX_BYTES=0"}, LogConfig{}.env())
+}
@@ -0,0 +1,348 @@
+package pythonapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	grpcpyapi "github.com/jogam/terraform-provider-netapp/netapp/internal/grpcapi"
+)
+
+// marker files of the Python API server process in the API folder, the
+// server creates API_UP and API_PID once serving and API_STOPPING while
+// shutting down. The lock file serializes the API start of concurrent
+// provider processes
+const (
+	apiUpFile       = "API_UP"
+	apiStoppingFile = "API_STOPPING"
+	apiPIDFile      = "API_PID"
+	apiLockFile     = "API_LOCK"
+)
+
+const (
+	apiLockTimeout   = 2 * time.Minute  // other providers starting the API
+	apiStopTimeout   = 30 * time.Second // previous API server shutting down
+	apiStartTimeout  = 30 * time.Second // new API server up after handshake
+	apiHealthTimeout = 5 * time.Second  // gRPC health check of running API
+	apiPollInterval  = 200 * time.Millisecond
+)
+
+// apiServerCmd is the script of the API server process, see serverAlive
+var apiServerCmd = grpcpyapi.APIMain
+
+// apiProcess supervises the Python API server process of the API folder
+// through its marker files, PID and gRPC health service
+type apiProcess struct {
+	folder  string
+	apiPort string
+	regPort string
+
+	// serverCmd is the script of the server process, empty to take any
+	// live process as the server, see serverAlive
+	serverCmd string
+
+	stopTimeout   time.Duration
+	startTimeout  time.Duration
+	healthTimeout time.Duration
+}
+
+func newAPIProcess(folder, apiPort, regPort string) *apiProcess {
+	return &apiProcess{
+		folder:        folder,
+		apiPort:       apiPort,
+		regPort:       regPort,
+		serverCmd:     apiServerCmd,
+		stopTimeout:   apiStopTimeout,
+		startTimeout:  apiStartTimeout,
+		healthTimeout: apiHealthTimeout,
+	}
+}
+
+func (p *apiProcess) exists(name string) bool {
+	_, err := os.Stat(filepath.Join(p.folder, name))
+	return err == nil
+}
+
+// pid returns the PID of the API server process, 0 if not known
+func (p *apiProcess) pid() int {
+	data, err := ioutil.ReadFile(filepath.Join(p.folder, apiPIDFile))
+	if err != nil {
+		return 0
+	}
+
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		log.Printf("[WARN] invalid python API PID file content: %q", data)
+		return 0
+	}
+
+	return pid
+}
+
+// serverAlive reports whether the API server process of the folder is
+// still running. A PID reused by an unrelated process, e.g. after a reboot,
+// is not the server: its command line misses the server script or its
+// working directory is not the API folder. Without /proc every live
+// process counts as the server
+func (p *apiProcess) serverAlive(pid int) bool {
+	if !processAlive(pid) {
+		return false
+	}
+
+	if p.serverCmd == "" {
+		return true
+	}
+
+	cmdline, err := processCmdline(pid)
+	if err != nil {
+		return true
+	}
+	if !containsArg(cmdline, p.serverCmd) {
+		log.Printf("[INFO] python API PID [%d] reused by: %s", pid, strings.Join(cmdline, " "))
+		return false
+	}
+
+	cwd, err := processCwd(pid)
+	if err != nil {
+		return true
+	}
+	folder, err := filepath.Abs(p.folder)
+	if err == nil {
+		folder, err = filepath.EvalSymlinks(folder)
+	}
+
+	return err != nil || cwd == folder
+}
+
+// containsArg reports whether one of the arguments is name or its path
+func containsArg(args []string, name string) bool {
+	for _, arg := range args {
+		if filepath.Base(arg) == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// removeStale removes the markers left by an API server which is gone,
+// e.g. killed, otherwise the markers would block every later start
+func (p *apiProcess) removeStale(pid int) {
+	log.Printf(
+		"[WARN] python API server [pid: %d] in [%s] is gone, removing stale markers",
+		pid, p.folder)
+
+	for _, name := range []string{
+		apiUpFile, apiStoppingFile, apiPIDFile, apiReattachFile} {
+		err := os.Remove(filepath.Join(p.folder, name))
+		if err != nil && !os.IsNotExist(err) {
+			log.Printf("[ERROR] could not remove stale marker [%s], got: %s", name, err)
+		}
+	}
+}
+
+// lock takes the exclusive API folder lock, waiting at most timeout for
+// other providers to finish their API start
+func (p *apiProcess) lock(timeout time.Duration) (func(), error) {
+	if err := ensureAPIFolder(p.folder); err != nil {
+		return nil, err
+	}
+
+	path := filepath.Join(p.folder, apiLockFile)
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("could not open python API lock [%s], got: %s", path, err)
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		locked, err := tryLockFile(file)
+		if err != nil {
+			file.Close()
+			return nil, fmt.Errorf("could not lock python API [%s], got: %s", path, err)
+		}
+		if locked {
+			break
+		}
+		if time.Now().After(deadline) {
+			file.Close()
+			return nil, fmt.Errorf(
+				"python API folder [%s] still locked by another provider after %s",
+				p.folder, timeout)
+		}
+
+		time.Sleep(apiPollInterval)
+	}
+
+	return func() {
+		unlockFile(file)
+		file.Close()
+	}, nil
+}
+
+// probeHealth checks the gRPC health service of the running API server
+func (p *apiProcess) probeHealth(ctx context.Context) error {
+	conn, err := grpc.DialContext(
+		ctx, "127.0.0.1:"+p.apiPort, grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	resp, err := grpc_health_v1.NewHealthClient(conn).Check(
+		ctx, &grpc_health_v1.HealthCheckRequest{Service: "plugin"})
+	if err != nil {
+		return err
+	}
+	if resp.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+		return fmt.Errorf("health status: %s", resp.Status)
+	}
+
+	return nil
+}
+
+// probeVersion checks the running API server is of this provider build
+func (p *apiProcess) probeVersion(ctx context.Context) error {
+	conn, err := grpc.DialContext(
+		ctx, "127.0.0.1:"+p.apiPort, grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = checkVersion(ctx, grpcpyapi.NewClient(conn))
+	return err
+}
+
+// stop terminates the API server, e.g. of another provider build. The
+// server shuts down like on idle timeout, a server of a provider version
+// before the version handshake is killed and its markers removed
+func (p *apiProcess) stop(pid int) error {
+	if err := terminateProcess(pid); err != nil {
+		return fmt.Errorf(
+			"could not stop python API server [pid: %d], got: %s", pid, err)
+	}
+
+	deadline := time.Now().Add(p.stopTimeout)
+	for processAlive(pid) {
+		if time.Now().After(deadline) {
+			return fmt.Errorf(
+				"python API server [pid: %d] in [%s] still running after %s",
+				pid, p.folder, p.stopTimeout)
+		}
+
+		time.Sleep(apiPollInterval)
+	}
+
+	for _, name := range []string{apiUpFile, apiStoppingFile, apiPIDFile} {
+		if p.exists(name) {
+			p.removeStale(pid)
+			break
+		}
+	}
+
+	return nil
+}
+
+// portFree checks that no other process listens on the port
+func portFree(port string) error {
+	listener, err := net.Listen("tcp", "127.0.0.1:"+port)
+	if err != nil {
+		return err
+	}
+
+	return listener.Close()
+}
+
+// prepare waits for a stopping API server and removes stale markers, it
+// returns whether a healthy API server is running. Without running server
+// the API ports must be free for the new one
+func (p *apiProcess) prepare() (bool, error) {
+	deadline := time.Now().Add(p.stopTimeout)
+	for p.exists(apiStoppingFile) {
+		pid := p.pid()
+		if !p.serverAlive(pid) {
+			p.removeStale(pid)
+			break
+		}
+		if time.Now().After(deadline) {
+			return false, fmt.Errorf(
+				"python API server [pid: %d] in [%s] still stopping after %s",
+				pid, p.folder, p.stopTimeout)
+		}
+
+		time.Sleep(apiPollInterval)
+	}
+
+	if p.exists(apiUpFile) {
+		pid := p.pid()
+		if p.serverAlive(pid) {
+			ctx, cancel := context.WithTimeout(context.Background(), p.healthTimeout)
+			defer cancel()
+
+			if err := p.probeHealth(ctx); err != nil {
+				return false, fmt.Errorf(
+					"python API server [pid: %d] in [%s] not healthy on port [%s], got: %s",
+					pid, p.folder, p.apiPort, err)
+			}
+
+			err := p.probeVersion(ctx)
+			if err == nil {
+				return true, nil
+			}
+			if !errors.Is(err, errOtherBuild) {
+				return false, fmt.Errorf(
+					"python API server [pid: %d] in [%s] version check failed, got: %s",
+					pid, p.folder, err)
+			}
+
+			log.Printf("[WARN] restarting python API server [pid: %d], got: %s", pid, err)
+			if err := p.stop(pid); err != nil {
+				return false, err
+			}
+		} else {
+			p.removeStale(pid)
+		}
+	}
+
+	ports := []struct{ name, port string }{
+		{"api_port", p.apiPort},
+		{"api_client_registry_port", p.regPort},
+	}
+	for _, port := range ports {
+		if err := portFree(port.port); err != nil {
+			return false, fmt.Errorf(
+				"%s [%s] for the python API already in use, got: %s",
+				port.name, port.port, err)
+		}
+	}
+
+	return false, nil
+}
+
+// waitUp waits until the launched API server declared itself up
+func (p *apiProcess) waitUp() error {
+	deadline := time.Now().Add(p.startTimeout)
+	for !p.exists(apiUpFile) || p.pid() == 0 {
+		if time.Now().After(deadline) {
+			return fmt.Errorf(
+				"python API server in [%s] not up after %s, see the python API log file",
+				p.folder, p.startTimeout)
+		}
+
+		time.Sleep(apiPollInterval)
+	}
+
+	return nil
+}
@@ -0,0 +1,248 @@
+package pythonapi
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	grpcpyapi "github.com/jogam/terraform-provider-netapp/netapp/internal/grpcapi"
+)
+
+// freePort returns a currently unused local port
+func freePort(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	return strconv.Itoa(listener.Addr().(*net.TCPAddr).Port)
+}
+
+// deadPID returns the PID of an exited process
+func deadPID(t *testing.T) int {
+	cmd := exec.Command("true")
+	require.NoError(t, cmd.Run())
+
+	return cmd.Process.Pid
+}
+
+func testProcess(t *testing.T) *apiProcess {
+	p := newAPIProcess(t.TempDir(), freePort(t), freePort(t))
+	// the test process stands in for the server
+	p.serverCmd = ""
+	p.stopTimeout = 100 * time.Millisecond
+	p.startTimeout = 100 * time.Millisecond
+	p.healthTimeout = 500 * time.Millisecond
+
+	return p
+}
+
+func writeMarker(t *testing.T, p *apiProcess, name, content string) {
+	require.NoError(t, ioutil.WriteFile(
+		filepath.Join(p.folder, name), []byte(content), 0600))
+}
+
+func Test_APIProcess_StaleMarkers(t *testing.T) {
+	r := require.New(t)
+	p := testProcess(t)
+
+	writeMarker(t, p, apiUpFile, "")
+	writeMarker(t, p, apiStoppingFile, "")
+	writeMarker(t, p, apiPIDFile, fmt.Sprint(deadPID(t)))
+
+	running, err := p.prepare()
+	r.NoError(err)
+	r.False(running)
+	for _, name := range []string{apiUpFile, apiStoppingFile, apiPIDFile} {
+		r.False(p.exists(name), name)
+	}
+
+	// running file of a crashed server without PID file
+	writeMarker(t, p, apiUpFile, "")
+	running, err = p.prepare()
+	r.NoError(err)
+	r.False(running)
+	r.False(p.exists(apiUpFile))
+}
+
+func Test_APIProcess_StoppingTimeout(t *testing.T) {
+	r := require.New(t)
+	p := testProcess(t)
+
+	writeMarker(t, p, apiStoppingFile, "")
+	writeMarker(t, p, apiPIDFile, fmt.Sprint(os.Getpid()))
+
+	start := time.Now()
+	_, err := p.prepare()
+	r.Error(err)
+	r.Contains(err.Error(), "still stopping after 100ms")
+	r.True(time.Since(start) < 5*time.Second)
+	r.True(p.exists(apiStoppingFile))
+}
+
+func Test_APIProcess_Health(t *testing.T) {
+	r := require.New(t)
+	p := testProcess(t)
+
+	writeMarker(t, p, apiUpFile, "")
+	writeMarker(t, p, apiPIDFile, fmt.Sprint(os.Getpid()))
+
+	// live process but nothing serving on the API port
+	_, err := p.prepare()
+	r.Error(err)
+	r.Contains(err.Error(), "not healthy on port ["+p.apiPort+"]")
+
+	listener, err := net.Listen("tcp", "127.0.0.1:"+p.apiPort)
+	r.NoError(err)
+	defer serveAPIServer(t, listener, &fakeImpl{}).Stop()
+
+	running, err := p.prepare()
+	r.NoError(err)
+	r.True(running)
+}
+
+func Test_APIProcess_PIDReused(t *testing.T) {
+	if _, err := processCmdline(os.Getpid()); err != nil {
+		t.Skipf("process identity not available, got: %s", err)
+	}
+
+	r := require.New(t)
+	p := testProcess(t)
+	p.serverCmd = grpcpyapi.APIMain
+
+	// live process of the PID with the script argument but in another folder
+	cmd := exec.Command("sh", "-c", "sleep 10", grpcpyapi.APIMain)
+	r.NoError(cmd.Start())
+	defer cmd.Wait()
+	defer cmd.Process.Kill()
+
+	writeMarker(t, p, apiUpFile, "")
+	writeMarker(t, p, apiPIDFile, fmt.Sprint(cmd.Process.Pid))
+
+	running, err := p.prepare()
+	r.NoError(err)
+	r.False(running)
+	for _, name := range []string{apiUpFile, apiPIDFile} {
+		r.False(p.exists(name), name)
+	}
+
+	// same process started in the API folder is the unhealthy server
+	cmd = exec.Command("sh", "-c", "sleep 10", grpcpyapi.APIMain)
+	cmd.Dir = p.folder
+	r.NoError(cmd.Start())
+	defer cmd.Wait()
+	defer cmd.Process.Kill()
+
+	writeMarker(t, p, apiUpFile, "")
+	writeMarker(t, p, apiPIDFile, fmt.Sprint(cmd.Process.Pid))
+
+	_, err = p.prepare()
+	r.Error(err)
+	r.Contains(err.Error(), "not healthy on port ["+p.apiPort+"]")
+
+	// unrelated process of the PID
+	writeMarker(t, p, apiStoppingFile, "")
+	writeMarker(t, p, apiPIDFile, fmt.Sprint(os.Getpid()))
+
+	running, err = p.prepare()
+	r.NoError(err)
+	r.False(running)
+	r.False(p.exists(apiStoppingFile))
+}
+
+// testOtherBuildPort is the port of the API server of another build served
+// by Test_APIProcess_OtherBuildServer in a child process
+const testOtherBuildPort = "NETAPP_TEST_OTHER_BUILD_PORT"
+
+func Test_APIProcess_OtherBuildServer(t *testing.T) {
+	port := os.Getenv(testOtherBuildPort)
+	if port == "" {
+		t.Skip("only run as child process of Test_APIProcess_OtherBuild")
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:"+port)
+	require.NoError(t, err)
+	serveAPIServer(t, listener, &fakeImpl{
+		version: &grpcpyapi.VersionResponse{Build: "other"}})
+
+	// until terminated by the provider of this build
+	select {}
+}
+
+func Test_APIProcess_OtherBuild(t *testing.T) {
+	r := require.New(t)
+	p := testProcess(t)
+	p.stopTimeout = 10 * time.Second
+
+	cmd := exec.Command(os.Args[0], "-test.run=^Test_APIProcess_OtherBuildServer$")
+	cmd.Env = append(os.Environ(), testOtherBuildPort+"="+p.apiPort)
+	r.NoError(cmd.Start())
+	exited := make(chan error, 1)
+	go func() { exited <- cmd.Wait() }()
+	defer cmd.Process.Kill()
+
+	for portFree(p.apiPort) == nil {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	writeMarker(t, p, apiUpFile, "")
+	writeMarker(t, p, apiPIDFile, fmt.Sprint(cmd.Process.Pid))
+
+	running, err := p.prepare()
+	r.NoError(err)
+	r.False(running)
+	r.Error(<-exited)
+	for _, name := range []string{apiUpFile, apiPIDFile} {
+		r.False(p.exists(name), name)
+	}
+}
+
+func Test_APIProcess_PortInUse(t *testing.T) {
+	r := require.New(t)
+	p := testProcess(t)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:"+p.regPort)
+	r.NoError(err)
+	defer listener.Close()
+
+	_, err = p.prepare()
+	r.Error(err)
+	r.Contains(err.Error(), "api_client_registry_port ["+p.regPort+"] for the python API already in use")
+}
+
+func Test_APIProcess_Lock(t *testing.T) {
+	r := require.New(t)
+	p := testProcess(t)
+
+	unlock, err := p.lock(time.Second)
+	r.NoError(err)
+
+	_, err = p.lock(100 * time.Millisecond)
+	r.Error(err)
+	r.Contains(err.Error(), "still locked by another provider")
+
+	unlock()
+	unlock, err = p.lock(100 * time.Millisecond)
+	r.NoError(err)
+	unlock()
+}
+
+func Test_APIProcess_WaitUp(t *testing.T) {
+	r := require.New(t)
+	p := testProcess(t)
+
+	err := p.waitUp()
+	r.Error(err)
+	r.Contains(err.Error(), "not up after 100ms")
+
+	writeMarker(t, p, apiUpFile, "")
+	writeMarker(t, p, apiPIDFile, fmt.Sprint(os.Getpid()))
+	r.NoError(p.waitUp())
+}
@@ -0,0 +1,63 @@
+//go:build !windows
+// +build !windows
+
+package pythonapi
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"syscall"
+)
+
+// processAlive reports whether a process with the PID exists
+func processAlive(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+
+	// signal 0 only checks for existence, EPERM: exists as other user
+	err := syscall.Kill(pid, 0)
+	return err == nil || err == syscall.EPERM
+}
+
+// processCmdline returns the command line arguments of the process, the
+// error is os.IsNotExist without /proc, e.g. on macOS
+func processCmdline(pid int) ([]string, error) {
+	data, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/cmdline", pid))
+	if err != nil {
+		return nil, err
+	}
+
+	return strings.Split(strings.TrimRight(string(data), "\x00"), "\x00"), nil
+}
+
+// processCwd returns the working directory of the process
+func processCwd(pid int) (string, error) {
+	return os.Readlink(fmt.Sprintf("/proc/%d/cwd", pid))
+}
+
+// terminateProcess asks the process to shut down, a gone process is fine
+func terminateProcess(pid int) error {
+	if err := syscall.Kill(pid, syscall.SIGTERM); err != nil && err != syscall.ESRCH {
+		return err
+	}
+
+	return nil
+}
+
+// tryLockFile takes the exclusive lock of the file, false if another
+// process holds it
+func tryLockFile(file *os.File) (bool, error) {
+	err := syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+	if err == syscall.EWOULDBLOCK {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+func unlockFile(file *os.File) {
+	syscall.Flock(int(file.Fd()), syscall.LOCK_UN)
+}
@@ -0,0 +1,52 @@
+//go:build windows
+// +build windows
+
+package pythonapi
+
+import (
+	"errors"
+	"os"
+)
+
+// errNoProcessSupport is returned where the API server process can not be
+// supervised, the API scripts need bash anyway
+var errNoProcessSupport = errors.New("python API server process not supported on windows")
+
+// processAlive reports whether a process with the PID exists
+func processAlive(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+
+	// opens the process handle, fails for a gone process
+	proc, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	proc.Release()
+
+	return true
+}
+
+func processCmdline(pid int) ([]string, error) {
+	return nil, errNoProcessSupport
+}
+
+func processCwd(pid int) (string, error) {
+	return "", errNoProcessSupport
+}
+
+func terminateProcess(pid int) error {
+	proc, err := os.FindProcess(pid)
+	if err != nil {
+		return nil
+	}
+
+	return proc.Kill()
+}
+
+func tryLockFile(file *os.File) (bool, error) {
+	return false, errNoProcessSupport
+}
+
+func unlockFile(file *os.File) {}
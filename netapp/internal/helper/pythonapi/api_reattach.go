@@ -0,0 +1,230 @@
+package pythonapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/segmentio/ksuid"
+
+	grpcpyapi "github.com/jogam/terraform-provider-netapp/netapp/internal/grpcapi"
+)
+
+// apiReattachFile keeps the go-plugin reattach configuration of the API
+// server in the API folder for later provider runs
+const apiReattachFile = "API_REATTACH"
+
+// reattachDialTimeout limits the check that the server still listens
+const reattachDialTimeout = 2 * time.Second
+
+// reattachConfig is the persisted go-plugin ReattachConfig
+type reattachConfig struct {
+	Protocol string `json:"protocol"`
+	Network  string `json:"network"`
+	Addr     string `json:"addr"`
+	Pid      int    `json:"pid"`
+
+	// Sessions are the API server sessions per connection key, see
+	// NetAppAPI.ReuseSession
+	Sessions map[string]string `json:"sessions,omitempty"`
+}
+
+// saveReattach persists the reattach configuration of the API server,
+// the saved sessions are kept while the server PID is the same
+func (p *apiProcess) saveReattach(config *plugin.ReattachConfig) error {
+	saved := &reattachConfig{
+		Protocol: string(config.Protocol),
+		Network:  config.Addr.Network(),
+		Addr:     config.Addr.String(),
+		Pid:      config.Pid,
+	}
+	if current, err := p.readReattach(); err == nil && current.Pid == config.Pid {
+		saved.Sessions = current.Sessions
+	}
+
+	return p.writeReattach(saved)
+}
+
+func (p *apiProcess) readReattach() (*reattachConfig, error) {
+	data, err := ioutil.ReadFile(filepath.Join(p.folder, apiReattachFile))
+	if err != nil {
+		return nil, err
+	}
+
+	config := &reattachConfig{}
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+func (p *apiProcess) writeReattach(config *reattachConfig) error {
+	data, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	// write + rename, a concurrent reader never sees a partial file
+	path := filepath.Join(p.folder, apiReattachFile)
+	if err := ioutil.WriteFile(path+".tmp", data, 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(path+".tmp", path)
+}
+
+// reuseSession returns the session saved for key with the reattach
+// configuration of the API server pid, else session which is saved
+// for key. Concurrent runs may save over each other, the lost session
+// is then only connected again by the next run
+func (p *apiProcess) reuseSession(pid int, key, session string) (string, error) {
+	config, err := p.readReattach()
+	if err != nil {
+		return "", fmt.Errorf("no python API reattach config, got: %s", err)
+	}
+	if config.Pid != pid {
+		return "", fmt.Errorf(
+			"python API reattach config of server [pid: %d], not [pid: %d]",
+			config.Pid, pid)
+	}
+
+	if saved, ok := config.Sessions[key]; ok {
+		return saved, nil
+	}
+
+	if config.Sessions == nil {
+		config.Sessions = map[string]string{}
+	}
+	config.Sessions[key] = session
+
+	return session, p.writeReattach(config)
+}
+
+func (p *apiProcess) removeReattach() {
+	err := os.Remove(filepath.Join(p.folder, apiReattachFile))
+	if err != nil && !os.IsNotExist(err) {
+		log.Printf("[ERROR] could not remove python API reattach config, got: %s", err)
+	}
+}
+
+// loadReattach returns the persisted reattach configuration if its API
+// server is still up, the configuration of a gone server is removed
+func (p *apiProcess) loadReattach() (*plugin.ReattachConfig, error) {
+	config, err := p.readReattach()
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("no python API reattach config, got: %s", err)
+	}
+	if err != nil {
+		p.removeReattach()
+		return nil, fmt.Errorf("invalid python API reattach config, got: %s", err)
+	}
+
+	// the PID tells the saved server from a new one on the same address
+	if p.exists(apiStoppingFile) || !p.exists(apiUpFile) ||
+		p.pid() != config.Pid || !p.serverAlive(config.Pid) {
+		p.removeReattach()
+		return nil, fmt.Errorf(
+			"python API server [pid: %d] in [%s] not running anymore",
+			config.Pid, p.folder)
+	}
+
+	var addr net.Addr
+	if config.Network == "unix" {
+		addr, err = net.ResolveUnixAddr(config.Network, config.Addr)
+	} else {
+		addr, err = net.ResolveTCPAddr(config.Network, config.Addr)
+	}
+	if err != nil {
+		p.removeReattach()
+		return nil, fmt.Errorf(
+			"invalid python API reattach address [%s], got: %s", config.Addr, err)
+	}
+
+	// go-plugin kills the reattach PID if the address does not answer,
+	// only hand over a server that still listens
+	conn, err := net.DialTimeout(addr.Network(), addr.String(), reattachDialTimeout)
+	if err != nil {
+		p.removeReattach()
+		return nil, fmt.Errorf(
+			"python API server [pid: %d] not listening on [%s], got: %s",
+			config.Pid, addr, err)
+	}
+	conn.Close()
+
+	return &plugin.ReattachConfig{
+		Protocol: plugin.Protocol(config.Protocol),
+		Addr:     addr,
+		Pid:      config.Pid,
+	}, nil
+}
+
+// ReattachAPI connects to the API server started by an earlier provider
+// run with the reattach configuration saved in the API folder
+func ReattachAPI(folder string) (*NetAppAPI, error) {
+	proc := newAPIProcess(folder, "", "")
+	reattach, err := proc.loadReattach()
+	if err != nil {
+		return nil, err
+	}
+
+	client := plugin.NewClient(&plugin.ClientConfig{
+		HandshakeConfig:  grpcpyapi.Handshake,
+		Plugins:          grpcpyapi.PluginMap,
+		Reattach:         reattach,
+		AllowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC},
+	})
+
+	// never Kill() the client, that would stop the shared API server
+	rpcClient, err := client.Client()
+	if err != nil {
+		proc.removeReattach()
+		return nil, fmt.Errorf(
+			"could not reattach python API server [pid: %d], got: %s",
+			reattach.Pid, err)
+	}
+
+	raw, err := rpcClient.Dispense("grpcapi")
+	if err != nil {
+		rpcClient.Close()
+		proc.removeReattach()
+		return nil, fmt.Errorf(
+			"could not dispense reattached python API, got: %s", err)
+	}
+
+	// a server of another provider build is restarted by CreateAPI
+	impl := raw.(grpcpyapi.PythonAPI)
+	ctx, cancel := context.WithTimeout(context.Background(), apiVersionTimeout)
+	defer cancel()
+	commands, err := checkVersion(ctx, impl)
+	if err != nil {
+		rpcClient.Close()
+		proc.removeReattach()
+		return nil, fmt.Errorf(
+			"not reattaching python API server [pid: %d], got: %s",
+			reattach.Pid, err)
+	}
+
+	log.Printf("[INFO] reattached python API server [pid: %d]", reattach.Pid)
+
+	clientID := ksuid.New().String()
+	api := &NetAppAPI{
+		impl:     impl,
+		client:   client,
+		clientID: clientID,
+		session:  clientID,
+		folder:   folder,
+		pid:      reattach.Pid,
+		commands: commands,
+	}
+	api.forwardLogs(forwardLevel())
+
+	return api, nil
+}
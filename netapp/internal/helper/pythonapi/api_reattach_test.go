@@ -0,0 +1,157 @@
+package pythonapi
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	grpcpyapi "github.com/jogam/terraform-provider-netapp/netapp/internal/grpcapi"
+)
+
+// serveAPIServer serves impl with health service like the Python API server
+func serveAPIServer(t *testing.T, listener net.Listener, impl grpcpyapi.GRPCNetAppAPI) *grpc.Server {
+	server := grpc.NewServer()
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("plugin", grpc_health_v1.HealthCheckResponse_SERVING)
+	grpc_health_v1.RegisterHealthServer(server, healthServer)
+	apiPlugin := grpcpyapi.NewPluginMap(impl)["grpcapi"].(plugin.GRPCPlugin)
+	require.NoError(t, apiPlugin.GRPCServer(nil, server))
+	go server.Serve(listener)
+
+	return server
+}
+
+// serveReattach serves impl like the Python API server and saves its
+// reattach config with the test process as server PID
+func serveReattach(t *testing.T, p *apiProcess, impl grpcpyapi.GRPCNetAppAPI) net.Listener {
+	serverCmd := apiServerCmd
+	apiServerCmd = ""
+	t.Cleanup(func() { apiServerCmd = serverCmd })
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(serveAPIServer(t, listener, impl).Stop)
+
+	writeMarker(t, p, apiUpFile, "")
+	writeMarker(t, p, apiPIDFile, fmt.Sprint(os.Getpid()))
+	require.NoError(t, p.saveReattach(&plugin.ReattachConfig{
+		Protocol: plugin.ProtocolGRPC,
+		Addr:     listener.Addr(),
+		Pid:      os.Getpid(),
+	}))
+
+	return listener
+}
+
+func Test_Reattach_API(t *testing.T) {
+	r := require.New(t)
+	p := testProcess(t)
+	serveReattach(t, p, &fakeImpl{})
+
+	api, err := ReattachAPI(p.folder)
+	r.NoError(err)
+
+	resp, err := testKeyValue(api, &KeyValueRequest{Key: "k", Value: "v"})
+	r.NoError(err)
+	r.Equal("v", resp.Value)
+	r.True(p.exists(apiReattachFile))
+}
+
+func Test_Reattach_Session(t *testing.T) {
+	r := require.New(t)
+	p := testProcess(t)
+	impl := &fakeImpl{}
+	listener := serveReattach(t, p, impl)
+
+	first, err := ReattachAPI(p.folder)
+	r.NoError(err)
+	first.ReuseSession("foo@cookie")
+	_, err = testKeyValue(first, &KeyValueRequest{Key: "k"})
+	r.NoError(err)
+
+	// the reused session stays open, the client is shut down
+	r.NoError(first.Stop())
+	r.Equal([]string{first.clientID}, impl.shutdowns)
+	r.NotEqual(first.clientID, impl.sessions[0])
+
+	second, err := ReattachAPI(p.folder)
+	r.NoError(err)
+	second.ReuseSession("foo@cookie")
+	_, err = testKeyValue(second, &KeyValueRequest{Key: "k"})
+	r.NoError(err)
+	r.Equal(impl.sessions[0], impl.sessions[1])
+
+	other, err := ReattachAPI(p.folder)
+	r.NoError(err)
+	other.ReuseSession("bar@cookie")
+	_, err = testKeyValue(other, &KeyValueRequest{Key: "k"})
+	r.NoError(err)
+	r.NotEqual(impl.sessions[0], impl.sessions[2])
+
+	// the sessions are kept for the same server only
+	r.NoError(p.saveReattach(&plugin.ReattachConfig{
+		Protocol: plugin.ProtocolGRPC, Addr: listener.Addr(), Pid: os.Getpid()}))
+	config, err := p.readReattach()
+	r.NoError(err)
+	r.Len(config.Sessions, 2)
+
+	r.NoError(p.saveReattach(&plugin.ReattachConfig{
+		Protocol: plugin.ProtocolGRPC, Addr: listener.Addr(), Pid: deadPID(t)}))
+	config, err = p.readReattach()
+	r.NoError(err)
+	r.Empty(config.Sessions)
+}
+
+func Test_Reattach_ServerGone(t *testing.T) {
+	r := require.New(t)
+	p := testProcess(t)
+
+	_, err := ReattachAPI(p.folder)
+	r.Error(err)
+	r.Contains(err.Error(), "no python API reattach config")
+
+	// new server with other PID
+	serveReattach(t, p, &fakeImpl{})
+	writeMarker(t, p, apiPIDFile, fmt.Sprint(deadPID(t)))
+
+	_, err = ReattachAPI(p.folder)
+	r.Error(err)
+	r.Contains(err.Error(), "not running anymore")
+	r.False(p.exists(apiReattachFile))
+
+	// server process alive but not listening anymore
+	listener := serveReattach(t, p, &fakeImpl{})
+	listener.Close()
+
+	_, err = ReattachAPI(p.folder)
+	r.Error(err)
+	r.Contains(err.Error(), "not listening on")
+	r.False(p.exists(apiReattachFile))
+}
+
+func Test_Reattach_OtherBuild(t *testing.T) {
+	r := require.New(t)
+	p := testProcess(t)
+	serveReattach(t, p, &fakeImpl{
+		version: &grpcpyapi.VersionResponse{Build: "0123456789abcdef"}})
+
+	_, err := ReattachAPI(p.folder)
+	r.Error(err)
+	r.Contains(err.Error(), "server build [0123456789ab], provider build")
+	r.False(p.exists(apiReattachFile))
+
+	// server of a provider version before the version handshake
+	serveReattach(t, p, struct{ grpcpyapi.GRPCNetAppAPI }{&fakeImpl{}})
+
+	_, err = ReattachAPI(p.folder)
+	r.Error(err)
+	r.Contains(err.Error(), "version not supported by the API")
+}
@@ -0,0 +1,86 @@
+package pythonapi
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// apiRequirementsFile is the pip requirements file of the Python API
+const apiRequirementsFile = "requirements.txt"
+
+// packageName normalizes a pip package name, pip treats case, '_' and
+// '-' alike, e.g. grpcio_tools and grpcio-tools
+func packageName(name string) string {
+	return strings.ToLower(strings.Replace(strings.TrimSpace(name), "_", "-", -1))
+}
+
+// parsePins returns the package versions of 'name==version' lines, e.g.
+// of requirements.txt or the 'pip freeze' output. Other lines, comments
+// and requirements without pinned version are ignored
+func parsePins(data []byte) map[string]string {
+	pins := map[string]string{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if idx := strings.Index(line, "#"); idx >= 0 {
+			line = line[:idx]
+		}
+
+		parts := strings.SplitN(line, "==", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		pins[packageName(parts[0])] = strings.TrimSpace(parts[1])
+	}
+
+	return pins
+}
+
+// checkPins returns an error listing the required packages missing or
+// installed with another version
+func checkPins(required, installed map[string]string) error {
+	var mismatches []string
+	for name, version := range required {
+		actual, ok := installed[name]
+		switch {
+		case !ok:
+			mismatches = append(mismatches, fmt.Sprintf("%s==%s not installed", name, version))
+		case actual != version:
+			mismatches = append(mismatches, fmt.Sprintf(
+				"%s==%s installed as %s", name, version, actual))
+		}
+	}
+	if len(mismatches) == 0 {
+		return nil
+	}
+
+	sort.Strings(mismatches)
+	return fmt.Errorf(
+		"python API virtualenv does not match %s: %s",
+		apiRequirementsFile, strings.Join(mismatches, ", "))
+}
+
+// checkAPIRequirements checks the packages installed in the virtualenv of
+// the API folder match the versions of requirements.txt
+func checkAPIRequirements(folder string) error {
+	required, err := ioutil.ReadFile(filepath.Join(folder, apiRequirementsFile))
+	if err != nil {
+		return fmt.Errorf("could not read python API requirements, got: %s", err)
+	}
+
+	python := filepath.Join(folder, "venv", "bin", "python")
+	out, err := exec.Command(python, "-m", "pip", "freeze").Output()
+	if err != nil {
+		return fmt.Errorf("could not list python API virtualenv packages, got: %s", err)
+	}
+
+	return checkPins(parsePins(required), parsePins(out))
+}
@@ -0,0 +1,41 @@
+package pythonapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParsePins(t *testing.T) {
+	r := require.New(t)
+
+	pins := parsePins([]byte(`# comment
+grpcio==1.15.0
+grpcio_tools == 1.15.0  # trailing comment
+six>=1.11.0
+-e git+https://example.com/pkg.git#egg=pkg
+Protobuf==3.6.1
+`))
+
+	r.Equal(map[string]string{
+		"grpcio":       "1.15.0",
+		"grpcio-tools": "1.15.0",
+		"protobuf":     "3.6.1",
+	}, pins)
+}
+
+func Test_CheckPins(t *testing.T) {
+	r := require.New(t)
+
+	required := map[string]string{"grpcio": "1.15.0", "protobuf": "3.6.1", "six": "1.11.0"}
+	r.NoError(checkPins(required, map[string]string{
+		"grpcio": "1.15.0", "protobuf": "3.6.1", "six": "1.11.0", "wheel": "0.31.1"}))
+
+	err := checkPins(required, map[string]string{"grpcio": "1.16.0", "six": "1.11.0"})
+	r.Error(err)
+	r.Contains(err.Error(),
+		"grpcio==1.15.0 installed as 1.16.0, protobuf==3.6.1 not installed")
+
+	// no virtualenv in the folder
+	r.Error(checkAPIRequirements(t.TempDir()))
+}
@@ -0,0 +1,83 @@
+package pythonapi
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	grpcpyapi "github.com/jogam/terraform-provider-netapp/netapp/internal/grpcapi"
+)
+
+// errOtherBuild is the version check failure of an API server started by
+// another provider build, such a server is restarted
+var errOtherBuild = errors.New("python API server of another provider build")
+
+// apiBuildEnv passes the build of the provider to the API server it starts
+const apiBuildEnv = "NETAPP_API_BUILD"
+
+// apiVersionTimeout limits the version check of a running API server
+const apiVersionTimeout = 5 * time.Second
+
+// BuildHash returns the build of the Python API files of this provider,
+// the SHA-256 over the pythonManifest. A running API server of another
+// build is not reused
+func BuildHash() string {
+	paths := make([]string, 0, len(pythonManifest))
+	for path := range pythonManifest {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	hash := sha256.New()
+	for _, path := range paths {
+		fmt.Fprintf(hash, "%s  %s\n", pythonManifest[path], path)
+	}
+
+	return fmt.Sprintf("%x", hash.Sum(nil))
+}
+
+// shortBuild returns the build abbreviated for messages
+func shortBuild(build string) string {
+	if build == "" {
+		return "unknown"
+	}
+	if len(build) > 12 {
+		return build[:12]
+	}
+
+	return build
+}
+
+// checkVersion returns the commands of the API server, an error if the
+// server is of another build or without version
+func checkVersion(ctx context.Context, impl grpcpyapi.PythonAPI) (map[string]bool, error) {
+	versioned, ok := impl.(grpcpyapi.Versioned)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errOtherBuild, grpcpyapi.ErrVersionUnsupported)
+	}
+
+	version, err := versioned.GetVersion(ctx)
+	if errors.Is(err, grpcpyapi.ErrVersionUnsupported) {
+		// server of a provider version before the version handshake
+		return nil, fmt.Errorf("%w: %s", errOtherBuild, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not get python API server version, got: %s", err)
+	}
+
+	if build := BuildHash(); version.Build != build {
+		return nil, fmt.Errorf(
+			"%w: server build [%s], provider build [%s]",
+			errOtherBuild, shortBuild(version.Build), shortBuild(build))
+	}
+
+	commands := make(map[string]bool, len(version.Commands))
+	for _, cmdName := range version.Commands {
+		commands[cmdName] = true
+	}
+
+	return commands, nil
+}
@@ -0,0 +1,56 @@
+package pythonapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	grpcpyapi "github.com/jogam/terraform-provider-netapp/netapp/internal/grpcapi"
+)
+
+func Test_Version_BuildHash(t *testing.T) {
+	r := require.New(t)
+
+	build := BuildHash()
+	r.Len(build, 64)
+	r.Equal(build, BuildHash())
+
+	sum := pythonManifest[grpcpyapi.APIMain]
+	defer func() { pythonManifest[grpcpyapi.APIMain] = sum }()
+	pythonManifest[grpcpyapi.APIMain] = "changed"
+	r.NotEqual(build, BuildHash())
+}
+
+func Test_Version_Check(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+
+	commands, err := checkVersion(ctx, &fakeImpl{})
+	r.NoError(err)
+	r.Equal(map[string]bool{testKeyValueCmd: true}, commands)
+
+	_, err = checkVersion(ctx, &fakeImpl{
+		version: &grpcpyapi.VersionResponse{Build: "other"}})
+	r.True(errors.Is(err, errOtherBuild))
+	r.Contains(err.Error(), "server build [other]")
+
+	_, err = checkVersion(ctx, struct{ grpcpyapi.GRPCNetAppAPI }{&fakeImpl{}})
+	r.True(errors.Is(err, errOtherBuild))
+}
+
+func Test_Version_UnsupportedCommand(t *testing.T) {
+	r := require.New(t)
+	impl := &fakeImpl{}
+	api := NewNetAppAPI(impl)
+	api.commands = map[string]bool{testKeyValueCmd: true}
+
+	_, err := testKeyValue(api, &KeyValueRequest{Key: "k", Value: "v"})
+	r.NoError(err)
+
+	err = api.Call(context.Background(), "SVM.NEW.CMD", &KeyValueRequest{}, &KeyValueResponse{})
+	r.Error(err)
+	r.Contains(err.Error(), "api call [SVM.NEW.CMD] not supported by the python API server")
+	r.Equal([]string{testKeyValueCmd}, impl.cmds)
+}
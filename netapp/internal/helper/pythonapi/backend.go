@@ -0,0 +1,170 @@
+package pythonapi
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	grpcpyapi "github.com/jogam/terraform-provider-netapp/netapp/internal/grpcapi"
+)
+
+// Backend executes NetApp API commands, the request is JSON marshalled
+// and the JSON result is unmarshalled into response. It is implemented
+// by the NetApp API and the native ZAPI/REST clients, which take the
+// request and fill response without JSON, see Convert. Backends can be
+// wrapped by middlewares, e.g. logging, retry or caching, or replaced by
+// in-process fakes for testing
+type Backend interface {
+	Call(ctx context.Context, cmdName string, request, response interface{}) error
+}
+
+// JobWatcher is implemented by backends that push the state changes of
+// long running ONTAP jobs instead of being polled with SYS.JOB.GET. Every
+// job state is unmarshalled into response before update is called, the
+// watch ends once the job ended, update fails or the context is done
+type JobWatcher interface {
+	Backend
+	WatchJob(ctx context.Context, request, response interface{}, update func() error) error
+}
+
+// ErrWatchUnsupported is returned by JobWatcher backends whose API can
+// only be polled, e.g. the REST API
+var ErrWatchUnsupported = grpcpyapi.ErrWatchUnsupported
+
+// BatchItem is a command of a batch call, its result is unmarshalled into
+// Response or its error is Err
+type BatchItem struct {
+	Cmd      string
+	Request  interface{}
+	Response interface{}
+	Err      error
+}
+
+// Batcher is implemented by backends that execute several commands in one
+// round trip, e.g. the reads of a refresh. The items are executed in order
+// and a failed item does not stop the batch, the batch only fails as a
+// whole if it was not executed, e.g. on transport errors
+type Batcher interface {
+	Backend
+	CallBatch(ctx context.Context, items []*BatchItem) error
+}
+
+// ErrBatchUnsupported is returned by Batcher backends whose API executes
+// one command per round trip
+var ErrBatchUnsupported = grpcpyapi.ErrBatchUnsupported
+
+// CallBatch executes the items in one round trip if the client is a
+// Batcher, otherwise item by item. The error of each item is its Err
+func CallBatch(ctx context.Context, client Backend, items []*BatchItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	if batcher, ok := client.(Batcher); ok {
+		err := batcher.CallBatch(ctx, items)
+		if err != ErrBatchUnsupported {
+			return err
+		}
+	}
+
+	for _, item := range items {
+		item.Err = client.Call(ctx, item.Cmd, item.Request, item.Response)
+	}
+
+	return nil
+}
+
+// BackendFunc is an adapter to use ordinary functions as Backend
+type BackendFunc func(ctx context.Context, cmdName string, request, response interface{}) error
+
+// Call calls f(ctx, cmdName, request, response)
+func (f BackendFunc) Call(
+	ctx context.Context, cmdName string,
+	request, response interface{}) error {
+	return f(ctx, cmdName, request, response)
+}
+
+// Convert stores src in dst, a pointer. A value or pointer of the type of
+// dst is copied as is, other types go through their JSON encoding like
+// with the python API, e.g. a ResourceInfo result into a resource info
+func Convert(src, dst interface{}) error {
+	value := reflect.ValueOf(src)
+	target := reflect.ValueOf(dst).Elem()
+	if value.Kind() == reflect.Ptr && !value.IsNil() {
+		value = value.Elem()
+	}
+	if value.IsValid() && value.Type() == target.Type() {
+		target.Set(value)
+		return nil
+	}
+
+	data, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, dst)
+}
+
+// Middleware wraps a Backend with additional behaviour
+type Middleware func(next Backend) Backend
+
+// Wrap returns the backend wrapped by the middlewares, the first
+// middleware is the outermost and sees each call first
+func Wrap(backend Backend, middlewares ...Middleware) Backend {
+	for idx := len(middlewares) - 1; idx >= 0; idx-- {
+		backend = middlewares[idx](backend)
+	}
+
+	return backend
+}
+
+// WatchFunc is an adapter to use ordinary functions as JobWatcher method
+type WatchFunc func(ctx context.Context, request, response interface{}, update func() error) error
+
+// WatchJob calls f(ctx, request, response, update)
+func (f WatchFunc) WatchJob(
+	ctx context.Context, request, response interface{},
+	update func() error) error {
+	return f(ctx, request, response, update)
+}
+
+// BatchFunc is an adapter to use ordinary functions as Batcher method
+type BatchFunc func(ctx context.Context, items []*BatchItem) error
+
+// CallBatch calls f(ctx, items)
+func (f BatchFunc) CallBatch(ctx context.Context, items []*BatchItem) error {
+	return f(ctx, items)
+}
+
+// extend returns the call of a middleware with the job watches and batches
+// of the wrapped backend next, if next supports them. They are passed on
+// by watch and batch, e.g. to apply the middleware to them as well
+func extend(
+	call Backend, next Backend,
+	watch func(JobWatcher) WatchFunc, batch func(Batcher) BatchFunc) Backend {
+
+	watcher, isWatcher := next.(JobWatcher)
+	batcher, isBatcher := next.(Batcher)
+
+	switch {
+	case isWatcher && isBatcher:
+		return &struct {
+			Backend
+			WatchFunc
+			BatchFunc
+		}{call, watch(watcher), batch(batcher)}
+	case isWatcher:
+		return &struct {
+			Backend
+			WatchFunc
+		}{call, watch(watcher)}
+	case isBatcher:
+		return &struct {
+			Backend
+			BatchFunc
+		}{call, batch(batcher)}
+	}
+
+	return call
+}
@@ -0,0 +1,125 @@
+package pythonapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	grpcpyapi "github.com/jogam/terraform-provider-netapp/netapp/internal/grpcapi"
+)
+
+// fakeImpl echoes the request data or fails with the configured message
+// and errno, its version is of this provider build unless configured
+type fakeImpl struct {
+	errmsg    string
+	errno     int32
+	cmds      []string
+	sessions  []string
+	shutdowns []string
+	version   *grpcpyapi.VersionResponse
+}
+
+func (f *fakeImpl) GetVersion(ctx context.Context) (*grpcpyapi.VersionResponse, error) {
+	if f.version != nil {
+		return f.version, nil
+	}
+
+	return &grpcpyapi.VersionResponse{
+		Build: BuildHash(), Commands: []string{testKeyValueCmd}}, nil
+}
+
+func (f *fakeImpl) Call(
+	ctx context.Context, cmd string, data []byte) (*grpcpyapi.CallResponse, error) {
+	f.cmds = append(f.cmds, cmd)
+	f.sessions = append(f.sessions, grpcpyapi.SessionFromContext(ctx))
+	if f.errmsg != "" {
+		return &grpcpyapi.CallResponse{Errmsg: f.errmsg, Errno: f.errno}, nil
+	}
+
+	return &grpcpyapi.CallResponse{Success: true, Data: data}, nil
+}
+
+func (f *fakeImpl) Shutdown(ctx context.Context, clientID string) (bool, error) {
+	f.shutdowns = append(f.shutdowns, clientID)
+	return true, nil
+}
+
+func Test_Backend_NetAppAPI(t *testing.T) {
+	r := require.New(t)
+	impl := &fakeImpl{}
+	var api Backend = NewNetAppAPI(impl)
+
+	resp, err := testKeyValue(api, &KeyValueRequest{Key: "k", Value: "v"})
+	r.NoError(err)
+	r.Equal("v", resp.Value)
+	r.Equal([]string{testKeyValueCmd}, impl.cmds)
+
+	impl.errmsg = "failed cmd [TEST.KEYVALUE] with boom"
+	_, err = testKeyValue(api, &KeyValueRequest{Key: "k"})
+	r.Error(err)
+	r.Contains(err.Error(), "boom")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = api.Call(ctx, testKeyValueCmd, &KeyValueRequest{}, &KeyValueResponse{})
+	r.Error(err)
+	r.Len(impl.cmds, 2)
+}
+
+func Test_Backend_Wrap(t *testing.T) {
+	r := require.New(t)
+	calls := []string{}
+
+	tag := func(name string) Middleware {
+		return func(next Backend) Backend {
+			return BackendFunc(func(
+				ctx context.Context, cmdName string,
+				request, response interface{}) error {
+				calls = append(calls, name)
+				return next.Call(ctx, cmdName, request, response)
+			})
+		}
+	}
+
+	fake := BackendFunc(func(
+		ctx context.Context, cmdName string,
+		request, response interface{}) error {
+		calls = append(calls, cmdName)
+		response.(*KeyValueResponse).Modified = true
+		return nil
+	})
+
+	resp, err := testKeyValue(
+		Wrap(fake, tag("outer"), tag("inner")), &KeyValueRequest{})
+	r.NoError(err)
+	r.True(resp.Modified)
+	r.Equal([]string{"outer", "inner", testKeyValueCmd}, calls)
+}
+
+func Test_Backend_Convert(t *testing.T) {
+	r := require.New(t)
+
+	// same type is copied without JSON, the unexported field tells
+	type info struct {
+		ResourceInfo
+		Name   string `json:"name"`
+		hidden int
+	}
+	actual := &info{}
+	r.NoError(Convert(&info{Name: "e0c", hidden: 1}, actual))
+	r.Equal(&info{Name: "e0c", hidden: 1}, actual)
+
+	actual = &info{}
+	r.NoError(Convert(info{Name: "e0d"}, actual))
+	r.Equal("e0d", actual.Name)
+
+	// other types through JSON
+	actual = &info{}
+	r.NoError(Convert(&ResourceInfo{NonExist: true}, actual))
+	r.True(actual.NonExist)
+
+	actual = &info{}
+	r.NoError(Convert(map[string]string{"name": "e0e"}, actual))
+	r.Equal("e0e", actual.Name)
+}
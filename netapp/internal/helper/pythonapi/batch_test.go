@@ -0,0 +1,137 @@
+package pythonapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	grpcpyapi "github.com/jogam/terraform-provider-netapp/netapp/internal/grpcapi"
+)
+
+// batchImpl executes the batch calls one by one, the first call of each
+// key is locked if locked is set
+type batchImpl struct {
+	fakeImpl
+	batches int
+	locked  map[string]bool
+}
+
+func (b *batchImpl) CallBatch(
+	ctx context.Context,
+	calls []*grpcpyapi.CallRequest) ([]*grpcpyapi.CallResponse, error) {
+	b.batches++
+
+	var results []*grpcpyapi.CallResponse
+	for _, call := range calls {
+		resp, _ := b.Call(ctx, call.Cmd, call.Data)
+		if b.locked != nil && !b.locked[string(call.Data)] {
+			b.locked[string(call.Data)] = true
+			resp = &grpcpyapi.CallResponse{Errmsg: "object busy", Errno: ErrnoBusy}
+		}
+
+		results = append(results, resp)
+	}
+
+	return results, nil
+}
+
+func testBatch(keys ...string) []*BatchItem {
+	var items []*BatchItem
+	for _, key := range keys {
+		items = append(items, &BatchItem{
+			Cmd:      testKeyValueCmd,
+			Request:  &KeyValueRequest{Key: key, Value: "v-" + key},
+			Response: &KeyValueResponse{}})
+	}
+
+	return items
+}
+
+func Test_Batch_NetAppAPI(t *testing.T) {
+	r := require.New(t)
+	impl := &batchImpl{}
+	api := NewNetAppAPI(impl)
+
+	items := testBatch("a", "b")
+	items = append(items, &BatchItem{
+		Cmd: "SYS.UNKNOWN", Request: &KeyValueRequest{}, Response: &KeyValueResponse{}})
+	api.commands = map[string]bool{testKeyValueCmd: true}
+
+	r.NoError(CallBatch(context.Background(), api, items))
+	r.Equal(1, impl.batches)
+	r.Equal([]string{testKeyValueCmd, testKeyValueCmd}, impl.cmds)
+	r.NoError(items[0].Err)
+	r.Equal("v-a", items[0].Response.(*KeyValueResponse).Value)
+	r.NoError(items[1].Err)
+	r.Equal("v-b", items[1].Response.(*KeyValueResponse).Value)
+	r.Error(items[2].Err)
+	r.Contains(items[2].Err.Error(), "not supported by the python API server")
+
+	// failed items are API errors of their command
+	impl.errmsg = "failed cmd [TEST.KEYVALUE] with boom"
+	impl.errno = 13
+	items = testBatch("a")
+	r.NoError(CallBatch(context.Background(), api, items))
+	r.Equal(13, Errno(items[0].Err))
+}
+
+func Test_Batch_Unsupported(t *testing.T) {
+	r := require.New(t)
+	impl := &fakeImpl{}
+	api := Wrap(NewNetAppAPI(impl), NewMetrics().Middleware(),
+		Retry(context.Background(), testRetryPolicy),
+		Deadline(context.Background(), time.Second, nil))
+
+	items := testBatch("a", "b")
+	r.NoError(CallBatch(context.Background(), api, items))
+	r.Equal([]string{testKeyValueCmd, testKeyValueCmd}, impl.cmds)
+	r.Equal("v-b", items[1].Response.(*KeyValueResponse).Value)
+
+	// backends without batches, e.g. the cassette player
+	echo := BackendFunc(func(
+		ctx context.Context, cmdName string, request, response interface{}) error {
+		response.(*KeyValueResponse).Value = request.(*KeyValueRequest).Value
+		return nil
+	})
+	items = testBatch("c")
+	r.NoError(CallBatch(context.Background(), echo, items))
+	r.Equal("v-c", items[0].Response.(*KeyValueResponse).Value)
+}
+
+func Test_Batch_Middlewares(t *testing.T) {
+	r := require.New(t)
+	impl := &batchImpl{locked: map[string]bool{}}
+	metrics := NewMetrics()
+	api := Wrap(NewNetAppAPI(impl), metrics.Middleware(),
+		Retry(context.Background(), testRetryPolicy),
+		Deadline(context.Background(), time.Second, nil))
+
+	_, ok := api.(JobWatcher)
+	r.True(ok)
+
+	// the locked reads are retried one by one
+	items := testBatch("a", "b")
+	for _, item := range items {
+		item.Cmd = "SYS.NODE.GET"
+	}
+	r.NoError(CallBatch(context.Background(), api, items))
+	r.Equal(1, impl.batches)
+	r.Len(impl.cmds, 4)
+	r.NoError(items[0].Err)
+	r.NoError(items[1].Err)
+	r.Equal("v-a", items[0].Response.(*KeyValueResponse).Value)
+
+	commands := map[string]int{}
+	for _, cm := range metrics.Commands() {
+		commands[cm.Command] = cm.Calls
+	}
+	r.Equal(map[string]int{MetricsBatch: 1, "SYS.NODE.GET": 2}, commands)
+
+	// the batch is cancelled with its context
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	items = testBatch("c")
+	r.Error(CallBatch(ctx, api, items))
+}
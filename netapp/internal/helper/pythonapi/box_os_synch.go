@@ -1,7 +1,7 @@
 package pythonapi
 
 import (
-	"crypto/md5"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -73,7 +73,49 @@ func (sR SyncResult) GetFilePath(boxPath string) (string, error) {
 	return file.FilePath, nil
 }
 
-// SynchBoxToOS synchronize box folder ./python to provided folder
+//go:generate go run manifest_gen.go
+
+// file modes of the API folder, only the owner writes the API files and
+// only the scripts are executable
+const (
+	apiDirMode    os.FileMode = 0750
+	apiFileMode   os.FileMode = 0640
+	apiScriptMode os.FileMode = 0750
+)
+
+// boxFileMode returns the mode of the API file at box path
+func boxFileMode(path string) os.FileMode {
+	if strings.HasSuffix(path, ".sh") {
+		return apiScriptMode
+	}
+
+	return apiFileMode
+}
+
+// ensureAPIFolder creates the API folder, a world-writable folder is
+// refused since others could replace the scripts the provider executes
+func ensureAPIFolder(folder string) error {
+	if err := os.MkdirAll(folder, apiDirMode); err != nil {
+		return fmt.Errorf(
+			"could not create python API folder [%s], got: %s", folder, err)
+	}
+
+	info, err := os.Stat(folder)
+	if err != nil {
+		return fmt.Errorf(
+			"could not stat python API folder [%s], got: %s", folder, err)
+	}
+	if info.Mode().Perm()&0002 != 0 {
+		return fmt.Errorf(
+			"python API folder [%s] is world-writable [%s], remove the write permission of others",
+			folder, info.Mode().Perm())
+	}
+
+	return nil
+}
+
+// SynchBoxToOS synchronize box folder ./python to provided folder, the
+// box files must match the SHA-256 of the pythonManifest
 func SynchBoxToOS(folder string, requiredFiles *[]string) (*SyncResult, error) {
 
 	// create packr box
@@ -88,7 +130,9 @@ func SynchBoxToOS(folder string, requiredFiles *[]string) (*SyncResult, error) {
 	}
 
 	// ensure folder exists on host drive
-	os.MkdirAll(folder, os.ModePerm)
+	if err := ensureAPIFolder(folder); err != nil {
+		return nil, err
+	}
 
 	// walk the packr box content and ensure files in folder are identical
 	done := make(chan struct{})
@@ -146,7 +190,7 @@ func DirDeleteRecursive(path string) error {
 
 //**************************************************************************
 //   the actual extraction of the python source to source OS
-//   MD5 Sum Source: https://blog.golang.org/pipelines#TOC_8.
+//   Source: https://blog.golang.org/pipelines#TOC_8.
 //**************************************************************************
 
 // a result is a python source file with its OS availability status
@@ -171,7 +215,7 @@ func createPythonProjectFolder(done <-chan struct{},
 				return err
 			}
 
-			if !fInfo.Mode().IsRegular() {
+			if !fInfo.Mode().IsRegular() || manifestIgnored(path) {
 				return nil
 			}
 
@@ -207,12 +251,27 @@ func createPythonProjectFolder(done <-chan struct{},
 	return resc, errc
 }
 
+// manifestIgnored reports whether the box path is Python bytecode, e.g.
+// of a development run, which is neither in the manifest nor extracted
+func manifestIgnored(path string) bool {
+	return strings.HasSuffix(path, ".pyc") ||
+		strings.Contains(filepath.ToSlash(path), "__pycache__/")
+}
+
 // processFilePath returns a {result} for the extraction of the file
 // for given path from box to the host OS at provided osRoot folder
 func processFilePath(osRoot string, path string, box packr.Box) prjFileResult {
 	// create the OS full file path from path
 	osFilePath := filepath.Join(osRoot, path)
 
+	// the box file must be listed with its hash in the manifest
+	boxPath := filepath.ToSlash(path)
+	sum, ok := pythonManifest[boxPath]
+	if !ok {
+		return prjFileResult{path, osFilePath, false, false,
+			fmt.Errorf("box file not in manifest")}
+	}
+
 	// try to read box file at path to byte[]
 	boxFileData, bfdErr := box.MustBytes(path)
 
@@ -221,36 +280,38 @@ func processFilePath(osRoot string, path string, box packr.Box) prjFileResult {
 		return prjFileResult{path, osFilePath, false, false, bfdErr}
 	}
 
+	if boxSum := fmt.Sprintf("%x", sha256.Sum256(boxFileData)); boxSum != sum {
+		return prjFileResult{path, osFilePath, false, false, fmt.Errorf(
+			"box file SHA-256 [%s] does not match manifest [%s]", boxSum, sum)}
+	}
+
+	mode := boxFileMode(boxPath)
+
 	// try reading the file from OS
 	osFileData, ofdErr := ioutil.ReadFile(osFilePath)
 
-	// flag to indicate if BOX file needs to be written
-	wrFileToOS := false
-
 	if ofdErr != nil {
-		// either host file corrupt or not existent
+		// either host file corrupt or not existent, ensure file folder exists
 		osFileDir, _ := filepath.Split(osFilePath)
-		// ensure file folder exists
-		os.MkdirAll(osFileDir, os.ModePerm)
-		// flag file needs to be written
-		wrFileToOS = true
+		if err := os.MkdirAll(osFileDir, apiDirMode); err != nil {
+			return prjFileResult{path, osFilePath, false, false, err}
+		}
 	}
 
-	boxfMD5 := md5.Sum(boxFileData)
-	osfMD5 := md5.Sum(osFileData)
-
-	log.Printf("[DEBUG] processing path [%v] box vs OS: %v vs %v", path, boxfMD5, osfMD5)
+	osSum := fmt.Sprintf("%x", sha256.Sum256(osFileData))
+	log.Printf("[DEBUG] processing path [%v] manifest vs OS: %v vs %v", path, sum, osSum)
 
-	if boxfMD5 != osfMD5 {
-		// OS file different from package file, needs writing
-		wrFileToOS = true
+	if ofdErr == nil && osSum == sum {
+		// restrict files written with wider modes by earlier versions
+		err := os.Chmod(osFilePath, mode)
+		return prjFileResult{path, osFilePath, err == nil, false, err}
 	}
 
-	if wrFileToOS {
-		// write box file bytes to file <-- think about permission (read/exec only?)
-		fwrErr := ioutil.WriteFile(osFilePath, boxFileData, os.ModePerm)
-		return prjFileResult{path, osFilePath, fwrErr == nil, true, fwrErr}
+	// OS file missing or different from package file, write it with the
+	// restrictive mode, WriteFile keeps the mode of an existing file
+	fwrErr := ioutil.WriteFile(osFilePath, boxFileData, mode)
+	if fwrErr == nil {
+		fwrErr = os.Chmod(osFilePath, mode)
 	}
-
-	return prjFileResult{path, osFilePath, true, false, nil}
+	return prjFileResult{path, osFilePath, fwrErr == nil, true, fwrErr}
 }
@@ -1,6 +1,9 @@
 package pythonapi
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -8,11 +11,67 @@ import (
 
 func Test_SynchBoxToOS(t *testing.T) {
 	r := require.New(t)
-	res, err := SynchBoxToOS(tmpDir, &requiredAPIScripts)
+	folder := filepath.Join(t.TempDir(), "api")
+
+	res, err := SynchBoxToOS(folder, &requiredAPIScripts)
 	r.NoError(err)
-	r.Equal(12, res.FileCount())
+	r.Equal(len(pythonManifest), res.FileCount())
 
 	for _, fRes := range res.files {
 		r.True(fRes.Updated && fRes.Available)
+
+		info, err := os.Stat(fRes.FilePath)
+		r.NoError(err)
+		r.Equal(boxFileMode(filepath.ToSlash(fRes.BoxPath)), info.Mode().Perm(), fRes.BoxPath)
+	}
+
+	info, err := os.Stat(folder)
+	r.NoError(err)
+	r.Equal(apiDirMode, info.Mode().Perm())
+
+	// a tampered script is replaced, a widened mode restricted again
+	script, err := res.GetFilePath("scripts/start_api.sh")
+	r.NoError(err)
+	r.NoError(ioutil.WriteFile(script, []byte("#!/bin/sh\nrm -rf /\n"), 0777))
+	r.NoError(os.Chmod(script, 0777))
+	reqs, err := res.GetFilePath(apiRequirementsFile)
+	r.NoError(err)
+	r.NoError(os.Chmod(reqs, 0666))
+
+	res, err = SynchBoxToOS(folder, &requiredAPIScripts)
+	r.NoError(err)
+	for _, fRes := range res.files {
+		r.Equal(fRes.BoxPath == "scripts/start_api.sh", fRes.Updated, fRes.BoxPath)
+
+		info, err := os.Stat(fRes.FilePath)
+		r.NoError(err)
+		r.Equal(boxFileMode(filepath.ToSlash(fRes.BoxPath)), info.Mode().Perm(), fRes.BoxPath)
+	}
+}
+
+func Test_SynchBoxToOS_WorldWritable(t *testing.T) {
+	r := require.New(t)
+	folder := t.TempDir()
+	r.NoError(os.Chmod(folder, 0777))
+
+	_, err := SynchBoxToOS(folder, &requiredAPIScripts)
+	r.Error(err)
+	r.Contains(err.Error(), "world-writable")
+
+	_, err = newAPIProcess(folder, "1234", "56789").lock(apiLockTimeout)
+	r.Error(err)
+	r.Contains(err.Error(), "world-writable")
+}
+
+func Test_SynchBoxToOS_Manifest(t *testing.T) {
+	r := require.New(t)
+
+	r.True(manifestIgnored("__pycache__/grpcapi.cpython-36.pyc"))
+	r.True(manifestIgnored("apicmd/__pycache__/svm.cpython-36.pyc"))
+	r.False(manifestIgnored("apicmd/svm.py"))
+
+	// every required file is listed, see go:generate of box_os_synch.go
+	for _, path := range requiredAPIScripts {
+		r.Contains(pythonManifest, path)
 	}
 }
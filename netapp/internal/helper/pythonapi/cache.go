@@ -0,0 +1,260 @@
+package pythonapi
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+)
+
+// cachedCommands are the idempotent lookups served from the read cache,
+// e.g. the IPspace and aggregate of every SVM read
+var cachedCommands = map[string]bool{
+	"NW.IPSPACE.GET": true,
+	"SYS.AGGR.GET":   true,
+	"SYS.NODE.GET":   true,
+	"NW.BRCDOM.GET":  true,
+}
+
+// cacheDependents are the object types whose cached infos list objects of
+// the mutated type, e.g. the subnets of a broadcast domain. A mutation
+// invalidates its own object type and the dependents
+var cacheDependents = map[string][]string{
+	"NW.IPSPACE":    {"NW.BRCDOM"},
+	"NW.BRCDOM":     {"NW.IPSPACE"},
+	"NW.SUBNET":     {"NW.BRCDOM"},
+	"NW.VLAN":       {"NW.IPSPACE", "NW.BRCDOM"},
+	"SYS.PORT":      {"NW.IPSPACE", "NW.BRCDOM"},
+	"SYS.PORTGROUP": {"NW.IPSPACE", "NW.BRCDOM"},
+	"SVM":           {"NW.IPSPACE", "SYS.AGGR"},
+	"SVM.VOL":       {"SYS.AGGR"},
+}
+
+// jobGetCmd polls the jobs of mutations, e.g. SVM.CREATE
+const jobGetCmd = "SYS.JOB.GET"
+
+// objectType returns the object type of the command, e.g. NW.BRCDOM of
+// NW.BRCDOM.PORT.ADD or SVM of SVM.CREATE
+func objectType(cmdName string) string {
+	parts := strings.Split(cmdName, ".")
+	if len(parts) < 3 {
+		return parts[0]
+	}
+
+	return parts[0] + "." + parts[1]
+}
+
+// cacheEntry is the JSON response of a cached lookup
+type cacheEntry struct {
+	data    []byte
+	expires time.Time
+}
+
+// ReadCache serves the lookups of cachedCommands for ttl from memory. All
+// cached infos of an object type are invalidated by any mutating command
+// on the object type, see cacheDependents, and by the jobs of mutations
+type ReadCache struct {
+	mutex   sync.Mutex
+	ttl     time.Duration
+	entries map[string]map[string]*cacheEntry // key: object type, request
+	// generations of the object types, a lookup is only cached if no
+	// mutation of its object type started or ended meanwhile
+	generations map[string]int
+
+	// now is the cache clock, replaced by tests
+	now func() time.Time
+}
+
+// NewReadCache returns an empty cache keeping the lookups for ttl
+func NewReadCache(ttl time.Duration) *ReadCache {
+	return &ReadCache{
+		ttl:         ttl,
+		entries:     map[string]map[string]*cacheEntry{},
+		generations: map[string]int{},
+		now:         time.Now,
+	}
+}
+
+func cacheKey(cmdName string, request interface{}) (string, bool) {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return "", false
+	}
+
+	return cmdName + " " + string(data), true
+}
+
+// get unmarshals the cached response into response
+func (rc *ReadCache) get(cmdName, key string, response interface{}) bool {
+	rc.mutex.Lock()
+	entry, ok := rc.entries[objectType(cmdName)][key]
+	rc.mutex.Unlock()
+
+	if !ok || rc.now().After(entry.expires) {
+		return false
+	}
+
+	return json.Unmarshal(entry.data, response) == nil
+}
+
+// generation returns the generation of the object type of the lookup
+func (rc *ReadCache) generation(cmdName string) int {
+	rc.mutex.Lock()
+	defer rc.mutex.Unlock()
+
+	return rc.generations[objectType(cmdName)]
+}
+
+// put caches the response unless the object type changed since generation
+func (rc *ReadCache) put(cmdName, key string, generation int, response interface{}) {
+	data, err := json.Marshal(response)
+	if err != nil {
+		return
+	}
+
+	rc.mutex.Lock()
+	defer rc.mutex.Unlock()
+
+	objType := objectType(cmdName)
+	if rc.generations[objType] != generation {
+		return
+	}
+	if rc.entries[objType] == nil {
+		rc.entries[objType] = map[string]*cacheEntry{}
+	}
+	rc.entries[objType][key] = &cacheEntry{data: data, expires: rc.now().Add(rc.ttl)}
+}
+
+// invalidate drops the cached infos changed by the command, all of them
+// if the command is empty, e.g. once a job ended
+func (rc *ReadCache) invalidate(cmdName string) {
+	rc.mutex.Lock()
+	defer rc.mutex.Unlock()
+
+	if cmdName == "" {
+		// all object types, a lookup without cached entry yet might be
+		// in flight
+		for cached := range cachedCommands {
+			rc.generations[objectType(cached)]++
+		}
+		rc.entries = map[string]map[string]*cacheEntry{}
+		return
+	}
+
+	objType := objectType(cmdName)
+	for _, changed := range append([]string{objType}, cacheDependents[objType]...) {
+		rc.generations[changed]++
+		delete(rc.entries, changed)
+	}
+}
+
+// mutates reports whether the command invalidates cached infos, the job
+// polls invalidate all of them, the job might have ended
+func mutates(cmdName string) bool {
+	return !isRead(cmdName) || cmdName == jobGetCmd
+}
+
+// invalidateFor invalidates the cached infos changed by the command
+func (rc *ReadCache) invalidateFor(cmdName string) {
+	if cmdName == jobGetCmd {
+		rc.invalidate("")
+	} else {
+		rc.invalidate(cmdName)
+	}
+}
+
+// Middleware serves the cached lookups and invalidates the cache with the
+// mutations passing through, before and after their execution. Batches
+// only pass on the items which are not cached
+func (rc *ReadCache) Middleware() Middleware {
+	return func(next Backend) Backend {
+		call := BackendFunc(func(
+			ctx context.Context, cmdName string,
+			request, response interface{}) error {
+
+			if mutates(cmdName) {
+				rc.invalidateFor(cmdName)
+				defer rc.invalidateFor(cmdName)
+
+				return next.Call(ctx, cmdName, request, response)
+			}
+
+			key, ok := cacheKey(cmdName, request)
+			if !cachedCommands[cmdName] || !ok {
+				return next.Call(ctx, cmdName, request, response)
+			}
+			if rc.get(cmdName, key, response) {
+				return nil
+			}
+
+			generation := rc.generation(cmdName)
+			err := next.Call(ctx, cmdName, request, response)
+			if err == nil {
+				rc.put(cmdName, key, generation, response)
+			}
+
+			return err
+		})
+
+		watch := func(next JobWatcher) WatchFunc {
+			return func(
+				ctx context.Context, request, response interface{},
+				update func() error) error {
+
+				// the job changed objects until it ended
+				defer rc.invalidate("")
+				return next.WatchJob(ctx, request, response, update)
+			}
+		}
+
+		batch := func(next Batcher) BatchFunc {
+			return func(ctx context.Context, items []*BatchItem) error {
+				return rc.callBatch(ctx, next, items)
+			}
+		}
+
+		return extend(call, next, watch, batch)
+	}
+}
+
+// callBatch passes the items on which are not served from the cache
+func (rc *ReadCache) callBatch(ctx context.Context, next Batcher, items []*BatchItem) error {
+	type lookup struct {
+		key        string
+		generation int
+	}
+
+	var pending []*BatchItem
+	lookups := map[*BatchItem]*lookup{}
+	for _, item := range items {
+		if mutates(item.Cmd) {
+			rc.invalidateFor(item.Cmd)
+			defer rc.invalidateFor(item.Cmd)
+		} else if key, ok := cacheKey(item.Cmd, item.Request); ok && cachedCommands[item.Cmd] {
+			if rc.get(item.Cmd, key, item.Response) {
+				item.Err = nil
+				continue
+			}
+
+			lookups[item] = &lookup{key: key, generation: rc.generation(item.Cmd)}
+		}
+
+		pending = append(pending, item)
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	if err := next.CallBatch(ctx, pending); err != nil {
+		return err
+	}
+
+	for _, item := range pending {
+		if lk, ok := lookups[item]; ok && item.Err == nil {
+			rc.put(item.Cmd, lk.key, lk.generation, item.Response)
+		}
+	}
+
+	return nil
+}
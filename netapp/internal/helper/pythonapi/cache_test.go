@@ -0,0 +1,170 @@
+package pythonapi
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// cacheBackend answers each call with its call count, the calls of the
+// during hook are made while the call is in flight
+type cacheBackend struct {
+	mutex   sync.Mutex
+	calls   map[string]int
+	batches int
+	during  func()
+}
+
+func (cb *cacheBackend) Call(
+	ctx context.Context, cmdName string, request, response interface{}) error {
+	cb.mutex.Lock()
+	cb.calls[cmdName]++
+	count := cb.calls[cmdName]
+	cb.mutex.Unlock()
+
+	if cb.during != nil {
+		during := cb.during
+		cb.during = nil
+		during()
+	}
+
+	if resp, ok := response.(*KeyValueResponse); ok {
+		resp.Value = fmt.Sprintf("%s-%d", cmdName, count)
+	}
+
+	return nil
+}
+
+func (cb *cacheBackend) CallBatch(ctx context.Context, items []*BatchItem) error {
+	cb.batches++
+	for _, item := range items {
+		item.Err = cb.Call(ctx, item.Cmd, item.Request, item.Response)
+	}
+
+	return nil
+}
+
+func testCache(ttl time.Duration) (*ReadCache, *cacheBackend, Backend, *time.Time) {
+	now := time.Unix(1000, 0)
+	cache := NewReadCache(ttl)
+	cache.now = func() time.Time { return now }
+
+	impl := &cacheBackend{calls: map[string]int{}}
+	return cache, impl, Wrap(impl, cache.Middleware()), &now
+}
+
+func cacheGet(r *require.Assertions, api Backend, cmdName, key string) string {
+	resp := &KeyValueResponse{}
+	r.NoError(api.Call(context.Background(), cmdName, &KeyValueRequest{Key: key}, resp))
+	return resp.Value
+}
+
+func Test_Cache_TTL(t *testing.T) {
+	r := require.New(t)
+	_, impl, api, now := testCache(time.Minute)
+
+	r.Equal("SYS.NODE.GET-1", cacheGet(r, api, "SYS.NODE.GET", "n1"))
+	r.Equal("SYS.NODE.GET-1", cacheGet(r, api, "SYS.NODE.GET", "n1"))
+	r.Equal("SYS.NODE.GET-2", cacheGet(r, api, "SYS.NODE.GET", "n2"))
+
+	// other reads are never cached
+	r.Equal("SYS.PORT.GET-1", cacheGet(r, api, "SYS.PORT.GET", "p1"))
+	r.Equal("SYS.PORT.GET-2", cacheGet(r, api, "SYS.PORT.GET", "p1"))
+
+	*now = now.Add(time.Minute + time.Second)
+	r.Equal("SYS.NODE.GET-3", cacheGet(r, api, "SYS.NODE.GET", "n1"))
+	r.Equal(3, impl.calls["SYS.NODE.GET"])
+}
+
+func Test_Cache_Invalidation(t *testing.T) {
+	r := require.New(t)
+	_, impl, api, _ := testCache(time.Hour)
+
+	r.Equal("NW.IPSPACE.GET-1", cacheGet(r, api, "NW.IPSPACE.GET", "ips"))
+	r.Equal("NW.BRCDOM.GET-1", cacheGet(r, api, "NW.BRCDOM.GET", "bd"))
+	r.Equal("SYS.AGGR.GET-1", cacheGet(r, api, "SYS.AGGR.GET", "aggr"))
+
+	// same object type
+	r.NoError(api.Call(context.Background(), "NW.IPSPACE.UPDATE", &KeyValueRequest{}, nil))
+	r.Equal("NW.IPSPACE.GET-2", cacheGet(r, api, "NW.IPSPACE.GET", "ips"))
+	// the broadcast domains of the IPspace
+	r.Equal("NW.BRCDOM.GET-2", cacheGet(r, api, "NW.BRCDOM.GET", "bd"))
+	r.Equal("SYS.AGGR.GET-1", cacheGet(r, api, "SYS.AGGR.GET", "aggr"))
+
+	// the volumes of the aggregate
+	r.NoError(api.Call(context.Background(), "SVM.VOL.CREATE", &KeyValueRequest{}, nil))
+	r.Equal("SYS.AGGR.GET-2", cacheGet(r, api, "SYS.AGGR.GET", "aggr"))
+	r.Equal("NW.IPSPACE.GET-2", cacheGet(r, api, "NW.IPSPACE.GET", "ips"))
+
+	// jobs might have ended
+	r.NoError(api.Call(context.Background(), "SYS.JOB.GET", &KeyValueRequest{}, nil))
+	r.Equal("NW.IPSPACE.GET-3", cacheGet(r, api, "NW.IPSPACE.GET", "ips"))
+	r.Equal("SYS.AGGR.GET-3", cacheGet(r, api, "SYS.AGGR.GET", "aggr"))
+	r.Equal(1, impl.calls["SYS.JOB.GET"])
+}
+
+func Test_Cache_MutationInFlight(t *testing.T) {
+	r := require.New(t)
+	_, impl, api, _ := testCache(time.Hour)
+
+	// the read result of a broadcast domain changed meanwhile is stale
+	impl.during = func() {
+		r.NoError(api.Call(context.Background(), "NW.BRCDOM.PORT.ADD", &KeyValueRequest{}, nil))
+	}
+	r.Equal("NW.BRCDOM.GET-1", cacheGet(r, api, "NW.BRCDOM.GET", "bd"))
+	r.Equal("NW.BRCDOM.GET-2", cacheGet(r, api, "NW.BRCDOM.GET", "bd"))
+	r.Equal("NW.BRCDOM.GET-2", cacheGet(r, api, "NW.BRCDOM.GET", "bd"))
+
+	// and a read during a mutation
+	impl.during = func() {
+		r.Equal("NW.BRCDOM.GET-3", cacheGet(r, api, "NW.BRCDOM.GET", "bd"))
+	}
+	r.NoError(api.Call(context.Background(), "NW.BRCDOM.PORT.REMOVE", &KeyValueRequest{}, nil))
+	r.Equal("NW.BRCDOM.GET-4", cacheGet(r, api, "NW.BRCDOM.GET", "bd"))
+
+	// a job ending during the first read of an object type
+	impl.during = func() {
+		r.NoError(api.Call(context.Background(), "SYS.JOB.GET", &KeyValueRequest{}, nil))
+	}
+	r.Equal("SYS.AGGR.GET-1", cacheGet(r, api, "SYS.AGGR.GET", "aggr"))
+	r.Equal("SYS.AGGR.GET-2", cacheGet(r, api, "SYS.AGGR.GET", "aggr"))
+}
+
+func Test_Cache_Batch(t *testing.T) {
+	r := require.New(t)
+	_, impl, api, _ := testCache(time.Hour)
+
+	r.Equal("SYS.NODE.GET-1", cacheGet(r, api, "SYS.NODE.GET", "n1"))
+
+	items := []*BatchItem{
+		{Cmd: "SYS.NODE.GET", Request: &KeyValueRequest{Key: "n1"}, Response: &KeyValueResponse{}},
+		{Cmd: "SYS.NODE.GET", Request: &KeyValueRequest{Key: "n2"}, Response: &KeyValueResponse{}},
+	}
+	r.NoError(CallBatch(context.Background(), api, items))
+	r.Equal(1, impl.batches)
+	r.Equal("SYS.NODE.GET-1", items[0].Response.(*KeyValueResponse).Value)
+	r.Equal("SYS.NODE.GET-2", items[1].Response.(*KeyValueResponse).Value)
+	r.Equal("SYS.NODE.GET-2", cacheGet(r, api, "SYS.NODE.GET", "n2"))
+
+	// all cached, nothing to pass on
+	r.NoError(CallBatch(context.Background(), api, items[:1]))
+	r.Equal(1, impl.batches)
+
+	// mutations in a batch invalidate the cache
+	items = []*BatchItem{
+		{Cmd: "SYS.NODE.UPDATE", Request: &KeyValueRequest{}, Response: &KeyValueResponse{}},
+	}
+	r.NoError(CallBatch(context.Background(), api, items))
+	r.Equal("SYS.NODE.GET-3", cacheGet(r, api, "SYS.NODE.GET", "n1"))
+}
+
+func Test_Cache_ObjectType(t *testing.T) {
+	r := require.New(t)
+	r.Equal("SVM", objectType("SVM.CREATE"))
+	r.Equal("SVM.VOL", objectType("SVM.VOL.CREATE"))
+	r.Equal("NW.BRCDOM", objectType("NW.BRCDOM.PORT.ADD"))
+}
@@ -0,0 +1,126 @@
+package pythonapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Deadline returns a middleware limiting each call to timeout, commands
+// found in overrides use their own timeout, e.g. a slow SVM.CREATE. All
+// calls are cancelled once parent is done, e.g. on Terraform interrupt.
+// A timeout <= 0 disables the deadline for the call. A batch is limited by
+// the largest timeout of its commands, job watches only by their context
+func Deadline(
+	parent context.Context, timeout time.Duration,
+	overrides map[string]time.Duration) Middleware {
+
+	cmdTimeout := func(cmdName string) time.Duration {
+		if override, ok := overrides[cmdName]; ok {
+			return override
+		}
+
+		return timeout
+	}
+
+	return func(next Backend) Backend {
+		call := BackendFunc(func(
+			ctx context.Context, cmdName string,
+			request, response interface{}) error {
+
+			cmdTimeout := cmdTimeout(cmdName)
+			ctx, cancel := withDeadline(ctx, parent, cmdTimeout)
+			defer cancel()
+
+			err := next.Call(ctx, cmdName, request, response)
+			if err != nil {
+				switch {
+				case parent.Err() != nil:
+					return fmt.Errorf("api call [%s] cancelled: %s", cmdName, err)
+				case ctx.Err() == context.DeadlineExceeded:
+					return fmt.Errorf(
+						"api call [%s] timed out after %s: %s", cmdName, cmdTimeout, err)
+				}
+			}
+
+			return err
+		})
+
+		watch := func(next JobWatcher) WatchFunc {
+			return func(
+				ctx context.Context, request, response interface{},
+				update func() error) error {
+
+				ctx, cancel := withDeadline(ctx, parent, 0)
+				defer cancel()
+
+				err := next.WatchJob(ctx, request, response, update)
+				if err != nil && parent.Err() != nil {
+					return fmt.Errorf("job watch cancelled: %s", err)
+				}
+
+				return err
+			}
+		}
+
+		batch := func(next Batcher) BatchFunc {
+			return func(ctx context.Context, items []*BatchItem) error {
+				var batchTimeout time.Duration
+				for _, item := range items {
+					itemTimeout := cmdTimeout(item.Cmd)
+					if itemTimeout <= 0 {
+						batchTimeout = 0
+						break
+					}
+					if itemTimeout > batchTimeout {
+						batchTimeout = itemTimeout
+					}
+				}
+
+				ctx, cancel := withDeadline(ctx, parent, batchTimeout)
+				defer cancel()
+
+				err := next.CallBatch(ctx, items)
+				if err != nil {
+					switch {
+					case parent.Err() != nil:
+						return fmt.Errorf("batch call cancelled: %s", err)
+					case ctx.Err() == context.DeadlineExceeded:
+						return fmt.Errorf(
+							"batch call timed out after %s: %s", batchTimeout, err)
+					}
+				}
+
+				return err
+			}
+		}
+
+		return extend(call, next, watch, batch)
+	}
+}
+
+// withDeadline returns the context of a call limited to timeout, none
+// if timeout <= 0, which is cancelled as well once parent is done
+func withDeadline(
+	ctx, parent context.Context,
+	timeout time.Duration) (context.Context, context.CancelFunc) {
+
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+
+	// cancel the call context as well if parent is done, the goroutine
+	// ends with the call
+	go func() {
+		select {
+		case <-parent.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return ctx, cancel
+}
@@ -0,0 +1,107 @@
+package pythonapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// hungBackend blocks every call until its context is done
+var hungBackend = BackendFunc(func(
+	ctx context.Context, cmdName string,
+	request, response interface{}) error {
+	<-ctx.Done()
+	return ctx.Err()
+})
+
+func Test_Deadline_Timeout(t *testing.T) {
+	r := require.New(t)
+
+	api := Wrap(hungBackend, Deadline(
+		context.Background(), 10*time.Millisecond,
+		map[string]time.Duration{"SVM.CREATE": 50 * time.Millisecond}))
+
+	start := time.Now()
+	err := api.Call(context.Background(), "SYS.NODE.GET", nil, nil)
+	r.Error(err)
+	r.Contains(err.Error(), "api call [SYS.NODE.GET] timed out after 10ms")
+
+	err = api.Call(context.Background(), "SVM.CREATE", nil, nil)
+	r.Error(err)
+	r.Contains(err.Error(), "timed out after 50ms")
+	r.True(time.Since(start) < time.Second)
+}
+
+func Test_Deadline_Cancel(t *testing.T) {
+	r := require.New(t)
+
+	parent, cancel := context.WithCancel(context.Background())
+	api := Wrap(hungBackend, Deadline(parent, 0, nil))
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+
+	err := api.Call(context.Background(), "SVM.CREATE", nil, nil)
+	r.Error(err)
+	r.Contains(err.Error(), "api call [SVM.CREATE] cancelled")
+
+	// calls after cancellation fail right away
+	err = api.Call(context.Background(), "SYS.NODE.GET", nil, nil)
+	r.Error(err)
+	r.Contains(err.Error(), "cancelled")
+}
+
+func Test_Deadline_NetAppAPI(t *testing.T) {
+	r := require.New(t)
+	impl := &fakeImpl{}
+
+	api := Wrap(NewNetAppAPI(impl), Deadline(context.Background(), time.Second, nil))
+	resp, err := testKeyValue(api, &KeyValueRequest{Key: "k", Value: "v"})
+	r.NoError(err)
+	r.Equal("v", resp.Value)
+}
+
+// hungWatcher blocks every call and job watch until its context is done
+type hungWatcher struct{ Backend }
+
+func (hungWatcher) WatchJob(
+	ctx context.Context, request, response interface{},
+	update func() error) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func Test_Deadline_JobWatch(t *testing.T) {
+	r := require.New(t)
+
+	parent, cancel := context.WithCancel(context.Background())
+	api := Wrap(hungWatcher{hungBackend}, Deadline(parent, 10*time.Millisecond, nil))
+	watcher, ok := api.(JobWatcher)
+	r.True(ok)
+
+	// the call timeout does not limit job watches
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer ctxCancel()
+
+	start := time.Now()
+	err := watcher.WatchJob(ctx, nil, nil, nil)
+	r.Equal(context.DeadlineExceeded, err)
+	r.True(time.Since(start) >= 50*time.Millisecond)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+
+	err = watcher.WatchJob(context.Background(), nil, nil, nil)
+	r.Error(err)
+	r.Contains(err.Error(), "job watch cancelled")
+
+	// backends without job watch are not turned into watchers
+	_, ok = Wrap(hungBackend, Deadline(parent, 0, nil)).(JobWatcher)
+	r.False(ok)
+}
@@ -0,0 +1,82 @@
+package pythonapi
+
+import (
+	"errors"
+	"fmt"
+)
+
+// error kinds of failed API commands, test with errors.Is, e.g.
+// errors.Is(err, ErrNotFound) for an object deleted outside of terraform
+var (
+	ErrNotFound      = errors.New("object not found")
+	ErrAlreadyExists = errors.New("object already exists")
+	ErrBusy          = errors.New("object busy")
+	ErrAuth          = errors.New("not authorized")
+)
+
+// ZAPI errno values of the error kinds, the REST and simulated APIs use
+// them as well to report the error kind of a failed command
+const (
+	ErrnoNoEntry         = 2     // EONTAPI_ENOENT
+	ErrnoAgain           = 11    // EONTAPI_EAGAIN
+	ErrnoBusy            = 16    // EONTAPI_EBUSY
+	ErrnoExists          = 17    // EONTAPI_EEXIST
+	ErrnoTimedOut        = 60    // EONTAPI_ETIMEDOUT, might be executed anyway
+	ErrnoAuthFailed      = 13002 // authorization failed, e.g. HTTP 401
+	ErrnoPrivilege       = 13003 // EAPIPRIVILEGE
+	ErrnoDuplicateEntry  = 13130 // EDUPLICATEENTRY
+	ErrnoObjectNotFound  = 15661 // EOBJECTNOTFOUND
+	ErrnoVserverNotFound = 15698 // EVSERVERNOTFOUND
+)
+
+// errnoKinds maps the ZAPI errno to its error kind, errno values not
+// listed have no kind and only match the APIError itself
+var errnoKinds = map[int]error{
+	ErrnoNoEntry:         ErrNotFound,
+	ErrnoObjectNotFound:  ErrNotFound,
+	ErrnoVserverNotFound: ErrNotFound,
+	ErrnoExists:          ErrAlreadyExists,
+	ErrnoDuplicateEntry:  ErrAlreadyExists,
+	ErrnoAgain:           ErrBusy,
+	ErrnoBusy:            ErrBusy,
+	ErrnoAuthFailed:      ErrAuth,
+	ErrnoPrivilege:       ErrAuth,
+}
+
+// APIError is a failed API command with the ZAPI errno of the failure,
+// zero if unknown, e.g. for commands failed by the Python API itself
+type APIError struct {
+	Cmd   string
+	Errno int
+	Msg   string // complete error message, see NewAPIError
+}
+
+// NewAPIError returns the error of the failed command with its errno
+func NewAPIError(cmdName string, errno int, errmsg string) *APIError {
+	return &APIError{
+		Cmd:   cmdName,
+		Errno: errno,
+		Msg:   fmt.Sprintf("api call [%s] failed with msg: %v", cmdName, errmsg),
+	}
+}
+
+func (e *APIError) Error() string {
+	return e.Msg
+}
+
+// Is reports whether target is the error kind of the errno
+func (e *APIError) Is(target error) bool {
+	kind, ok := errnoKinds[e.Errno]
+	return ok && kind == target
+}
+
+// Errno returns the ZAPI errno of the API error in the chain of err,
+// zero if err is no API error or its errno is unknown
+func Errno(err error) int {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Errno
+	}
+
+	return 0
+}
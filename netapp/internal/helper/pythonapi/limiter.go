@@ -0,0 +1,222 @@
+package pythonapi
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+// serializedTypes are the object types whose mutations ONTAP does not
+// handle concurrently, mapped to their lock. E.g. ports join and leave
+// broadcast domains with VLAN, interface group, port and broadcast domain
+// changes, concurrent changes fail or get lost. The locks are cluster wide,
+// the VLAN, port and interface group requests do not name the IPspace of
+// their port
+var serializedTypes = map[string]string{
+	"NW.IPSPACE":    "NW.PORTS",
+	"NW.BRCDOM":     "NW.PORTS",
+	"NW.VLAN":       "NW.PORTS",
+	"SYS.PORT":      "NW.PORTS",
+	"SYS.PORTGROUP": "NW.PORTS",
+	"NW.SUBNET":     "NW.SUBNET",
+}
+
+// lockName returns the serializedTypes lock of a mutation
+func lockName(cmdName string) (string, bool) {
+	lock, ok := serializedTypes[objectType(cmdName)]
+	if !ok || isRead(cmdName) {
+		return "", false
+	}
+
+	return lock, true
+}
+
+// lockNames returns the locks of the mutating items, sorted so that
+// batches never wait for each other crosswise
+func lockNames(items []*BatchItem) []string {
+	locks := map[string]bool{}
+	for _, item := range items {
+		if lock, ok := lockName(item.Cmd); ok {
+			locks[lock] = true
+		}
+	}
+
+	names := make([]string, 0, len(locks))
+	for lock := range locks {
+		names = append(names, lock)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// heldLocks are the locks kept by the calls of a Serialized backend until
+// its release, the calls do not queue for them again
+type heldLocks struct {
+	mutex sync.Mutex
+	locks map[string]chan struct{}
+}
+
+type heldLocksKey struct{}
+
+// Serialized returns the client whose calls keep the locks of their
+// mutations until release, e.g. to wait for the port updates a broadcast
+// domain change started. Without Limiter the calls are passed on as is
+func Serialized(client Backend) (Backend, func()) {
+	held := &heldLocks{locks: map[string]chan struct{}{}}
+	hold := func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, heldLocksKey{}, held)
+	}
+
+	call := BackendFunc(func(
+		ctx context.Context, cmdName string,
+		request, response interface{}) error {
+		return client.Call(hold(ctx), cmdName, request, response)
+	})
+
+	watch := func(next JobWatcher) WatchFunc {
+		return func(
+			ctx context.Context, request, response interface{},
+			update func() error) error {
+			return next.WatchJob(hold(ctx), request, response, update)
+		}
+	}
+
+	batch := func(next Batcher) BatchFunc {
+		return func(ctx context.Context, items []*BatchItem) error {
+			return next.CallBatch(hold(ctx), items)
+		}
+	}
+
+	return extend(call, client, watch, batch), func() {
+		held.mutex.Lock()
+		defer held.mutex.Unlock()
+
+		for name, lock := range held.locks {
+			<-lock
+			delete(held.locks, name)
+		}
+	}
+}
+
+// Limiter queues the API calls passing through its middleware, at most
+// maxCalls calls run concurrently and the mutations of serializedTypes one
+// after the other
+type Limiter struct {
+	// slots of the running calls, nil for no limit
+	slots chan struct{}
+
+	// locks of lockName, a lock is held by a buffered element
+	mutex sync.Mutex
+	locks map[string]chan struct{}
+}
+
+// NewLimiter returns a limiter of maxCalls concurrent calls, 0 only
+// serializes the mutations of serializedTypes
+func NewLimiter(maxCalls int) *Limiter {
+	l := &Limiter{locks: map[string]chan struct{}{}}
+	if maxCalls > 0 {
+		l.slots = make(chan struct{}, maxCalls)
+	}
+
+	return l
+}
+
+func (l *Limiter) lock(name string) chan struct{} {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.locks[name] == nil {
+		l.locks[name] = make(chan struct{}, 1)
+	}
+
+	return l.locks[name]
+}
+
+// acquire waits for the locks of the items and a call slot, in this order
+// so that a queued mutation does not block the slot of other calls. The
+// locks of Serialized calls are kept until their release
+func (l *Limiter) acquire(ctx context.Context, items ...*BatchItem) (func(), error) {
+	held, _ := ctx.Value(heldLocksKey{}).(*heldLocks)
+	if held != nil {
+		held.mutex.Lock()
+		defer held.mutex.Unlock()
+	}
+
+	var acquired []chan struct{}
+	release := func() {
+		for idx := len(acquired) - 1; idx >= 0; idx-- {
+			<-acquired[idx]
+		}
+	}
+
+	for _, name := range lockNames(items) {
+		if held != nil && held.locks[name] != nil {
+			continue
+		}
+
+		lock := l.lock(name)
+		select {
+		case lock <- struct{}{}:
+		case <-ctx.Done():
+			release()
+			return nil, ctx.Err()
+		}
+
+		if held != nil {
+			held.locks[name] = lock
+		} else {
+			acquired = append(acquired, lock)
+		}
+	}
+
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+			acquired = append(acquired, l.slots)
+		case <-ctx.Done():
+			release()
+			return nil, ctx.Err()
+		}
+	}
+
+	return release, nil
+}
+
+// Middleware queues the calls and batches of the wrapped backend, a batch
+// takes one call slot and the locks of all its items. Job watches are not
+// queued, they would hold a slot until the job ended
+func (l *Limiter) Middleware() Middleware {
+	return func(next Backend) Backend {
+		call := BackendFunc(func(
+			ctx context.Context, cmdName string,
+			request, response interface{}) error {
+
+			release, err := l.acquire(ctx, &BatchItem{Cmd: cmdName, Request: request})
+			if err != nil {
+				return err
+			}
+			defer release()
+
+			return next.Call(ctx, cmdName, request, response)
+		})
+
+		watch := func(next JobWatcher) WatchFunc {
+			return next.WatchJob
+		}
+
+		batch := func(next Batcher) BatchFunc {
+			return func(ctx context.Context, items []*BatchItem) error {
+				release, err := l.acquire(ctx, items...)
+				if err != nil {
+					return err
+				}
+				defer release()
+
+				return next.CallBatch(ctx, items)
+			}
+		}
+
+		return extend(call, next, watch, batch)
+	}
+}
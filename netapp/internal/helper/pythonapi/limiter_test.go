@@ -0,0 +1,247 @@
+package pythonapi
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// concurrencyBackend records the most calls running at once, overall, per
+// command and per lock of the mutations
+type concurrencyBackend struct {
+	mutex   sync.Mutex
+	running map[string]int
+	max     map[string]int
+}
+
+func (cb *concurrencyBackend) enter(keys ...string) {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+
+	for _, key := range keys {
+		cb.running[key]++
+		if cb.running[key] > cb.max[key] {
+			cb.max[key] = cb.running[key]
+		}
+	}
+}
+
+func (cb *concurrencyBackend) leave(keys ...string) {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+
+	for _, key := range keys {
+		cb.running[key]--
+	}
+}
+
+func (cb *concurrencyBackend) Call(
+	ctx context.Context, cmdName string, request, response interface{}) error {
+	keys := append([]string{"", cmdName},
+		lockNames([]*BatchItem{{Cmd: cmdName}})...)
+	cb.enter(keys...)
+	defer cb.leave(keys...)
+
+	time.Sleep(5 * time.Millisecond)
+	return nil
+}
+
+func (cb *concurrencyBackend) maxRunning(key string) int {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+
+	return cb.max[key]
+}
+
+// ipspaceRequest is a request of the IPspace
+type ipspaceRequest struct {
+	IPSpace string `json:"ipspace"`
+}
+
+// vlanRequest is a VLAN request, it does not name the IPspace of its port
+type vlanRequest struct {
+	NodeName   string `json:"node_name"`
+	ParentName string `json:"parent_name"`
+	VlanID     string `json:"vlan_id"`
+}
+
+func runConcurrently(api Backend, calls ...*BatchItem) {
+	var wg sync.WaitGroup
+	for idx := 0; idx < 10; idx++ {
+		for _, call := range calls {
+			wg.Add(1)
+			go func(call *BatchItem) {
+				defer wg.Done()
+				api.Call(context.Background(), call.Cmd, call.Request, nil)
+			}(call)
+		}
+	}
+	wg.Wait()
+}
+
+func Test_Limiter_MaxCalls(t *testing.T) {
+	r := require.New(t)
+	impl := &concurrencyBackend{running: map[string]int{}, max: map[string]int{}}
+	api := Wrap(impl, NewLimiter(3).Middleware())
+
+	runConcurrently(api, &BatchItem{Cmd: "SYS.NODE.GET"}, &BatchItem{Cmd: "SVM.CREATE"})
+	r.Equal(3, impl.maxRunning(""))
+}
+
+func Test_Limiter_Serialized(t *testing.T) {
+	r := require.New(t)
+	impl := &concurrencyBackend{running: map[string]int{}, max: map[string]int{}}
+	api := Wrap(impl, NewLimiter(0).Middleware())
+
+	runConcurrently(api,
+		&BatchItem{Cmd: "NW.BRCDOM.PORT.ADD", Request: &ipspaceRequest{"ips1"}},
+		&BatchItem{Cmd: "NW.BRCDOM.PORT.REMOVE", Request: &ipspaceRequest{"ips1"}},
+		&BatchItem{Cmd: "NW.BRCDOM.PORT.ADD", Request: &ipspaceRequest{"ips2"}},
+		&BatchItem{Cmd: "NW.VLAN.CREATE", Request: &vlanRequest{"node1", "e0c", "100"}},
+		&BatchItem{Cmd: "NW.BRCDOM.GET", Request: &ipspaceRequest{"ips1"}},
+		&BatchItem{Cmd: "SVM.VOL.SIZE"})
+
+	// the port changes of all IPspaces share one lock, reads and other
+	// types run in parallel
+	r.Equal(1, impl.maxRunning("NW.PORTS"))
+	r.Equal(1, impl.maxRunning("NW.BRCDOM.PORT.ADD"))
+	r.True(impl.maxRunning("NW.BRCDOM.GET") > 1)
+	r.True(impl.maxRunning("SVM.VOL.SIZE") > 1)
+}
+
+func Test_Limiter_LockName(t *testing.T) {
+	r := require.New(t)
+
+	lock, ok := lockName("NW.IPSPACE.UPDATE")
+	r.True(ok)
+	r.Equal("NW.PORTS", lock)
+	lock, ok = lockName("NW.VLAN.CREATE")
+	r.True(ok)
+	r.Equal("NW.PORTS", lock)
+	lock, ok = lockName("NW.SUBNET.CREATE")
+	r.True(ok)
+	r.Equal("NW.SUBNET", lock)
+
+	_, ok = lockName("NW.BRCDOM.STATUS")
+	r.False(ok)
+	_, ok = lockName("SVM.CREATE")
+	r.False(ok)
+}
+
+func Test_Limiter_Cancel(t *testing.T) {
+	r := require.New(t)
+	limiter := NewLimiter(1)
+	release, err := limiter.acquire(context.Background(),
+		&BatchItem{Cmd: "NW.BRCDOM.CREATE", Request: &ipspaceRequest{"ips1"}})
+	r.NoError(err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	// queued behind the lock and the slot
+	_, err = limiter.acquire(ctx,
+		&BatchItem{Cmd: "NW.VLAN.CREATE", Request: &vlanRequest{"node1", "e0c", "100"}})
+	r.Equal(context.DeadlineExceeded, err)
+	_, err = limiter.acquire(ctx, &BatchItem{Cmd: "SYS.NODE.GET"})
+	r.Equal(context.DeadlineExceeded, err)
+
+	// a cancelled wait does not keep any lock
+	release()
+	release, err = limiter.acquire(context.Background(),
+		&BatchItem{Cmd: "NW.VLAN.CREATE", Request: &vlanRequest{"node1", "e0c", "100"}})
+	r.NoError(err)
+	release()
+}
+
+func Test_Limiter_Held(t *testing.T) {
+	r := require.New(t)
+	impl := &cacheBackend{calls: map[string]int{}}
+	limiter := NewLimiter(1)
+	api := Wrap(impl, limiter.Middleware())
+	request := &ipspaceRequest{"ips1"}
+
+	locked := func() bool {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		release, err := limiter.acquire(ctx, &BatchItem{Cmd: "NW.BRCDOM.UPDATE", Request: request})
+		if err != nil {
+			return true
+		}
+		release()
+		return false
+	}
+
+	serialized, release := Serialized(api)
+	r.NoError(serialized.Call(context.Background(), "NW.BRCDOM.PORT.ADD", request, nil))
+	// the lock is kept for the wait, the own calls do not queue for it
+	r.True(locked())
+	r.NoError(serialized.Call(context.Background(), "NW.BRCDOM.STATUS", request, nil))
+	r.NoError(serialized.Call(context.Background(), "NW.BRCDOM.PORT.REMOVE", request, nil))
+	r.Equal(1, impl.calls["NW.BRCDOM.PORT.REMOVE"])
+
+	// unless released
+	release()
+	r.False(locked())
+
+	// calls of the client itself only lock while running
+	r.NoError(api.Call(context.Background(), "NW.BRCDOM.PORT.ADD", request, nil))
+	r.False(locked())
+}
+
+func Test_Limiter_VlanDuringPortUpdate(t *testing.T) {
+	r := require.New(t)
+	impl := &cacheBackend{calls: map[string]int{}}
+	api := Wrap(impl, NewLimiter(0).Middleware())
+
+	serialized, release := Serialized(api)
+	r.NoError(serialized.Call(
+		context.Background(), "NW.BRCDOM.PORT.ADD", &ipspaceRequest{"ips1"}, nil))
+
+	created := make(chan error, 1)
+	go func() {
+		created <- api.Call(context.Background(),
+			"NW.VLAN.CREATE", &vlanRequest{"node1", "e0c", "100"}, nil)
+	}()
+
+	// the VLAN create waits for the port update of the broadcast domain
+	// although its request has no IPspace
+	select {
+	case err := <-created:
+		r.Failf("VLAN created during the port update", "got: %v", err)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	release()
+	r.NoError(<-created)
+	r.Equal(1, impl.calls["NW.VLAN.CREATE"])
+}
+
+func Test_Limiter_Batch(t *testing.T) {
+	r := require.New(t)
+	impl := &cacheBackend{calls: map[string]int{}}
+	limiter := NewLimiter(1)
+	api := Wrap(impl, limiter.Middleware())
+
+	items := []*BatchItem{
+		{Cmd: "NW.BRCDOM.PORT.ADD", Request: &ipspaceRequest{"ips1"}, Response: &KeyValueResponse{}},
+		{Cmd: "NW.SUBNET.CREATE", Request: &ipspaceRequest{"ips1"}, Response: &KeyValueResponse{}},
+	}
+	r.Equal([]string{"NW.PORTS", "NW.SUBNET"},
+		lockNames([]*BatchItem{items[1], items[0]}))
+
+	// the batch holds the slot and the locks of its items
+	impl.during = func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err := limiter.acquire(ctx,
+			&BatchItem{Cmd: "NW.SUBNET.DELETE", Request: &ipspaceRequest{"ips1"}})
+		r.Equal(context.DeadlineExceeded, err)
+	}
+	r.NoError(CallBatch(context.Background(), api, items))
+	r.Equal(1, impl.batches)
+	r.Equal(1, impl.calls["NW.SUBNET.CREATE"])
+}
@@ -0,0 +1,79 @@
+//go:build ignore
+// +build ignore
+
+// manifest_gen writes python_manifest.go with the SHA-256 of the Python API
+// files in ./python, run via: go generate ./netapp/internal/helper/pythonapi
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const header = `// Code generated by manifest_gen.go; DO NOT EDIT.
+
+package pythonapi
+
+// pythonManifest is the SHA-256 of each Python API file in the box, the
+// files written to the API folder must match it
+var pythonManifest = map[string]string{
+`
+
+func main() {
+	sums := map[string]string{}
+	err := filepath.Walk("python", func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() && info.Name() == "__pycache__" {
+			return filepath.SkipDir
+		}
+		if !info.Mode().IsRegular() || strings.HasSuffix(path, ".pyc") {
+			return nil
+		}
+
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel("python", path)
+		if err != nil {
+			return err
+		}
+		sums[filepath.ToSlash(rel)] = fmt.Sprintf("%x", sha256.Sum256(data))
+		return nil
+	})
+	if err != nil {
+		log.Fatalf("could not hash python API files, got: %s", err)
+	}
+
+	paths := make([]string, 0, len(sums))
+	for path := range sums {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	for _, path := range paths {
+		fmt.Fprintf(&buf, "\t%q: %q,\n", path, sums[path])
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("could not format manifest, got: %s", err)
+	}
+	if err = ioutil.WriteFile("python_manifest.go", src, 0644); err != nil {
+		log.Fatalf("could not write manifest, got: %s", err)
+	}
+}
@@ -0,0 +1,288 @@
+package pythonapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// command names of the job watches and batches in the metrics
+const (
+	MetricsJobWatch = "JOB.WATCH"
+	MetricsBatch    = "BATCH"
+)
+
+// formats of the metrics file
+const (
+	MetricsFormatJSON       = "json"
+	MetricsFormatPrometheus = "prometheus"
+)
+
+// latencyBuckets are the upper bounds in seconds of the call latency
+// histogram, slower calls only count for the +Inf bucket
+var latencyBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300}
+
+// CommandMetrics are the calls, errors and latencies of a command
+type CommandMetrics struct {
+	Command string  `json:"command"`
+	Calls   int     `json:"calls"`
+	Errors  int     `json:"errors"`
+	Total   float64 `json:"total_seconds"`
+	Max     float64 `json:"max_seconds"`
+	// Buckets counts the calls per latency bucket of latencyBuckets, the
+	// counts are not cumulative, the last one is the +Inf bucket
+	Buckets []int `json:"buckets"`
+}
+
+// Avg returns the average latency of the calls in seconds
+func (cm *CommandMetrics) Avg() float64 {
+	if cm.Calls == 0 {
+		return 0
+	}
+
+	return cm.Total / float64(cm.Calls)
+}
+
+func (cm *CommandMetrics) observe(latency time.Duration, err error) {
+	seconds := latency.Seconds()
+
+	cm.Calls++
+	if err != nil {
+		cm.Errors++
+	}
+	cm.Total += seconds
+	if seconds > cm.Max {
+		cm.Max = seconds
+	}
+
+	bucket := sort.SearchFloat64s(latencyBuckets, seconds)
+	cm.Buckets[bucket]++
+}
+
+// Metrics collects the per command metrics of the API calls passing
+// through its middleware, e.g. to spot the N+1 calls of resource reads
+type Metrics struct {
+	mutex    sync.Mutex
+	commands map[string]*CommandMetrics
+
+	// since measures the latency, replaced by tests
+	since func(time.Time) time.Duration
+}
+
+// NewMetrics returns metrics without calls
+func NewMetrics() *Metrics {
+	return &Metrics{
+		commands: map[string]*CommandMetrics{},
+		since:    time.Since,
+	}
+}
+
+func (m *Metrics) observe(cmdName string, latency time.Duration, err error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	cm, ok := m.commands[cmdName]
+	if !ok {
+		cm = &CommandMetrics{
+			Command: cmdName, Buckets: make([]int, len(latencyBuckets)+1)}
+		m.commands[cmdName] = cm
+	}
+	cm.observe(latency, err)
+}
+
+// Middleware measures the calls, batches and job watches of the wrapped
+// backend, job watches count as MetricsJobWatch, batches as MetricsBatch
+// and each of their items as call of its command
+func (m *Metrics) Middleware() Middleware {
+	return func(next Backend) Backend {
+		call := BackendFunc(func(
+			ctx context.Context, cmdName string,
+			request, response interface{}) error {
+
+			start := time.Now()
+			err := next.Call(ctx, cmdName, request, response)
+			m.observe(cmdName, m.since(start), err)
+
+			return err
+		})
+
+		watch := func(next JobWatcher) WatchFunc {
+			return func(
+				ctx context.Context, request, response interface{},
+				update func() error) error {
+
+				start := time.Now()
+				err := next.WatchJob(ctx, request, response, update)
+				// not supported by the API, the job is polled instead
+				if err != ErrWatchUnsupported {
+					m.observe(MetricsJobWatch, m.since(start), err)
+				}
+
+				return err
+			}
+		}
+
+		batch := func(next Batcher) BatchFunc {
+			return func(ctx context.Context, items []*BatchItem) error {
+				start := time.Now()
+				err := next.CallBatch(ctx, items)
+				if err == ErrBatchUnsupported {
+					// executed item by item through this middleware
+					return err
+				}
+
+				latency := m.since(start)
+				m.observe(MetricsBatch, latency, err)
+				if len(items) == 0 {
+					return err
+				}
+
+				// the items share the latency of the batch
+				share := latency / time.Duration(len(items))
+				for _, item := range items {
+					itemErr := item.Err
+					if err != nil {
+						itemErr = err
+					}
+					m.observe(item.Cmd, share, itemErr)
+				}
+
+				return err
+			}
+		}
+
+		return extend(call, next, watch, batch)
+	}
+}
+
+// Commands returns a copy of the command metrics, the commands with the
+// most total latency first
+func (m *Metrics) Commands() []CommandMetrics {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	commands := make([]CommandMetrics, 0, len(m.commands))
+	for _, cm := range m.commands {
+		cmCopy := *cm
+		cmCopy.Buckets = append([]int(nil), cm.Buckets...)
+		commands = append(commands, cmCopy)
+	}
+
+	sort.Slice(commands, func(i, j int) bool {
+		if commands[i].Total != commands[j].Total {
+			return commands[i].Total > commands[j].Total
+		}
+		return commands[i].Command < commands[j].Command
+	})
+
+	return commands
+}
+
+// Summary returns the command metrics as table for the log
+func (m *Metrics) Summary() string {
+	var buf bytes.Buffer
+	writer := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "COMMAND\tCALLS\tERRORS\tTOTAL\tAVG\tMAX\t")
+	for _, cm := range m.Commands() {
+		fmt.Fprintf(writer, "%s\t%d\t%d\t%.3fs\t%.3fs\t%.3fs\t\n",
+			cm.Command, cm.Calls, cm.Errors, cm.Total, cm.Avg(), cm.Max)
+	}
+	writer.Flush()
+
+	return buf.String()
+}
+
+// metricsJSON is the JSON metrics file content
+type metricsJSON struct {
+	Buckets  []float64        `json:"bucket_bounds"`
+	Commands []CommandMetrics `json:"commands"`
+}
+
+// JSON returns the command metrics JSON encoded
+func (m *Metrics) JSON() ([]byte, error) {
+	return json.MarshalIndent(
+		&metricsJSON{Buckets: latencyBuckets, Commands: m.Commands()}, "", "  ")
+}
+
+// Prometheus returns the command metrics in the Prometheus text format,
+// e.g. for the textfile collector of the node exporter
+func (m *Metrics) Prometheus() []byte {
+	commands := m.Commands()
+	sort.Slice(commands, func(i, j int) bool {
+		return commands[i].Command < commands[j].Command
+	})
+
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "# HELP netapp_api_calls_total NetApp API calls by command.")
+	fmt.Fprintln(&buf, "# TYPE netapp_api_calls_total counter")
+	for _, cm := range commands {
+		fmt.Fprintf(&buf, "netapp_api_calls_total{command=%q} %d\n", cm.Command, cm.Calls)
+	}
+
+	fmt.Fprintln(&buf, "# HELP netapp_api_call_errors_total Failed NetApp API calls by command.")
+	fmt.Fprintln(&buf, "# TYPE netapp_api_call_errors_total counter")
+	for _, cm := range commands {
+		fmt.Fprintf(&buf, "netapp_api_call_errors_total{command=%q} %d\n", cm.Command, cm.Errors)
+	}
+
+	fmt.Fprintln(&buf, "# HELP netapp_api_call_duration_seconds NetApp API call latency by command.")
+	fmt.Fprintln(&buf, "# TYPE netapp_api_call_duration_seconds histogram")
+	for _, cm := range commands {
+		count := 0
+		for idx, bound := range latencyBuckets {
+			count += cm.Buckets[idx]
+			fmt.Fprintf(&buf, "netapp_api_call_duration_seconds_bucket{command=%q,le=%q} %d\n",
+				cm.Command, strconv.FormatFloat(bound, 'g', -1, 64), count)
+		}
+		fmt.Fprintf(&buf, "netapp_api_call_duration_seconds_bucket{command=%q,le=\"+Inf\"} %d\n",
+			cm.Command, cm.Calls)
+		fmt.Fprintf(&buf, "netapp_api_call_duration_seconds_sum{command=%q} %g\n", cm.Command, cm.Total)
+		fmt.Fprintf(&buf, "netapp_api_call_duration_seconds_count{command=%q} %d\n", cm.Command, cm.Calls)
+	}
+
+	return buf.Bytes()
+}
+
+// WriteFile writes the metrics in the format to path, through a temporary
+// file so that readers, e.g. the node exporter, never see a partial file
+func (m *Metrics) WriteFile(path, format string) error {
+	var data []byte
+	switch format {
+	case MetricsFormatJSON:
+		var err error
+		if data, err = m.JSON(); err != nil {
+			return err
+		}
+	case MetricsFormatPrometheus:
+		data = m.Prometheus()
+	default:
+		return fmt.Errorf("unknown metrics format [%s]", format)
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(data); err == nil {
+		err = tmp.Chmod(apiFileMode)
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
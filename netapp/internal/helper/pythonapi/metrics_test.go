@@ -0,0 +1,113 @@
+package pythonapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testMetrics measures each call with the latency of its command
+func testMetrics(latencies map[string]time.Duration) (*Metrics, Backend) {
+	metrics := NewMetrics()
+	var cmdName string
+	metrics.since = func(time.Time) time.Duration { return latencies[cmdName] }
+
+	api := Wrap(BackendFunc(func(
+		ctx context.Context, cmd string, request, response interface{}) error {
+		cmdName = cmd
+		if cmd == "SVM.CREATE" {
+			return errors.New("boom")
+		}
+		return nil
+	}), metrics.Middleware())
+
+	return metrics, api
+}
+
+func Test_Metrics_Commands(t *testing.T) {
+	r := require.New(t)
+	metrics, api := testMetrics(map[string]time.Duration{
+		"SYS.PORT.GET": 20 * time.Millisecond,
+		"SVM.CREATE":   400 * time.Second,
+	})
+
+	for idx := 0; idx < 200; idx++ {
+		r.NoError(api.Call(context.Background(), "SYS.PORT.GET", nil, nil))
+	}
+	r.Error(api.Call(context.Background(), "SVM.CREATE", nil, nil))
+
+	commands := metrics.Commands()
+	r.Len(commands, 2)
+
+	r.Equal("SVM.CREATE", commands[0].Command)
+	r.Equal(1, commands[0].Calls)
+	r.Equal(1, commands[0].Errors)
+	r.Equal(1, commands[0].Buckets[len(latencyBuckets)])
+
+	r.Equal("SYS.PORT.GET", commands[1].Command)
+	r.Equal(200, commands[1].Calls)
+	r.Equal(0, commands[1].Errors)
+	r.InDelta(4.0, commands[1].Total, 1e-9)
+	r.InDelta(0.02, commands[1].Avg(), 1e-9)
+	r.Equal(200, commands[1].Buckets[0])
+
+	summary := metrics.Summary()
+	r.Regexp(`SVM.CREATE\s+1\s+1\s+400.000s\s+400.000s\s+400.000s`, summary)
+	r.Regexp(`SYS.PORT.GET\s+200\s+0\s+4.000s\s+0.020s\s+0.020s`, summary)
+}
+
+func Test_Metrics_Files(t *testing.T) {
+	r := require.New(t)
+	metrics, api := testMetrics(map[string]time.Duration{"SYS.NODE.GET": time.Second})
+	r.NoError(api.Call(context.Background(), "SYS.NODE.GET", nil, nil))
+
+	folder := t.TempDir()
+	path := filepath.Join(folder, "api_metrics.prom")
+	r.NoError(metrics.WriteFile(path, MetricsFormatPrometheus))
+	data, err := ioutil.ReadFile(path)
+	r.NoError(err)
+	r.Contains(string(data), `netapp_api_calls_total{command="SYS.NODE.GET"} 1`)
+	r.Contains(string(data), `netapp_api_call_duration_seconds_bucket{command="SYS.NODE.GET",le="0.5"} 0`)
+	r.Contains(string(data), `netapp_api_call_duration_seconds_bucket{command="SYS.NODE.GET",le="1"} 1`)
+	r.Contains(string(data), `netapp_api_call_duration_seconds_bucket{command="SYS.NODE.GET",le="+Inf"} 1`)
+	r.Contains(string(data), `netapp_api_call_duration_seconds_sum{command="SYS.NODE.GET"} 1`)
+
+	path = filepath.Join(folder, "api_metrics.json")
+	r.NoError(metrics.WriteFile(path, MetricsFormatJSON))
+	data, err = ioutil.ReadFile(path)
+	r.NoError(err)
+
+	var content metricsJSON
+	r.NoError(json.Unmarshal(data, &content))
+	r.Equal(latencyBuckets, content.Buckets)
+	r.Equal(metrics.Commands(), content.Commands)
+
+	r.Error(metrics.WriteFile(path, "xml"))
+	files, err := ioutil.ReadDir(folder)
+	r.NoError(err)
+	r.Len(files, 2)
+}
+
+func Test_Metrics_JobWatch(t *testing.T) {
+	r := require.New(t)
+	metrics := NewMetrics()
+
+	api := Wrap(hungWatcher{hungBackend}, metrics.Middleware())
+	watcher, ok := api.(JobWatcher)
+	r.True(ok)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	r.Equal(context.DeadlineExceeded, watcher.WatchJob(ctx, nil, nil, nil))
+
+	commands := metrics.Commands()
+	r.Len(commands, 1)
+	r.Equal(MetricsJobWatch, commands[0].Command)
+	r.Equal(1, commands[0].Errors)
+}
@@ -2,6 +2,8 @@ import sys
 import os
 import logging
 import json
+import math
+import threading
 
 # append the NetApp SDK python library to path
 sys.path.append(
@@ -14,6 +16,8 @@ from NaServer import NaServer
 import importlib
 import pkgutil
 
+from redact import redact_data, redact_secrets, register_secret
+
 LOGGER = logging.getLogger(__name__)
 
 def import_submodules(package, recursive=True):
@@ -103,6 +107,13 @@ class NetAppCommand(object):
 
         return values
         
+    @staticmethod
+    def _RESULTS_ERRNO(response):
+        try:
+            return int(response.results_errno())
+        except (TypeError, ValueError):
+            return 0
+
     @staticmethod
     def _CREATE_EMPTY_RESPONSE(success, msg):
         return { 
@@ -110,17 +121,19 @@ class NetAppCommand(object):
             'data': {'dummy': 1} }
 
     @staticmethod
-    def _CREATE_FAIL_RESPONSE(msg):
-        return { 'success': False, 'errmsg': msg, 'data': {} }
+    def _CREATE_FAIL_RESPONSE(msg, errno=0):
+        return { 'success': False, 'errmsg': msg, 'data': {}, 'errno': errno }
 
     @staticmethod
     def _INVOKE_CHECK(server, request, cmd_name):
         response = server.invoke_elem(request)
         err_resp = None
         if response.results_errno() is not 0:
+            # the errno tells the client the kind of error, e.g. not found
             err_resp = NetAppCommand._CREATE_FAIL_RESPONSE(
                 '[' + cmd_name + '] returned: '
-                + response.sprintf())
+                + response.sprintf(),
+                errno=NetAppCommand._RESULTS_ERRNO(response))
 
         port_cnt = NetAppCommand._GET_INT(response, 'num-records')
         if port_cnt == 0:
@@ -198,11 +211,52 @@ import_submodules(__name__)
 
 API_ENCODING = 'utf-8'
 API_CONNECT_CMD = "SYS.CONNECT"
-
-class NetAppCommandExecutor(object):
+API_CONNECT_INFO_CMD = "SYS.CONNECT.INFO"
+
+class CallCancelled(Exception):
+    pass
+
+class CancellableServer(object):
+    '''
+    NaServer proxy that stops waiting on invoke_elem once the gRPC call
+    is no longer active, e.g. deadline exceeded or cancelled by client
+    '''
+    CHECK_INTERVAL = 0.1    # check call is still active every 100ms
+
+    def __init__(self, server, is_active):
+        self._server = server
+        self._is_active = is_active
+
+    def __getattr__(self, name):
+        return getattr(self._server, name)
+
+    def invoke_elem(self, request):
+        if not self._is_active():
+            raise CallCancelled('call cancelled before invoke')
+
+        result = {}
+        def invoke():
+            result['response'] = self._server.invoke_elem(request)
+
+        worker = threading.Thread(target=invoke, daemon=True)
+        worker.start()
+        while worker.is_alive():
+            worker.join(self.CHECK_INTERVAL)
+            if worker.is_alive() and not self._is_active():
+                # NaServer timeout ends the abandoned request eventually
+                raise CallCancelled('call cancelled during invoke')
+
+        return result['response']
+
+class NetAppConnection(object):
+    '''
+    cluster connection of a client session
+    '''
+    TRANSPORT_PORTS = {'HTTPS': 443, 'HTTP': 80}
+    SERVER_TYPES = ('FILER', 'VSERVER')
 
     def __init__(self):
-        super(NetAppCommandExecutor, self).__init__()
+        super(NetAppConnection, self).__init__()
         self.connected  = False
 
         self.ontap_major_version = 1
@@ -210,20 +264,87 @@ class NetAppCommandExecutor(object):
         self.os_version = 'NO-INITIALIZED'
 
         # parameters required for server creation
+        self.params = {}
         self.host = ''
         self.user = ''
         self.pwd = ''
 
-        # these are static for now
         self.server_type = 'FILER'
         self.transport_type = 'HTTPS'
         self.server_port = 443
+        self.insecure = False
+        self.ca_file = ''
+        self.client_cert_file = ''
+        self.client_key_file = ''
         self.connect_style = 'LOGIN'
 
-    def __create_server(self, testing_active):
-        if testing_active:
-            return None
+    @staticmethod
+    def CONNECT_PARAMS(cmd_data):
+        '''
+        connection parameters of the connect command data, the
+        certificate is verified unless insecure is set
+        '''
+        return {
+            'host': cmd_data.get('host', 'HOST-ERROR'),
+            'user': cmd_data.get('user', 'USER-ERROR'),
+            'pwd': cmd_data.get('pwd', 'PWD-ERROR'),
+            'transport': cmd_data.get('transport') or 'HTTPS',
+            'port': cmd_data.get('port', 0),
+            'server_type': cmd_data.get('server_type') or 'FILER',
+            'insecure': cmd_data.get('insecure', False),
+            'ca_file': cmd_data.get('ca_file', ''),
+            'client_cert_file': cmd_data.get('client_cert_file', ''),
+            'client_key_file': cmd_data.get('client_key_file', ''),
+        }
+
+    def configure(self, params):
+        '''
+        set the connection parameters, returns the error message of
+        invalid parameters, empty if all good
+        '''
+        if params['transport'] not in self.TRANSPORT_PORTS:
+            return 'invalid transport: ' + params['transport']
+        if params['server_type'] not in self.SERVER_TYPES:
+            return 'invalid server type: ' + params['server_type']
+        if params['transport'] != 'HTTPS' and (
+                params['insecure'] or params['ca_file']):
+            return 'insecure and ca_file require transport HTTPS'
+        if params['client_cert_file']:
+            if params['transport'] != 'HTTPS':
+                return 'client certificate auth requires transport HTTPS'
+            if not params['client_key_file']:
+                return 'client certificate auth requires client_key_file'
+            if params['pwd']:
+                return (
+                    'password and client certificate auth both set, '
+                    'only one can be used')
+
+        self.params = params
+        self.host = params['host']
+        self.user = params['user']
+        self.pwd = params['pwd']
+        register_secret(self.pwd)
+        self.transport_type = params['transport']
+        self.server_port = (
+            params['port'] or self.TRANSPORT_PORTS[self.transport_type])
+        self.server_type = params['server_type']
+        self.insecure = params['insecure']
+        self.ca_file = params['ca_file']
+        self.client_cert_file = params['client_cert_file']
+        self.client_key_file = params['client_key_file']
+        self.connect_style = (
+            'CERTIFICATE' if self.client_cert_file else 'LOGIN')
+
+        return ''
+
+    def version_data(self):
+        return {
+            'version_ontap': (
+                str(self.ontap_major_version) + '.'
+                + str(self.ontap_minor_version)),
+            'version_os': self.os_version }
 
+    def create_server(self, timeout, is_active):
         s = NaServer(
                 self.host,
                 self.ontap_major_version,
@@ -232,9 +353,71 @@ class NetAppCommandExecutor(object):
         s.set_transport_type(self.transport_type)
         s.set_port(self.server_port)
         s.set_style(self.connect_style)
-        s.set_admin_user(self.user, self.pwd)
-    
-        return s
+        if self.connect_style == 'CERTIFICATE':
+            # the certificate authenticates the user, no password
+            s.set_client_cert_and_key(
+                self.client_cert_file, self.client_key_file)
+        else:
+            s.set_admin_user(self.user, self.pwd)
+        if self.transport_type == 'HTTPS':
+            # NaServer does not verify the certificate by default
+            s.set_server_cert_verification(not self.insecure)
+            if not self.insecure:
+                s.set_hostname_verification(True)
+                if self.ca_file:
+                    s.set_ca_certs(self.ca_file)
+        if timeout is not None:
+            # NaServer timeout in whole seconds, at least 1
+            s.set_timeout(max(1, int(math.ceil(timeout))))
+
+        if is_active is None:
+            return s
+
+        return CancellableServer(s, is_active)
+
+class NetAppCommandExecutor(object):
+    '''
+    executes the commands with the cluster connection of the client
+    session, each provider (alias) connects its own session so several
+    clusters can be used with one API server
+    '''
+
+    def __init__(self):
+        super(NetAppCommandExecutor, self).__init__()
+        self.sessions = {}
+        self.sessions_lock = threading.Lock()
+
+    def __get_connection(self, session):
+        with self.sessions_lock:
+            if session not in self.sessions:
+                self.sessions[session] = NetAppConnection()
+
+            return self.sessions[session]
+
+    def close_session(self, session):
+        '''
+        drop the cluster connection of the session, e.g. on client shutdown
+
+        :param string session: the client session to close
+        :return bool: True if the session was connected
+        '''
+        with self.sessions_lock:
+            conn = self.sessions.pop(session, None)
+
+        if conn is None:
+            return False
+
+        LOGGER.debug('closed session [%s] of %s@%s', session, conn.user, conn.host)
+        return conn.connected
+
+    @staticmethod
+    def commands():
+        '''
+        names of the commands the executor can execute
+
+        :return list: the sorted command names
+        '''
+        return sorted(NetAppCommand.available_implementations.keys())
 
     @staticmethod
     def __GET_COMMAND(name):
@@ -248,73 +431,116 @@ class NetAppCommandExecutor(object):
 
     @staticmethod
     def __BYTES_TO_JSON(byte_data):
-        json_str = byte_data.decode(API_ENCODING).replace("'", '"')
-        LOGGER.debug('decoded byte data: %s', json_str)
-        return json.loads(json_str)
+        json_data = json.loads(byte_data.decode(API_ENCODING))
+        LOGGER.debug('decoded byte data: %s', redact_data(json_data))
+        return json_data
 
     @staticmethod
     def __JSON_TO_BYTES(json_data):
         json_str = json.dumps(json_data)
-        LOGGER.debug('dumped json data: %s', json_str)
+        LOGGER.debug('dumped json data: %s', redact_data(json_data))
         return json_str.encode(API_ENCODING)
 
     @staticmethod
-    def __CREATE_FAIL_RETVAL(errmsg):
-        return False, errmsg, b''
+    def __CREATE_FAIL_RETVAL(errmsg, errno=0):
+        # the client logs the error message
+        return False, redact_secrets(errmsg), {}, errno
 
-    def execute(self, cmd_name, cmd_byte_data):
+    def execute(
+            self, cmd_name, cmd_byte_data,
+            timeout=None, is_active=None, session=''):
         '''
-        execute a NetApp API command
+        execute a NetApp API command with JSON encoded data
 
         :param string cmd_name:
             name of the command to execute
         :param bytes cmd_byte_data: 
-            the command input data to use
-
-        :return: succ, errmsg, resp_data
+            the JSON encoded command input data to use
+        :param float timeout:
+            seconds until the call deadline, None for no deadline
+        :param callable is_active:
+            returns False once the call was cancelled by the client
+        :param string session:
+            client session of the cluster connection to use
+
+        :return: succ, errmsg, resp_data, errno
             :param bool succ:
                 True if command executed successful
             :param string errmsg:
                 error message if succ == False
             :param bytes resp_data:
-                command respond data as bytes
+                JSON encoded command respond data
+            :param int errno:
+                ZAPI errno if succ == False, 0 if unknown
         '''
         # create json from command data bytes
         cmd_data = self.__BYTES_TO_JSON(cmd_byte_data)
 
+        succ, errmsg, res_data, errno = self.execute_data(
+            cmd_name, cmd_data,
+            timeout=timeout, is_active=is_active, session=session)
+        if not res_data:
+            return succ, errmsg, b'', errno
+
+        return succ, errmsg, self.__JSON_TO_BYTES(res_data), errno
+
+    def execute_data(
+            self, cmd_name, cmd_data,
+            timeout=None, is_active=None, session=''):
+        '''
+        execute a NetApp API command
+
+        :param string cmd_name:
+            name of the command to execute
+        :param dict cmd_data:
+            the command input data to use
+        :param float timeout:
+            seconds until the call deadline, None for no deadline
+        :param callable is_active:
+            returns False once the call was cancelled by the client
+        :param string session:
+            client session of the cluster connection to use
+
+        :return: succ, errmsg, resp_data, errno
+            :param bool succ:
+                True if command executed successful
+            :param string errmsg:
+                error message if succ == False
+            :param dict resp_data:
+                command respond data, empty if succ == False
+            :param int errno:
+                ZAPI errno if succ == False, 0 if unknown
+        '''
         connect_active = False
         # NOTE: that might be a little somewhat special...
         testing_active = cmd_name.startswith('TEST.')
+        conn = self.__get_connection(session)
+
+        if cmd_name == API_CONNECT_INFO_CMD:
+            # current connection, e.g. checked by reattached clients
+            res_data_json = conn.version_data()
+            res_data_json['host'] = conn.host
+            res_data_json['user'] = conn.user
+            res_data_json['connected'] = conn.connected
+
+            return True, "", res_data_json, 0
 
         if cmd_name == API_CONNECT_CMD:
             # get command data
-            host = cmd_data.get('host', 'HOST-ERROR')
-            user = cmd_data.get('user', 'USER-ERROR')
-            pwd = cmd_data.get('pwd', 'PWD-ERROR')
+            params = NetAppConnection.CONNECT_PARAMS(cmd_data)
 
             # check for changes and if already connected
-            if (
-                    host == self.host and
-                    user == self.user and
-                    pwd == self.pwd and
-                    self.connected):
-
+            if params == conn.params and conn.connected:
                 # already connected and all setup, just return data
-                res_data_json = {}
-                res_data_json['version_ontap'] = (
-                    str(self.ontap_major_version) + '.'
-                    + str(self.ontap_minor_version))
-                res_data_json['version_os'] = self.os_version
-
-                res_bytes = self.__JSON_TO_BYTES(res_data_json)
-
-                return True, "", res_bytes
+                return True, "", conn.version_data(), 0
 
             # not connected yet, store data and connect
-            self.host = host
-            self.user = user
-            self.pwd = pwd
-            
+            conn.connected = False
+            errmsg = conn.configure(params)
+            if errmsg:
+                return self.__CREATE_FAIL_RETVAL(
+                    'API connect failed with: ' + errmsg)
+
             cmd_name = 'SYS.INFO.GET'
             connect_active = True
 
@@ -323,13 +549,23 @@ class NetAppCommandExecutor(object):
             return self.__CREATE_FAIL_RETVAL(
                 'could not get command: ' + cmd_name)
 
-        if not(self.connected or connect_active or testing_active):
+        if not(conn.connected or connect_active or testing_active):
             # API is not connected yet and connect command is not active
             return self.__CREATE_FAIL_RETVAL(
                 'API not connected, call Connect() first')
 
-        cmd_res_dict = cmd.execute(
-            self.__create_server(testing_active), cmd_data)
+        server = None
+        if not testing_active:
+            server = conn.create_server(timeout, is_active)
+
+        try:
+            cmd_res_dict = cmd.execute(server, cmd_data)
+        except CallCancelled as err:
+            LOGGER.warn('cmd [%s] aborted: %s', cmd_name, err)
+            if connect_active:
+                conn.connected = False
+            return self.__CREATE_FAIL_RETVAL(
+                'cmd [' + cmd_name + '] aborted: ' + str(err))
 
         if not cmd_res_dict:
             LOGGER.error(
@@ -341,15 +577,17 @@ class NetAppCommandExecutor(object):
         res_err_msg = cmd_res_dict.get('errmsg', 'ERRMSG missing')
         res_success = cmd_res_dict.get('success', False)
         if not res_success:
-            LOGGER.debug('unsuccessful cmd exec: %s', cmd_res_dict)
+            LOGGER.debug('unsuccessful cmd exec: %s', redact_data(cmd_res_dict))
+            res_errno = cmd_res_dict.get('errno', 0)
             if connect_active:
                 # failed to connect...
-                self.connected = False
+                conn.connected = False
                 return self.__CREATE_FAIL_RETVAL(
-                    'API connect failed with: ' + res_err_msg )
+                    'API connect failed with: ' + res_err_msg, res_errno)
             
             return self.__CREATE_FAIL_RETVAL(
-                'failed cmd [' + cmd_name + '] with ' + res_err_msg)
+                'failed cmd [' + cmd_name + '] with ' + res_err_msg,
+                res_errno)
         
         if len(res_err_msg) > 0:
             LOGGER.warn(
@@ -361,23 +599,15 @@ class NetAppCommandExecutor(object):
             LOGGER.error(
                 'cmd [%s] returned empty data: %s',
                 cmd_name, res_data_json)
-            return (
-                False, 
-                'cmd [' + cmd_name + '] no data, with: ' + res_err_msg, 
-                b''
-            )
+            return self.__CREATE_FAIL_RETVAL(
+                'cmd [' + cmd_name + '] no data, with: ' + res_err_msg)
 
         if connect_active:
-            self.connected = True
-            self.ontap_major_version = res_data_json.pop('ontap_major')
-            self.ontap_minor_version = res_data_json.pop('ontap_minor')
-            self.os_version = res_data_json.pop('os_version')
-
-            res_data_json['version_ontap'] = (
-                str(self.ontap_major_version) + '.'
-                + str(self.ontap_minor_version))
-            res_data_json['version_os'] = self.os_version
+            conn.connected = True
+            conn.ontap_major_version = res_data_json.pop('ontap_major')
+            conn.ontap_minor_version = res_data_json.pop('ontap_minor')
+            conn.os_version = res_data_json.pop('os_version')
 
-        res_bytes = self.__JSON_TO_BYTES(res_data_json)
+            res_data_json.update(conn.version_data())
 
-        return res_success, res_err_msg, res_bytes
+        return res_success, res_err_msg, res_data_json, 0
@@ -726,6 +726,7 @@ class JobGetCommand(NetAppCommand):
         ji.child_add_string("job-completion","<job-completion>")
         ji.child_add_string("job-state","<job-state>")
         ji.child_add_string("job-status-code","<job-status-code>")
+        ji.child_add_string("job-progress","<job-progress>")
 
         des_attr.child_add(ji)
         call.child_add(des_attr)
@@ -759,7 +760,8 @@ class JobGetCommand(NetAppCommand):
             'svm': self._GET_STRING(job_info, "job-vserver"),
             'msg': self._GET_STRING(job_info, "job-completion"),
             'status': self._GET_STRING(job_info, "job-state"),
-            'errno': self._GET_INT(job_info, "job-status-code")
+            'errno': self._GET_INT(job_info, "job-status-code"),
+            'progress': self._GET_STRING(job_info, "job-progress")
         }
 
         return {
@@ -1,8 +1,12 @@
 # test implementation of key store
 
 import logging
+import logging.handlers
 import os
+import queue
+import signal
 import sys
+import threading
 
 import sys
 
@@ -27,23 +31,224 @@ import grpc
 import grpcapi_pb2
 import grpcapi_pb2_grpc
 
+from redact import SECRET_FILTER
+
+from google.protobuf import json_format
+
 from grpc_health.v1.health import HealthServicer
 from grpc_health.v1 import health_pb2, health_pb2_grpc
 
+# file log of the API server, relative to the API folder, rotated once it
+# grows beyond LOG_MAX_BYTES, 0 never rotates
+LOG_FILE = os.environ.get('NETAPP_API_LOG_FILE', 'python_api.log')
+LOG_MAX_BYTES = int(os.environ.get('NETAPP_API_LOG_MAX_BYTES', '0'))
+LOG_BACKUPS = 3
+LOG_FORMAT = (
+    '[%(asctime)s %(levelname)s][' + str(os.getpid())
+    + '] @{%(name)s:%(lineno)d} - %(message)s')
+
+# records per log stream waiting to be sent, further records are dropped
+LOG_STREAM_BUFFER = 1000
+# log streams served at once, each takes a worker thread of the server
+MAX_LOG_STREAMS = 8
+
+class CallSession(threading.local):
+    '''
+    client session of the call executed by the current thread, the log
+    records of the call are tagged with it
+    '''
+    session = ''
+
+CALL_SESSION = CallSession()
+
+class LogBroadcaster(logging.Handler):
+    '''
+    passes the log records on to the log streams of the clients, must not
+    log itself
+    '''
+
+    def __init__(self):
+        super(LogBroadcaster, self).__init__()
+        self.setFormatter(logging.Formatter('%(message)s'))
+        self.streams_lock = threading.Lock()
+        self.streams = []
+
+    def subscribe(self, level):
+        '''
+        :return queue.Queue: the records of level and above, None if already
+            MAX_LOG_STREAMS streams are subscribed
+        '''
+        with self.streams_lock:
+            if len(self.streams) >= MAX_LOG_STREAMS:
+                return None
+
+            records = queue.Queue(LOG_STREAM_BUFFER)
+            self.streams.append((records, level))
+            return records
+
+    def unsubscribe(self, records):
+        with self.streams_lock:
+            self.streams = [s for s in self.streams if s[0] is not records]
+
+    def emit(self, record):
+        with self.streams_lock:
+            streams = list(self.streams)
+        if not streams:
+            return
+
+        log_record = grpcapi_pb2.LogRecord()
+        log_record.level = record.levelname
+        log_record.name = record.name
+        log_record.message = self.format(record)
+        log_record.session = CALL_SESSION.session
+
+        for records, level in streams:
+            if record.levelno < level:
+                continue
+            try:
+                records.put_nowait(log_record)
+            except queue.Full:
+                pass
+
+LOG_BROADCASTER = LogBroadcaster()
+
+def configure_logging():
+    '''
+    log to the rotated LOG_FILE and the log streams of the clients
+    '''
+    file_handler = logging.handlers.RotatingFileHandler(
+        LOG_FILE, maxBytes=LOG_MAX_BYTES, backupCount=LOG_BACKUPS)
+    file_handler.setFormatter(logging.Formatter(LOG_FORMAT))
+    # the secrets never reach the log file or the log streams
+    file_handler.addFilter(SECRET_FILTER)
+    LOG_BROADCASTER.addFilter(SECRET_FILTER)
+
+    root = logging.getLogger()
+    root.setLevel(logging.DEBUG)
+    root.addHandler(file_handler)
+    root.addHandler(LOG_BROADCASTER)
+
 # configure logging before command modules import, otherwise logging fails
-logging.basicConfig(
-    filename='python_api.log',
-    level=logging.DEBUG,
-    format=(
-        '[%(asctime)s %(levelname)s][' + str(os.getpid()) 
-        + '] @{%(name)s:%(lineno)d} - %(message)s')
-)
+configure_logging()
 LOGGER = logging.getLogger('grpcapi')
 
 LOCALHOST = "172.0.0.1"
 CHECK_TIMEOUT = 0.8                 # check if api is being used every 800ms
 RUNNING_FILE = "./API_UP"           # file indicating to outside that API grpc server is up
 STOPPING_FILE = "./API_STOPPING"    # file indicating to outside that API grpc server is shutting down
+PID_FILE = "./API_PID"              # PID of the API grpc server, used to detect stale marker files
+
+# keep serving for reattached clients of later provider runs after the
+# last call, in seconds
+IDLE_TIMEOUT = float(os.environ.get('NETAPP_API_IDLE_TIMEOUT', '300'))
+JOB_WATCH_INTERVAL = 0.5            # job state poll interval of job watches
+
+# gRPC metadata key of the client session, see grpcapi.SessionMetadataKey
+SESSION_METADATA_KEY = 'netapp-session'
+# trailer metadata key of the ZAPI errno of an aborted typed RPC
+ERRNO_METADATA_KEY = 'netapp-errno'
+
+# build of the provider which started the server, see pythonapi.BuildHash
+API_BUILD = os.environ.get('NETAPP_API_BUILD', '')
+
+# final job states, a job watch ends once the job reached one of them
+JOB_END_STATES = ('success', 'failure', 'error', 'quit', 'dead')
+
+# typed RPCs and the command they execute, the commands without typed RPC
+# are executed by the generic JSON Call, e.g. SYS.CONNECT
+TYPED_COMMANDS = {
+    'NodeGet': 'SYS.NODE.GET',
+    'PortGet': 'SYS.PORT.GET',
+    'PortModify': 'SYS.PORT.MODIFY',
+    'PortFindPattern': 'SYS.PORT.FIND.PATTERN',
+    'PortGroupGet': 'SYS.PORTGROUP.GET',
+    'PortGroupCreate': 'SYS.PORTGROUP.CREATE',
+    'PortGroupPortAdd': 'SYS.PORTGROUP.PORT.ADD',
+    'PortGroupPortRemove': 'SYS.PORTGROUP.PORT.REMOVE',
+    'PortGroupDelete': 'SYS.PORTGROUP.DELETE',
+    'AggrGet': 'SYS.AGGR.GET',
+    'JobGet': 'SYS.JOB.GET',
+
+    'VlanGet': 'NW.VLAN.GET',
+    'VlanCreate': 'NW.VLAN.CREATE',
+    'VlanDelete': 'NW.VLAN.DELETE',
+    'IPSpaceGet': 'NW.IPSPACE.GET',
+    'IPSpaceCreate': 'NW.IPSPACE.CREATE',
+    'IPSpaceUpdate': 'NW.IPSPACE.UPDATE',
+    'IPSpaceDelete': 'NW.IPSPACE.DELETE',
+    'BcDomainGet': 'NW.BRCDOM.GET',
+    'BcDomainStatus': 'NW.BRCDOM.STATUS',
+    'BcDomainCreate': 'NW.BRCDOM.CREATE',
+    'BcDomainRename': 'NW.BRCDOM.RENAME',
+    'BcDomainPortAdd': 'NW.BRCDOM.PORT.ADD',
+    'BcDomainPortRemove': 'NW.BRCDOM.PORT.REMOVE',
+    'BcDomainUpdate': 'NW.BRCDOM.UPDATE',
+    'BcDomainDelete': 'NW.BRCDOM.DELETE',
+    'SubnetGet': 'NW.SUBNET.GET',
+    'SubnetCreate': 'NW.SUBNET.CREATE',
+    'SubnetDelete': 'NW.SUBNET.DELETE',
+    'SubnetRename': 'NW.SUBNET.RENAME',
+    'SubnetIPRangeAdd': 'NW.SUBNET.IPR.ADD',
+    'SubnetIPRangeRemove': 'NW.SUBNET.IPR.REMOVE',
+    'SubnetModify': 'NW.SUBNET.MODIFY',
+
+    'SvmGet': 'SVM.GET',
+    'SvmCreate': 'SVM.CREATE',
+    'SvmDelete': 'SVM.DELETE',
+    'SvmStart': 'SVM.START',
+    'SvmStop': 'SVM.STOP',
+    'SvmUnlock': 'SVM.UNLOCK',
+    'SvmRename': 'SVM.RENAME',
+    'VolumeOnline': 'SVM.VOL.ONLINE',
+    'VolumeOffline': 'SVM.VOL.OFFLINE',
+    'VolumeRestrict': 'SVM.VOL.RESTRICT',
+    'VolumeDelete': 'SVM.VOL.DELETE',
+    'VolumeSize': 'SVM.VOL.SIZE',
+}
+
+def message_to_data(message):
+    '''
+    command data of the typed request, only set (non-empty) fields are
+    passed on like the omitempty JSON of the Go helpers. Requests with
+    explicit empty values come with the generic Call, proto3 drops them
+    '''
+    data = {}
+    for field, value in message.ListFields():
+        repeated = field.label == field.LABEL_REPEATED
+        if field.message_type is not None:
+            if repeated:
+                value = [message_to_data(v) for v in value]
+            else:
+                value = message_to_data(value)
+        elif repeated:
+            value = list(value)
+
+        data[field.name] = value
+
+    return data
+
+def call_session(context):
+    '''
+    client session of the call, clients without session share the empty one.
+    The log records of the current thread are tagged with it
+    '''
+    CALL_SESSION.session = ''
+    for key, value in context.invocation_metadata():
+        if key == SESSION_METADATA_KEY:
+            CALL_SESSION.session = value
+            break
+
+    return CALL_SESSION.session
+
+def abort_command(context, errmsg, errno):
+    '''
+    abort the typed RPC of the failed command, the errno is sent with the
+    trailer metadata
+    '''
+    if errno:
+        context.set_trailing_metadata(((ERRNO_METADATA_KEY, str(errno)),))
+
+    context.abort(grpc.StatusCode.ABORTED, errmsg)
 
 class NetAppApiServicer(grpcapi_pb2_grpc.GRPCNetAppApiServicer):
     """Implementation of NetAppApiServicer."""
@@ -56,15 +261,19 @@ class NetAppApiServicer(grpcapi_pb2_grpc.GRPCNetAppApiServicer):
 
         LOGGER.debug("servicer initialized")
 
-    def Call(self, request, context):
-        LOGGER.debug("Call request: %s", request.cmd)
-
-        # indicate start of call to call counter
-        self.counter.start_call()
+    def execute_call(self, request, context, session):
+        '''
+        execute the command of the call request with the client session
 
-        # get the executor to execute the command
-        succ, errmsg, resp_data = self.executor.execute(
-                                        request.cmd, request.data)
+        :return grpcapi_pb2.CallResponse: the command result
+        '''
+        # get the executor to execute the command, the gRPC deadline and
+        # client cancellation (Ctrl-C in terraform) abort the command
+        succ, errmsg, resp_data, errno = self.executor.execute(
+                                        request.cmd, request.data,
+                                        timeout=context.time_remaining(),
+                                        is_active=context.is_active,
+                                        session=session)
 
         # do some internal logging
         if not succ:
@@ -72,26 +281,187 @@ class NetAppApiServicer(grpcapi_pb2_grpc.GRPCNetAppApiServicer):
                 'cmd [%s] failed with: %s',
                 request.cmd, errmsg)
 
-        # create the call response and ship it
+        # create the call response
         resp = grpcapi_pb2.CallResponse()
         resp.success = succ
         resp.errmsg = errmsg
         resp.data = resp_data
+        resp.errno = errno
+
+        return resp
+
+    def Call(self, request, context):
+        session = call_session(context)
+        LOGGER.debug("Call request: %s", request.cmd)
+
+        # indicate start of call to call counter
+        self.counter.start_call()
+
+        resp = self.execute_call(request, context, session)
 
         # indicate end of call to call counter
         self.counter.end_call()
 
         return resp
 
+    def CallBatch(self, request, context):
+        '''
+        execute the calls in order, a failed call does not stop the batch,
+        each call gets its own result
+        '''
+        session = call_session(context)
+        LOGGER.debug(
+            "batch request: %s", ', '.join(call.cmd for call in request.calls))
+
+        self.counter.start_call()
+
+        resp = grpcapi_pb2.BatchResponse()
+        for call in request.calls:
+            resp.results.append(self.execute_call(call, context, session))
+
+        self.counter.end_call()
+
+        return resp
+
+    def typed_call(self, cmd_name, response_type, request, context):
+        session = call_session(context)
+        LOGGER.debug("typed call request: %s", cmd_name)
+
+        # indicate start of call to call counter
+        self.counter.start_call()
+
+        succ, errmsg, resp_data, errno = self.executor.execute_data(
+                                        cmd_name, message_to_data(request),
+                                        timeout=context.time_remaining(),
+                                        is_active=context.is_active,
+                                        session=session)
+
+        # indicate end of call to call counter
+        self.counter.end_call()
+
+        if not succ:
+            LOGGER.error(
+                'cmd [%s] failed with: %s',
+                cmd_name, errmsg)
+            # ABORTED tells the client the command failed, not the call
+            abort_command(context, errmsg, errno)
+
+        try:
+            # unknown keys fail instead of being dropped silently
+            return json_format.ParseDict(resp_data, response_type())
+        except json_format.ParseError as err:
+            LOGGER.error(
+                'cmd [%s] response does not match %s: %s',
+                cmd_name, response_type.__name__, err)
+            context.abort(
+                grpc.StatusCode.INTERNAL,
+                'cmd [' + cmd_name + '] response does not match '
+                + response_type.__name__ + ': ' + str(err))
+
+    def WatchJob(self, request, context):
+        session = call_session(context)
+        LOGGER.debug("job watch request: %s", request.id)
+
+        # the watch counts as call, the API must not stop while watching
+        self.counter.start_call()
+
+        try:
+            job_data = message_to_data(request)
+            last_info = None
+            while context.is_active():
+                succ, errmsg, resp_data, _ = self.executor.execute_data(
+                                                'SYS.JOB.GET', job_data,
+                                                timeout=context.time_remaining(),
+                                                is_active=context.is_active,
+                                                session=session)
+                if not succ:
+                    LOGGER.error(
+                        'job [%s] watch failed with: %s',
+                        request.id, errmsg)
+                    context.abort(grpc.StatusCode.ABORTED, errmsg)
+
+                try:
+                    job_info = json_format.ParseDict(
+                        resp_data, grpcapi_pb2.JobInfo())
+                except json_format.ParseError as err:
+                    LOGGER.error(
+                        'job [%s] info does not match JobInfo: %s',
+                        request.id, err)
+                    context.abort(
+                        grpc.StatusCode.INTERNAL,
+                        'job info does not match JobInfo: ' + str(err))
+
+                # only push job state and progress changes
+                if job_info != last_info:
+                    yield job_info
+                    last_info = job_info
+
+                if job_info.status in JOB_END_STATES:
+                    return
+
+                time.sleep(JOB_WATCH_INTERVAL)
+        finally:
+            # indicate end of call to call counter
+            self.counter.end_call()
+
+    def GetVersion(self, request, context):
+        LOGGER.debug("version request")
+        resp = grpcapi_pb2.VersionResponse()
+        resp.build = API_BUILD
+        resp.commands.extend(self.executor.commands())
+        return resp
+
+    def StreamLogs(self, request, context):
+        # not counted as call, the server stops while clients only stream
+        level = logging.getLevelName(request.level or 'INFO')
+        if not isinstance(level, int):
+            context.abort(
+                grpc.StatusCode.INVALID_ARGUMENT,
+                'unknown log level: ' + request.level)
+
+        records = LOG_BROADCASTER.subscribe(level)
+        if records is None:
+            context.abort(
+                grpc.StatusCode.RESOURCE_EXHAUSTED,
+                'already ' + str(MAX_LOG_STREAMS) + ' log streams')
+
+        try:
+            while context.is_active():
+                try:
+                    yield records.get(timeout=CHECK_TIMEOUT)
+                except queue.Empty:
+                    pass
+        finally:
+            LOG_BROADCASTER.unsubscribe(records)
+
     def Shutdown(self, request, context):
         LOGGER.debug("SD request for client: %s", request.clientid)
-        success = self.registry.set_client_status(
-            request.clientid,
-            ClientStatus.shutdown)
+        # the client ID is the session of the client's cluster connection
+        self.executor.close_session(request.clientid)
+        # reattached clients were never launched, nothing waits for them
+        success = True
+        if self.registry.has_client(request.clientid):
+            success = self.registry.set_client_status(
+                request.clientid,
+                ClientStatus.shutdown)
         resp = grpcapi_pb2.ShutdownResponse()
         resp.result = success
         return resp
 
+def typed_rpc(rpc_name, cmd_name):
+    method = grpcapi_pb2.DESCRIPTOR.services_by_name[
+        'GRPCNetAppApi'].FindMethodByName(rpc_name)
+    response_type = getattr(grpcapi_pb2, method.output_type.name)
+
+    def rpc(self, request, context):
+        return self.typed_call(cmd_name, response_type, request, context)
+
+    return rpc
+
+# implement the typed RPCs of the servicer
+for rpc_name, cmd_name in TYPED_COMMANDS.items():
+    setattr(NetAppApiServicer, rpc_name, typed_rpc(rpc_name, cmd_name))
+
 def notify_grpc(client_id, registry):
     # send stdout msg for go-plugin to understand...
     grpc_msg = registry.get_grpc_msg()
@@ -116,6 +486,14 @@ def unregister_client(registry, client_id):
 
     return True
 
+def interrupt(signum, frame):
+    '''
+    stop serving on SIGTERM like on idle timeout, e.g. when a provider of
+    another build replaces this server
+    '''
+    LOGGER.warning('received signal [%d], stopping', signum)
+    raise KeyboardInterrupt()
+
 def serve(client_id, host='127.0.0.1', port='1234'):
 
     # create client registry server
@@ -169,7 +547,9 @@ def serve(client_id, host='127.0.0.1', port='1234'):
         health_pb2.HealthCheckResponse.ServingStatus.Value('SERVING'))
 
     # Start the server.
-    server = grpc.server(futures.ThreadPoolExecutor(max_workers=10))
+    # the log streams take a worker thread each besides the calls
+    server = grpc.server(futures.ThreadPoolExecutor(
+        max_workers=10 + MAX_LOG_STREAMS))
     grpcapi_pb2_grpc.add_GRPCNetAppApiServicer_to_server(servicer, server)
     health_pb2_grpc.add_HealthServicer_to_server(health, server)
     server.add_insecure_port(host + ':' + port)
@@ -193,6 +573,12 @@ def serve(client_id, host='127.0.0.1', port='1234'):
         reg_server.join()
         sys.exit(1)
 
+    # write the server PID before the running file, a running file
+    # without live PID process is removed as stale by the Go side
+    with open(PID_FILE + '.tmp', 'w') as pid_file:
+        pid_file.write(str(os.getpid()))
+    os.rename(PID_FILE + '.tmp', PID_FILE)
+
     # create running status file
     # source: https://stackoverflow.com/a/12654798
     with open(RUNNING_FILE, 'a'):
@@ -200,10 +586,18 @@ def serve(client_id, host='127.0.0.1', port='1234'):
 
     LOGGER.debug('running file created')
 
+    signal.signal(signal.SIGTERM, interrupt)
+
     try:
-        # check if counter thinks we should continue
-        # either by init or calls received/pending
-        while call_counter.stay_alive():
+        idle_since = time.time()
+        while True:
+            # check if counter thinks we should continue
+            # either by init or calls received/pending
+            if call_counter.stay_alive():
+                idle_since = time.time()
+            elif time.time() - idle_since > IDLE_TIMEOUT:
+                break
+
             # get the number of calls received + reset
             call_cnt = call_counter.get_call_cnt()
             LOGGER.debug("active calls, was needed %d times", call_cnt)
@@ -231,6 +625,7 @@ def serve(client_id, host='127.0.0.1', port='1234'):
     reg_server.join()
 
     os.remove(STOPPING_FILE)     # making sure we also declare stopped
+    os.remove(PID_FILE)
 
     LOGGER.debug("exiting netapp API serve()")
 
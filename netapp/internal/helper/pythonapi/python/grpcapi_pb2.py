@@ -19,7 +19,7 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   package='grpcapi',
   syntax='proto3',
   serialized_options=None,
-  serialized_pb=_b('\n\rgrpcapi.proto\x12\x07grpcapi\"(\n\x0b\x43\x61llRequest\x12\x0b\n\x03\x63md\x18\x01 \x01(\t\x12\x0c\n\x04\x64\x61ta\x18\x02 \x01(\x0c\"=\n\x0c\x43\x61llResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x0e\n\x06\x65rrmsg\x18\x02 \x01(\t\x12\x0c\n\x04\x64\x61ta\x18\x03 \x01(\x0c\"#\n\x0fShutdownRequest\x12\x10\n\x08\x63lientid\x18\x01 \x01(\t\"\"\n\x10ShutdownResponse\x12\x0e\n\x06result\x18\x01 \x01(\x08\x32\x85\x01\n\rGRPCNetAppApi\x12\x33\n\x04\x43\x61ll\x12\x14.grpcapi.CallRequest\x1a\x15.grpcapi.CallResponse\x12?\n\x08Shutdown\x12\x18.grpcapi.ShutdownRequest\x1a\x19.grpcapi.ShutdownResponseb\x06proto3')
+  serialized_pb=_b('\n\rgrpcapi.proto\x12\x07grpcapi\"(\n\x0b\x43\x61llRequest\x12\x0b\n\x03\x63md\x18\x01 \x01(\t\x12\x0c\n\x04\x64\x61ta\x18\x02 \x01(\x0c\"L\n\x0c\x43\x61llResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x0e\n\x06\x65rrmsg\x18\x02 \x01(\t\x12\x0c\n\x04\x64\x61ta\x18\x03 \x01(\x0c\x12\r\n\x05\x65rrno\x18\x04 \x01(\x05\"3\n\x0c\x42\x61tchRequest\x12#\n\x05\x63\x61lls\x18\x01 \x03(\x0b\x32\x14.grpcapi.CallRequest\"7\n\rBatchResponse\x12&\n\x07results\x18\x01 \x03(\x0b\x32\x15.grpcapi.CallResponse\"#\n\x0fShutdownRequest\x12\x10\n\x08\x63lientid\x18\x01 \x01(\t\"\"\n\x10ShutdownResponse\x12\x0e\n\x06result\x18\x01 \x01(\x08\"\x10\n\x0eVersionRequest\"2\n\x0fVersionResponse\x12\r\n\x05\x62uild\x18\x01 \x01(\t\x12\x10\n\x08\x63ommands\x18\x02 \x03(\t\"1\n\rEmptyResponse\x12\x11\n\tnon_exist\x18\x01 \x01(\x08\x12\r\n\x05\x64ummy\x18\x02 \x01(\x03\"\x1b\n\nLogRequest\x12\r\n\x05level\x18\x01 \x01(\t\"J\n\tLogRecord\x12\r\n\x05level\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0f\n\x07message\x18\x03 \x01(\t\x12\x0f\n\x07session\x18\x04 \x01(\t\")\n\x0bNodeRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0c\n\x04uuid\x18\x02 \x01(\t\"\x87\x01\n\x08NodeInfo\x12\x11\n\tnon_exist\x18\x01 \x01(\x08\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0e\n\x06serial\x18\x03 \x01(\t\x12\n\n\x02id\x18\x04 \x01(\t\x12\x0c\n\x04uuid\x18\x05 \x01(\t\x12\x0f\n\x07version\x18\x06 \x01(\t\x12\x0f\n\x07healthy\x18\x07 \x01(\x08\x12\x0e\n\x06uptime\x18\x08 \x01(\x03\")\n\x0bPortRequest\x12\x0c\n\x04node\x18\x01 \x01(\t\x12\x0c\n\x04port\x18\x02 \x01(\t\"\xd1\x03\n\x08PortInfo\x12\x11\n\tnon_exist\x18\x01 \x01(\x08\x12\x0c\n\x04node\x18\x02 \x01(\t\x12\x0c\n\x04port\x18\x03 \x01(\t\x12\x16\n\x0e\x61uto_rev_delay\x18\x04 \x01(\t\x12\x13\n\x0bignr_health\x18\x05 \x01(\t\x12\x0f\n\x07ipspace\x18\x06 \x01(\t\x12\x0c\n\x04role\x18\x07 \x01(\t\x12\x10\n\x08\x61\x64min_up\x18\x08 \x01(\t\x12\x11\n\tadmin_mtu\x18\t \x01(\t\x12\x12\n\nadmin_auto\x18\n \x01(\t\x12\x13\n\x0b\x61\x64min_speed\x18\x0b \x01(\t\x12\x14\n\x0c\x61\x64min_duplex\x18\x0c \x01(\t\x12\x12\n\nadmin_flow\x18\r \x01(\t\x12\x0e\n\x06status\x18\x0e \x01(\t\x12\x0e\n\x06health\x18\x0f \x01(\t\x12\x0b\n\x03mac\x18\x10 \x01(\t\x12\x18\n\x10\x62roadcast_domain\x18\x11 \x01(\t\x12\x0b\n\x03mtu\x18\x12 \x01(\t\x12\x0c\n\x04\x61uto\x18\x13 \x01(\t\x12\r\n\x05speed\x18\x14 \x01(\t\x12\x0e\n\x06\x64uplex\x18\x15 \x01(\t\x12\x0c\n\x04\x66low\x18\x16 \x01(\t\x12\x0c\n\x04type\x18\x17 \x01(\t\x12\x0f\n\x07vlan_id\x18\x18 \x01(\t\x12\x11\n\tvlan_node\x18\x19 \x01(\t\x12\x11\n\tvlan_port\x18\x1a \x01(\t\"\xcf\x01\n\x11PortModifyRequest\x12\x0c\n\x04node\x18\x01 \x01(\t\x12\x0c\n\x04port\x18\x02 \x01(\t\x12\n\n\x02up\x18\x03 \x01(\t\x12\x0b\n\x03mtu\x18\x04 \x01(\t\x12\x0c\n\x04\x61uto\x18\x05 \x01(\t\x12\x0e\n\x06\x64uplex\x18\x06 \x01(\t\x12\x0c\n\x04\x66low\x18\x07 \x01(\t\x12\r\n\x05speed\x18\x08 \x01(\t\x12\x16\n\x0e\x61uto_rev_delay\x18\t \x01(\t\x12\x13\n\x0bignr_health\x18\n \x01(\t\x12\x0f\n\x07ipspace\x18\x0b \x01(\t\x12\x0c\n\x04role\x18\x0c \x01(\t\"4\n\x10PortFindResponse\x12\x11\n\tnon_exist\x18\x01 \x01(\x08\x12\r\n\x05ports\x18\x02 \x03(\t\"Y\n\x10PortGroupRequest\x12\x0c\n\x04node\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0c\n\x04mode\x18\x03 \x01(\t\x12\x0c\n\x04\x64ist\x18\x04 \x01(\t\x12\r\n\x05ports\x18\x05 \x03(\t\"\x9d\x01\n\rPortGroupInfo\x12\x11\n\tnon_exist\x18\x01 \x01(\x08\x12\x0c\n\x04node\x18\x02 \x01(\t\x12\x0c\n\x04name\x18\x03 \x01(\t\x12\x0c\n\x04mode\x18\x04 \x01(\t\x12\x0c\n\x04\x64ist\x18\x05 \x01(\t\x12\r\n\x05ports\x18\x06 \x03(\t\x12\x0c\n\x04part\x18\x07 \x01(\t\x12\x12\n\nports_down\x18\x08 \x03(\t\x12\x10\n\x08ports_up\x18\t \x03(\t\"8\n\x0b\x41ggrRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0c\n\x04uuid\x18\x02 \x01(\t\x12\r\n\x05nodes\x18\x03 \x03(\t\"\xdb\x01\n\x08\x41ggrInfo\x12\x11\n\tnon_exist\x18\x01 \x01(\x08\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0c\n\x04uuid\x18\x03 \x01(\t\x12\r\n\x05nodes\x18\x04 \x03(\t\x12\x13\n\x0b\x66lexvol_cnt\x18\x05 \x01(\x03\x12\x14\n\x0cpct_used_cap\x18\x06 \x01(\x03\x12\x15\n\rpct_used_phys\x18\x07 \x01(\x03\x12\x12\n\nsize_total\x18\x08 \x01(\x03\x12\x11\n\tsize_used\x18\t \x01(\x03\x12\x12\n\nsize_avail\x18\n \x01(\x03\x12\x14\n\x0csize_reserve\x18\x0b \x01(\x03\"%\n\nJobRequest\x12\n\n\x02id\x18\x01 \x01(\x03\x12\x0b\n\x03svm\x18\x02 \x01(\t\"s\n\x07JobInfo\x12\x11\n\tnon_exist\x18\x01 \x01(\x08\x12\n\n\x02id\x18\x02 \x01(\x03\x12\x0b\n\x03svm\x18\x03 \x01(\t\x12\x0b\n\x03msg\x18\x04 \x01(\t\x12\x0e\n\x06status\x18\x05 \x01(\t\x12\r\n\x05\x65rrno\x18\x06 \x01(\x03\x12\x10\n\x08progress\x18\x07 \x01(\t\"*\n\x0fWatchJobRequest\x12\n\n\x02id\x18\x01 \x01(\x03\x12\x0b\n\x03svm\x18\x02 \x01(\t\"F\n\x0bVlanRequest\x12\x11\n\tnode_name\x18\x01 \x01(\t\x12\x13\n\x0bparent_name\x18\x02 \x01(\t\x12\x0f\n\x07vlan_id\x18\x03 \x01(\t\"d\n\x08VlanInfo\x12\x11\n\tnon_exist\x18\x01 \x01(\x08\x12\x11\n\tnode_name\x18\x02 \x01(\t\x12\x13\n\x0bparent_name\x18\x03 \x01(\t\x12\x0f\n\x07vlan_id\x18\x04 \x01(\t\x12\x0c\n\x04name\x18\x05 \x01(\t\">\n\x0eIPSpaceRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0c\n\x04uuid\x18\x02 \x01(\t\x12\x10\n\x08new_name\x18\x03 \x01(\t\"q\n\x0bIPSpaceInfo\x12\x11\n\tnon_exist\x18\x01 \x01(\x08\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0c\n\x04uuid\x18\x03 \x01(\t\x12\x12\n\nbc_domains\x18\x04 \x03(\t\x12\r\n\x05ports\x18\x05 \x03(\t\x12\x10\n\x08vservers\x18\x06 \x03(\t\"r\n\x0f\x42\x63\x44omainRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x10\n\x08new_name\x18\x02 \x01(\t\x12\x0b\n\x03mtu\x18\x03 \x01(\t\x12\x0f\n\x07ipspace\x18\x04 \x01(\t\x12\r\n\x05ports\x18\x05 \x03(\t\x12\x12\n\nstatusonly\x18\x06 \x01(\t\"N\n\x10\x42\x63\x44omainPortInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x15\n\rupdate_status\x18\x02 \x01(\t\x12\x15\n\rstatus_detail\x18\x03 \x01(\t\"\xb5\x01\n\x0c\x42\x63\x44omainInfo\x12\x11\n\tnon_exist\x18\x01 \x01(\x08\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x14\n\x0c\x66\x61ilovergrps\x18\x03 \x03(\t\x12\x0f\n\x07ipspace\x18\x04 \x01(\t\x12\x0b\n\x03mtu\x18\x05 \x01(\t\x12\x15\n\rupdate_status\x18\x06 \x01(\t\x12(\n\x05ports\x18\x07 \x03(\x0b\x32\x19.grpcapi.BcDomainPortInfo\x12\x0f\n\x07subnets\x18\x08 \x03(\t\"\x87\x01\n\rSubnetRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x10\n\x08new_name\x18\x02 \x01(\t\x12\x11\n\tbc_domain\x18\x03 \x01(\t\x12\x0f\n\x07ipspace\x18\x04 \x01(\t\x12\x0e\n\x06subnet\x18\x05 \x01(\t\x12\x0f\n\x07gateway\x18\x06 \x01(\t\x12\x11\n\tip_ranges\x18\x07 \x03(\t\"\xba\x01\n\nSubnetInfo\x12\x11\n\tnon_exist\x18\x01 \x01(\x08\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x11\n\tbc_domain\x18\x03 \x01(\t\x12\x0f\n\x07ipspace\x18\x04 \x01(\t\x12\x0e\n\x06subnet\x18\x05 \x01(\t\x12\x0f\n\x07gateway\x18\x06 \x01(\t\x12\x11\n\tip_ranges\x18\x07 \x03(\t\x12\x10\n\x08ip_count\x18\x08 \x01(\x03\x12\x0f\n\x07ip_used\x18\t \x01(\x03\x12\x10\n\x08ip_avail\x18\n \x01(\x03\"\xad\x01\n\nSvmRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x10\n\x08new_name\x18\x02 \x01(\t\x12\r\n\x05\x66orce\x18\x03 \x01(\t\x12\x0c\n\x04uuid\x18\x04 \x01(\t\x12\x0f\n\x07ipspace\x18\x05 \x01(\t\x12\x11\n\troot_aggr\x18\x06 \x01(\t\x12\x16\n\x0eroot_sec_style\x18\x07 \x01(\t\x12\x11\n\troot_name\x18\x08 \x01(\t\x12\x13\n\x0broot_retent\x18\t \x01(\t\"\x82\x02\n\x07SvmInfo\x12\x11\n\tnon_exist\x18\x01 \x01(\x08\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0c\n\x04uuid\x18\x03 \x01(\t\x12\x0f\n\x07ipspace\x18\x04 \x01(\t\x12\x11\n\troot_aggr\x18\x05 \x01(\t\x12\x16\n\x0eroot_sec_style\x18\x06 \x01(\t\x12\x11\n\troot_name\x18\x07 \x01(\t\x12\x13\n\x0broot_retent\x18\x08 \x01(\t\x12\x0e\n\x06locked\x18\t \x01(\x08\x12\x12\n\noper_state\x18\n \x01(\t\x12\x11\n\tsvm_state\x18\x0b \x01(\t\x12\x15\n\rproto_enabled\x18\x0c \x03(\t\x12\x16\n\x0eproto_inactive\x18\r \x03(\t\"\xc5\x02\n\x0cSvmJobResult\x12\x11\n\tnon_exist\x18\x01 \x01(\x08\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0c\n\x04uuid\x18\x03 \x01(\t\x12\x0f\n\x07ipspace\x18\x04 \x01(\t\x12\x11\n\troot_aggr\x18\x05 \x01(\t\x12\x16\n\x0eroot_sec_style\x18\x06 \x01(\t\x12\x11\n\troot_name\x18\x07 \x01(\t\x12\x13\n\x0broot_retent\x18\x08 \x01(\t\x12\x0e\n\x06locked\x18\t \x01(\x08\x12\x12\n\noper_state\x18\n \x01(\t\x12\x11\n\tsvm_state\x18\x0b \x01(\t\x12\x15\n\rproto_enabled\x18\x0c \x03(\t\x12\x16\n\x0eproto_inactive\x18\r \x03(\t\x12\x0e\n\x06status\x18\x0e \x01(\t\x12\r\n\x05jobid\x18\x0f \x01(\x03\x12\r\n\x05\x65rrno\x18\x10 \x01(\x03\x12\x0e\n\x06\x65rrmsg\x18\x11 \x01(\t\"=\n\rVolumeRequest\x12\x10\n\x08svm_name\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0c\n\x04size\x18\x03 \x01(\t\"M\n\nVolumeInfo\x12\x11\n\tnon_exist\x18\x01 \x01(\x08\x12\x10\n\x08svm_name\x18\x02 \x01(\t\x12\x0c\n\x04name\x18\x03 \x01(\t\x12\x0c\n\x04size\x18\x04 \x01(\t2\xf8\x18\n\rGRPCNetAppApi\x12\x33\n\x04\x43\x61ll\x12\x14.grpcapi.CallRequest\x1a\x15.grpcapi.CallResponse\x12:\n\tCallBatch\x12\x15.grpcapi.BatchRequest\x1a\x16.grpcapi.BatchResponse\x12?\n\x08Shutdown\x12\x18.grpcapi.ShutdownRequest\x1a\x19.grpcapi.ShutdownResponse\x12?\n\nGetVersion\x12\x17.grpcapi.VersionRequest\x1a\x18.grpcapi.VersionResponse\x12\x37\n\nStreamLogs\x12\x13.grpcapi.LogRequest\x1a\x12.grpcapi.LogRecord0\x01\x12\x32\n\x07NodeGet\x12\x14.grpcapi.NodeRequest\x1a\x11.grpcapi.NodeInfo\x12\x32\n\x07PortGet\x12\x14.grpcapi.PortRequest\x1a\x11.grpcapi.PortInfo\x12@\n\nPortModify\x12\x1a.grpcapi.PortModifyRequest\x1a\x16.grpcapi.EmptyResponse\x12\x42\n\x0fPortFindPattern\x12\x14.grpcapi.PortRequest\x1a\x19.grpcapi.PortFindResponse\x12\x41\n\x0cPortGroupGet\x12\x19.grpcapi.PortGroupRequest\x1a\x16.grpcapi.PortGroupInfo\x12\x44\n\x0fPortGroupCreate\x12\x19.grpcapi.PortGroupRequest\x1a\x16.grpcapi.EmptyResponse\x12\x45\n\x10PortGroupPortAdd\x12\x19.grpcapi.PortGroupRequest\x1a\x16.grpcapi.EmptyResponse\x12H\n\x13PortGroupPortRemove\x12\x19.grpcapi.PortGroupRequest\x1a\x16.grpcapi.EmptyResponse\x12\x44\n\x0fPortGroupDelete\x12\x19.grpcapi.PortGroupRequest\x1a\x16.grpcapi.EmptyResponse\x12\x32\n\x07\x41ggrGet\x12\x14.grpcapi.AggrRequest\x1a\x11.grpcapi.AggrInfo\x12/\n\x06JobGet\x12\x13.grpcapi.JobRequest\x1a\x10.grpcapi.JobInfo\x12\x38\n\x08WatchJob\x12\x18.grpcapi.WatchJobRequest\x1a\x10.grpcapi.JobInfo0\x01\x12\x32\n\x07VlanGet\x12\x14.grpcapi.VlanRequest\x1a\x11.grpcapi.VlanInfo\x12:\n\nVlanCreate\x12\x14.grpcapi.VlanRequest\x1a\x16.grpcapi.EmptyResponse\x12:\n\nVlanDelete\x12\x14.grpcapi.VlanRequest\x1a\x16.grpcapi.EmptyResponse\x12;\n\nIPSpaceGet\x12\x17.grpcapi.IPSpaceRequest\x1a\x14.grpcapi.IPSpaceInfo\x12>\n\rIPSpaceCreate\x12\x17.grpcapi.IPSpaceRequest\x1a\x14.grpcapi.IPSpaceInfo\x12@\n\rIPSpaceUpdate\x12\x17.grpcapi.IPSpaceRequest\x1a\x16.grpcapi.EmptyResponse\x12@\n\rIPSpaceDelete\x12\x17.grpcapi.IPSpaceRequest\x1a\x16.grpcapi.EmptyResponse\x12>\n\x0b\x42\x63\x44omainGet\x12\x18.grpcapi.BcDomainRequest\x1a\x15.grpcapi.BcDomainInfo\x12\x41\n\x0e\x42\x63\x44omainStatus\x12\x18.grpcapi.BcDomainRequest\x1a\x15.grpcapi.BcDomainInfo\x12\x41\n\x0e\x42\x63\x44omainCreate\x12\x18.grpcapi.BcDomainRequest\x1a\x15.grpcapi.BcDomainInfo\x12\x42\n\x0e\x42\x63\x44omainRename\x12\x18.grpcapi.BcDomainRequest\x1a\x16.grpcapi.EmptyResponse\x12\x42\n\x0f\x42\x63\x44omainPortAdd\x12\x18.grpcapi.BcDomainRequest\x1a\x15.grpcapi.BcDomainInfo\x12\x45\n\x12\x42\x63\x44omainPortRemove\x12\x18.grpcapi.BcDomainRequest\x1a\x15.grpcapi.BcDomainInfo\x12\x41\n\x0e\x42\x63\x44omainUpdate\x12\x18.grpcapi.BcDomainRequest\x1a\x15.grpcapi.BcDomainInfo\x12\x41\n\x0e\x42\x63\x44omainDelete\x12\x18.grpcapi.BcDomainRequest\x1a\x15.grpcapi.BcDomainInfo\x12\x38\n\tSubnetGet\x12\x16.grpcapi.SubnetRequest\x1a\x13.grpcapi.SubnetInfo\x12;\n\x0cSubnetCreate\x12\x16.grpcapi.SubnetRequest\x1a\x13.grpcapi.SubnetInfo\x12>\n\x0cSubnetDelete\x12\x16.grpcapi.SubnetRequest\x1a\x16.grpcapi.EmptyResponse\x12>\n\x0cSubnetRename\x12\x16.grpcapi.SubnetRequest\x1a\x16.grpcapi.EmptyResponse\x12\x42\n\x10SubnetIPRangeAdd\x12\x16.grpcapi.SubnetRequest\x1a\x16.grpcapi.EmptyResponse\x12\x45\n\x13SubnetIPRangeRemove\x12\x16.grpcapi.SubnetRequest\x1a\x16.grpcapi.EmptyResponse\x12>\n\x0cSubnetModify\x12\x16.grpcapi.SubnetRequest\x1a\x16.grpcapi.EmptyResponse\x12/\n\x06SvmGet\x12\x13.grpcapi.SvmRequest\x1a\x10.grpcapi.SvmInfo\x12\x37\n\tSvmCreate\x12\x13.grpcapi.SvmRequest\x1a\x15.grpcapi.SvmJobResult\x12\x37\n\tSvmDelete\x12\x13.grpcapi.SvmRequest\x1a\x15.grpcapi.SvmJobResult\x12\x37\n\x08SvmStart\x12\x13.grpcapi.SvmRequest\x1a\x16.grpcapi.EmptyResponse\x12\x36\n\x07SvmStop\x12\x13.grpcapi.SvmRequest\x1a\x16.grpcapi.EmptyResponse\x12\x38\n\tSvmUnlock\x12\x13.grpcapi.SvmRequest\x1a\x16.grpcapi.EmptyResponse\x12\x38\n\tSvmRename\x12\x13.grpcapi.SvmRequest\x1a\x16.grpcapi.EmptyResponse\x12>\n\x0cVolumeOnline\x12\x16.grpcapi.VolumeRequest\x1a\x16.grpcapi.EmptyResponse\x12?\n\rVolumeOffline\x12\x16.grpcapi.VolumeRequest\x1a\x16.grpcapi.EmptyResponse\x12@\n\x0eVolumeRestrict\x12\x16.grpcapi.VolumeRequest\x1a\x16.grpcapi.EmptyResponse\x12>\n\x0cVolumeDelete\x12\x16.grpcapi.VolumeRequest\x1a\x16.grpcapi.EmptyResponse\x12\x39\n\nVolumeSize\x12\x16.grpcapi.VolumeRequest\x1a\x13.grpcapi.VolumeInfob\x06proto3')
 )
 
 
@@ -91,6 +91,13 @@ _CALLRESPONSE = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='errno', full_name='grpcapi.CallResponse.errno', index=3,
+      number=4, type=5, cpp_type=1, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
   ],
   extensions=[
   ],
@@ -104,7 +111,69 @@ _CALLRESPONSE = _descriptor.Descriptor(
   oneofs=[
   ],
   serialized_start=68,
-  serialized_end=129,
+  serialized_end=144,
+)
+
+
+_BATCHREQUEST = _descriptor.Descriptor(
+  name='BatchRequest',
+  full_name='grpcapi.BatchRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='calls', full_name='grpcapi.BatchRequest.calls', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=146,
+  serialized_end=197,
+)
+
+
+_BATCHRESPONSE = _descriptor.Descriptor(
+  name='BatchResponse',
+  full_name='grpcapi.BatchResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='results', full_name='grpcapi.BatchResponse.results', index=0,
+      number=1, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=199,
+  serialized_end=254,
 )
 
 
@@ -134,8 +203,8 @@ _SHUTDOWNREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=131,
-  serialized_end=166,
+  serialized_start=256,
+  serialized_end=291,
 )
 
 
@@ -165,71 +234,2934 @@ _SHUTDOWNRESPONSE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=168,
-  serialized_end=202,
+  serialized_start=293,
+  serialized_end=327,
 )
 
-DESCRIPTOR.message_types_by_name['CallRequest'] = _CALLREQUEST
-DESCRIPTOR.message_types_by_name['CallResponse'] = _CALLRESPONSE
-DESCRIPTOR.message_types_by_name['ShutdownRequest'] = _SHUTDOWNREQUEST
-DESCRIPTOR.message_types_by_name['ShutdownResponse'] = _SHUTDOWNRESPONSE
-_sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
-CallRequest = _reflection.GeneratedProtocolMessageType('CallRequest', (_message.Message,), dict(
-  DESCRIPTOR = _CALLREQUEST,
-  __module__ = 'grpcapi_pb2'
-  # @@protoc_insertion_point(class_scope:grpcapi.CallRequest)
-  ))
-_sym_db.RegisterMessage(CallRequest)
+_VERSIONREQUEST = _descriptor.Descriptor(
+  name='VersionRequest',
+  full_name='grpcapi.VersionRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=329,
+  serialized_end=345,
+)
 
-CallResponse = _reflection.GeneratedProtocolMessageType('CallResponse', (_message.Message,), dict(
-  DESCRIPTOR = _CALLRESPONSE,
-  __module__ = 'grpcapi_pb2'
-  # @@protoc_insertion_point(class_scope:grpcapi.CallResponse)
-  ))
-_sym_db.RegisterMessage(CallResponse)
 
-ShutdownRequest = _reflection.GeneratedProtocolMessageType('ShutdownRequest', (_message.Message,), dict(
-  DESCRIPTOR = _SHUTDOWNREQUEST,
-  __module__ = 'grpcapi_pb2'
-  # @@protoc_insertion_point(class_scope:grpcapi.ShutdownRequest)
-  ))
-_sym_db.RegisterMessage(ShutdownRequest)
+_VERSIONRESPONSE = _descriptor.Descriptor(
+  name='VersionResponse',
+  full_name='grpcapi.VersionResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='build', full_name='grpcapi.VersionResponse.build', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='commands', full_name='grpcapi.VersionResponse.commands', index=1,
+      number=2, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=347,
+  serialized_end=397,
+)
+
+
+_EMPTYRESPONSE = _descriptor.Descriptor(
+  name='EmptyResponse',
+  full_name='grpcapi.EmptyResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='non_exist', full_name='grpcapi.EmptyResponse.non_exist', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='dummy', full_name='grpcapi.EmptyResponse.dummy', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=399,
+  serialized_end=448,
+)
 
-ShutdownResponse = _reflection.GeneratedProtocolMessageType('ShutdownResponse', (_message.Message,), dict(
-  DESCRIPTOR = _SHUTDOWNRESPONSE,
-  __module__ = 'grpcapi_pb2'
-  # @@protoc_insertion_point(class_scope:grpcapi.ShutdownResponse)
-  ))
-_sym_db.RegisterMessage(ShutdownResponse)
 
+_LOGREQUEST = _descriptor.Descriptor(
+  name='LogRequest',
+  full_name='grpcapi.LogRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='level', full_name='grpcapi.LogRequest.level', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=450,
+  serialized_end=477,
+)
 
 
-_GRPCNETAPPAPI = _descriptor.ServiceDescriptor(
-  name='GRPCNetAppApi',
-  full_name='grpcapi.GRPCNetAppApi',
+_LOGRECORD = _descriptor.Descriptor(
+  name='LogRecord',
+  full_name='grpcapi.LogRecord',
+  filename=None,
   file=DESCRIPTOR,
-  index=0,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='level', full_name='grpcapi.LogRecord.level', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='name', full_name='grpcapi.LogRecord.name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='message', full_name='grpcapi.LogRecord.message', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='session', full_name='grpcapi.LogRecord.session', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
   serialized_options=None,
-  serialized_start=205,
-  serialized_end=338,
-  methods=[
-  _descriptor.MethodDescriptor(
-    name='Call',
-    full_name='grpcapi.GRPCNetAppApi.Call',
-    index=0,
-    containing_service=None,
-    input_type=_CALLREQUEST,
-    output_type=_CALLRESPONSE,
-    serialized_options=None,
-  ),
-  _descriptor.MethodDescriptor(
-    name='Shutdown',
-    full_name='grpcapi.GRPCNetAppApi.Shutdown',
-    index=1,
-    containing_service=None,
-    input_type=_SHUTDOWNREQUEST,
-    output_type=_SHUTDOWNRESPONSE,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=479,
+  serialized_end=553,
+)
+
+
+_NODEREQUEST = _descriptor.Descriptor(
+  name='NodeRequest',
+  full_name='grpcapi.NodeRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='grpcapi.NodeRequest.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='uuid', full_name='grpcapi.NodeRequest.uuid', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=555,
+  serialized_end=596,
+)
+
+
+_NODEINFO = _descriptor.Descriptor(
+  name='NodeInfo',
+  full_name='grpcapi.NodeInfo',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='non_exist', full_name='grpcapi.NodeInfo.non_exist', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='name', full_name='grpcapi.NodeInfo.name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='serial', full_name='grpcapi.NodeInfo.serial', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='id', full_name='grpcapi.NodeInfo.id', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='uuid', full_name='grpcapi.NodeInfo.uuid', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='version', full_name='grpcapi.NodeInfo.version', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='healthy', full_name='grpcapi.NodeInfo.healthy', index=6,
+      number=7, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='uptime', full_name='grpcapi.NodeInfo.uptime', index=7,
+      number=8, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=599,
+  serialized_end=734,
+)
+
+
+_PORTREQUEST = _descriptor.Descriptor(
+  name='PortRequest',
+  full_name='grpcapi.PortRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='node', full_name='grpcapi.PortRequest.node', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='port', full_name='grpcapi.PortRequest.port', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=736,
+  serialized_end=777,
+)
+
+
+_PORTINFO = _descriptor.Descriptor(
+  name='PortInfo',
+  full_name='grpcapi.PortInfo',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='non_exist', full_name='grpcapi.PortInfo.non_exist', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='node', full_name='grpcapi.PortInfo.node', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='port', full_name='grpcapi.PortInfo.port', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='auto_rev_delay', full_name='grpcapi.PortInfo.auto_rev_delay', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ignr_health', full_name='grpcapi.PortInfo.ignr_health', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ipspace', full_name='grpcapi.PortInfo.ipspace', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='role', full_name='grpcapi.PortInfo.role', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='admin_up', full_name='grpcapi.PortInfo.admin_up', index=7,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='admin_mtu', full_name='grpcapi.PortInfo.admin_mtu', index=8,
+      number=9, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='admin_auto', full_name='grpcapi.PortInfo.admin_auto', index=9,
+      number=10, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='admin_speed', full_name='grpcapi.PortInfo.admin_speed', index=10,
+      number=11, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='admin_duplex', full_name='grpcapi.PortInfo.admin_duplex', index=11,
+      number=12, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='admin_flow', full_name='grpcapi.PortInfo.admin_flow', index=12,
+      number=13, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='status', full_name='grpcapi.PortInfo.status', index=13,
+      number=14, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='health', full_name='grpcapi.PortInfo.health', index=14,
+      number=15, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='mac', full_name='grpcapi.PortInfo.mac', index=15,
+      number=16, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='broadcast_domain', full_name='grpcapi.PortInfo.broadcast_domain', index=16,
+      number=17, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='mtu', full_name='grpcapi.PortInfo.mtu', index=17,
+      number=18, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='auto', full_name='grpcapi.PortInfo.auto', index=18,
+      number=19, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='speed', full_name='grpcapi.PortInfo.speed', index=19,
+      number=20, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='duplex', full_name='grpcapi.PortInfo.duplex', index=20,
+      number=21, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='flow', full_name='grpcapi.PortInfo.flow', index=21,
+      number=22, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='type', full_name='grpcapi.PortInfo.type', index=22,
+      number=23, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='vlan_id', full_name='grpcapi.PortInfo.vlan_id', index=23,
+      number=24, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='vlan_node', full_name='grpcapi.PortInfo.vlan_node', index=24,
+      number=25, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='vlan_port', full_name='grpcapi.PortInfo.vlan_port', index=25,
+      number=26, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=780,
+  serialized_end=1245,
+)
+
+
+_PORTMODIFYREQUEST = _descriptor.Descriptor(
+  name='PortModifyRequest',
+  full_name='grpcapi.PortModifyRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='node', full_name='grpcapi.PortModifyRequest.node', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='port', full_name='grpcapi.PortModifyRequest.port', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='up', full_name='grpcapi.PortModifyRequest.up', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='mtu', full_name='grpcapi.PortModifyRequest.mtu', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='auto', full_name='grpcapi.PortModifyRequest.auto', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='duplex', full_name='grpcapi.PortModifyRequest.duplex', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='flow', full_name='grpcapi.PortModifyRequest.flow', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='speed', full_name='grpcapi.PortModifyRequest.speed', index=7,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='auto_rev_delay', full_name='grpcapi.PortModifyRequest.auto_rev_delay', index=8,
+      number=9, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ignr_health', full_name='grpcapi.PortModifyRequest.ignr_health', index=9,
+      number=10, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ipspace', full_name='grpcapi.PortModifyRequest.ipspace', index=10,
+      number=11, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='role', full_name='grpcapi.PortModifyRequest.role', index=11,
+      number=12, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1248,
+  serialized_end=1455,
+)
+
+
+_PORTFINDRESPONSE = _descriptor.Descriptor(
+  name='PortFindResponse',
+  full_name='grpcapi.PortFindResponse',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='non_exist', full_name='grpcapi.PortFindResponse.non_exist', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ports', full_name='grpcapi.PortFindResponse.ports', index=1,
+      number=2, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1457,
+  serialized_end=1509,
+)
+
+
+_PORTGROUPREQUEST = _descriptor.Descriptor(
+  name='PortGroupRequest',
+  full_name='grpcapi.PortGroupRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='node', full_name='grpcapi.PortGroupRequest.node', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='name', full_name='grpcapi.PortGroupRequest.name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='mode', full_name='grpcapi.PortGroupRequest.mode', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='dist', full_name='grpcapi.PortGroupRequest.dist', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ports', full_name='grpcapi.PortGroupRequest.ports', index=4,
+      number=5, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1511,
+  serialized_end=1600,
+)
+
+
+_PORTGROUPINFO = _descriptor.Descriptor(
+  name='PortGroupInfo',
+  full_name='grpcapi.PortGroupInfo',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='non_exist', full_name='grpcapi.PortGroupInfo.non_exist', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='node', full_name='grpcapi.PortGroupInfo.node', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='name', full_name='grpcapi.PortGroupInfo.name', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='mode', full_name='grpcapi.PortGroupInfo.mode', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='dist', full_name='grpcapi.PortGroupInfo.dist', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ports', full_name='grpcapi.PortGroupInfo.ports', index=5,
+      number=6, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='part', full_name='grpcapi.PortGroupInfo.part', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ports_down', full_name='grpcapi.PortGroupInfo.ports_down', index=7,
+      number=8, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ports_up', full_name='grpcapi.PortGroupInfo.ports_up', index=8,
+      number=9, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1603,
+  serialized_end=1760,
+)
+
+
+_AGGRREQUEST = _descriptor.Descriptor(
+  name='AggrRequest',
+  full_name='grpcapi.AggrRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='grpcapi.AggrRequest.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='uuid', full_name='grpcapi.AggrRequest.uuid', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='nodes', full_name='grpcapi.AggrRequest.nodes', index=2,
+      number=3, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1762,
+  serialized_end=1818,
+)
+
+
+_AGGRINFO = _descriptor.Descriptor(
+  name='AggrInfo',
+  full_name='grpcapi.AggrInfo',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='non_exist', full_name='grpcapi.AggrInfo.non_exist', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='name', full_name='grpcapi.AggrInfo.name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='uuid', full_name='grpcapi.AggrInfo.uuid', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='nodes', full_name='grpcapi.AggrInfo.nodes', index=3,
+      number=4, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='flexvol_cnt', full_name='grpcapi.AggrInfo.flexvol_cnt', index=4,
+      number=5, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='pct_used_cap', full_name='grpcapi.AggrInfo.pct_used_cap', index=5,
+      number=6, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='pct_used_phys', full_name='grpcapi.AggrInfo.pct_used_phys', index=6,
+      number=7, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='size_total', full_name='grpcapi.AggrInfo.size_total', index=7,
+      number=8, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='size_used', full_name='grpcapi.AggrInfo.size_used', index=8,
+      number=9, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='size_avail', full_name='grpcapi.AggrInfo.size_avail', index=9,
+      number=10, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='size_reserve', full_name='grpcapi.AggrInfo.size_reserve', index=10,
+      number=11, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=1821,
+  serialized_end=2040,
+)
+
+
+_JOBREQUEST = _descriptor.Descriptor(
+  name='JobRequest',
+  full_name='grpcapi.JobRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='id', full_name='grpcapi.JobRequest.id', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='svm', full_name='grpcapi.JobRequest.svm', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2042,
+  serialized_end=2079,
+)
+
+
+_JOBINFO = _descriptor.Descriptor(
+  name='JobInfo',
+  full_name='grpcapi.JobInfo',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='non_exist', full_name='grpcapi.JobInfo.non_exist', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='id', full_name='grpcapi.JobInfo.id', index=1,
+      number=2, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='svm', full_name='grpcapi.JobInfo.svm', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='msg', full_name='grpcapi.JobInfo.msg', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='status', full_name='grpcapi.JobInfo.status', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='errno', full_name='grpcapi.JobInfo.errno', index=5,
+      number=6, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='progress', full_name='grpcapi.JobInfo.progress', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2081,
+  serialized_end=2196,
+)
+
+
+_WATCHJOBREQUEST = _descriptor.Descriptor(
+  name='WatchJobRequest',
+  full_name='grpcapi.WatchJobRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='id', full_name='grpcapi.WatchJobRequest.id', index=0,
+      number=1, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='svm', full_name='grpcapi.WatchJobRequest.svm', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2198,
+  serialized_end=2240,
+)
+
+
+_VLANREQUEST = _descriptor.Descriptor(
+  name='VlanRequest',
+  full_name='grpcapi.VlanRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='node_name', full_name='grpcapi.VlanRequest.node_name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='parent_name', full_name='grpcapi.VlanRequest.parent_name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='vlan_id', full_name='grpcapi.VlanRequest.vlan_id', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2242,
+  serialized_end=2312,
+)
+
+
+_VLANINFO = _descriptor.Descriptor(
+  name='VlanInfo',
+  full_name='grpcapi.VlanInfo',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='non_exist', full_name='grpcapi.VlanInfo.non_exist', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='node_name', full_name='grpcapi.VlanInfo.node_name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='parent_name', full_name='grpcapi.VlanInfo.parent_name', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='vlan_id', full_name='grpcapi.VlanInfo.vlan_id', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='name', full_name='grpcapi.VlanInfo.name', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2314,
+  serialized_end=2414,
+)
+
+
+_IPSPACEREQUEST = _descriptor.Descriptor(
+  name='IPSpaceRequest',
+  full_name='grpcapi.IPSpaceRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='grpcapi.IPSpaceRequest.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='uuid', full_name='grpcapi.IPSpaceRequest.uuid', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='new_name', full_name='grpcapi.IPSpaceRequest.new_name', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2416,
+  serialized_end=2478,
+)
+
+
+_IPSPACEINFO = _descriptor.Descriptor(
+  name='IPSpaceInfo',
+  full_name='grpcapi.IPSpaceInfo',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='non_exist', full_name='grpcapi.IPSpaceInfo.non_exist', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='name', full_name='grpcapi.IPSpaceInfo.name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='uuid', full_name='grpcapi.IPSpaceInfo.uuid', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='bc_domains', full_name='grpcapi.IPSpaceInfo.bc_domains', index=3,
+      number=4, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ports', full_name='grpcapi.IPSpaceInfo.ports', index=4,
+      number=5, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='vservers', full_name='grpcapi.IPSpaceInfo.vservers', index=5,
+      number=6, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2480,
+  serialized_end=2593,
+)
+
+
+_BCDOMAINREQUEST = _descriptor.Descriptor(
+  name='BcDomainRequest',
+  full_name='grpcapi.BcDomainRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='grpcapi.BcDomainRequest.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='new_name', full_name='grpcapi.BcDomainRequest.new_name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='mtu', full_name='grpcapi.BcDomainRequest.mtu', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ipspace', full_name='grpcapi.BcDomainRequest.ipspace', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ports', full_name='grpcapi.BcDomainRequest.ports', index=4,
+      number=5, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='statusonly', full_name='grpcapi.BcDomainRequest.statusonly', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2595,
+  serialized_end=2709,
+)
+
+
+_BCDOMAINPORTINFO = _descriptor.Descriptor(
+  name='BcDomainPortInfo',
+  full_name='grpcapi.BcDomainPortInfo',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='grpcapi.BcDomainPortInfo.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='update_status', full_name='grpcapi.BcDomainPortInfo.update_status', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='status_detail', full_name='grpcapi.BcDomainPortInfo.status_detail', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2711,
+  serialized_end=2789,
+)
+
+
+_BCDOMAININFO = _descriptor.Descriptor(
+  name='BcDomainInfo',
+  full_name='grpcapi.BcDomainInfo',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='non_exist', full_name='grpcapi.BcDomainInfo.non_exist', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='name', full_name='grpcapi.BcDomainInfo.name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='failovergrps', full_name='grpcapi.BcDomainInfo.failovergrps', index=2,
+      number=3, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ipspace', full_name='grpcapi.BcDomainInfo.ipspace', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='mtu', full_name='grpcapi.BcDomainInfo.mtu', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='update_status', full_name='grpcapi.BcDomainInfo.update_status', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ports', full_name='grpcapi.BcDomainInfo.ports', index=6,
+      number=7, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='subnets', full_name='grpcapi.BcDomainInfo.subnets', index=7,
+      number=8, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2792,
+  serialized_end=2973,
+)
+
+
+_SUBNETREQUEST = _descriptor.Descriptor(
+  name='SubnetRequest',
+  full_name='grpcapi.SubnetRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='grpcapi.SubnetRequest.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='new_name', full_name='grpcapi.SubnetRequest.new_name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='bc_domain', full_name='grpcapi.SubnetRequest.bc_domain', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ipspace', full_name='grpcapi.SubnetRequest.ipspace', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='subnet', full_name='grpcapi.SubnetRequest.subnet', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='gateway', full_name='grpcapi.SubnetRequest.gateway', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ip_ranges', full_name='grpcapi.SubnetRequest.ip_ranges', index=6,
+      number=7, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=2976,
+  serialized_end=3111,
+)
+
+
+_SUBNETINFO = _descriptor.Descriptor(
+  name='SubnetInfo',
+  full_name='grpcapi.SubnetInfo',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='non_exist', full_name='grpcapi.SubnetInfo.non_exist', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='name', full_name='grpcapi.SubnetInfo.name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='bc_domain', full_name='grpcapi.SubnetInfo.bc_domain', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ipspace', full_name='grpcapi.SubnetInfo.ipspace', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='subnet', full_name='grpcapi.SubnetInfo.subnet', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='gateway', full_name='grpcapi.SubnetInfo.gateway', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ip_ranges', full_name='grpcapi.SubnetInfo.ip_ranges', index=6,
+      number=7, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ip_count', full_name='grpcapi.SubnetInfo.ip_count', index=7,
+      number=8, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ip_used', full_name='grpcapi.SubnetInfo.ip_used', index=8,
+      number=9, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ip_avail', full_name='grpcapi.SubnetInfo.ip_avail', index=9,
+      number=10, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3114,
+  serialized_end=3300,
+)
+
+
+_SVMREQUEST = _descriptor.Descriptor(
+  name='SvmRequest',
+  full_name='grpcapi.SvmRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='name', full_name='grpcapi.SvmRequest.name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='new_name', full_name='grpcapi.SvmRequest.new_name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='force', full_name='grpcapi.SvmRequest.force', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='uuid', full_name='grpcapi.SvmRequest.uuid', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ipspace', full_name='grpcapi.SvmRequest.ipspace', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='root_aggr', full_name='grpcapi.SvmRequest.root_aggr', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='root_sec_style', full_name='grpcapi.SvmRequest.root_sec_style', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='root_name', full_name='grpcapi.SvmRequest.root_name', index=7,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='root_retent', full_name='grpcapi.SvmRequest.root_retent', index=8,
+      number=9, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3303,
+  serialized_end=3476,
+)
+
+
+_SVMINFO = _descriptor.Descriptor(
+  name='SvmInfo',
+  full_name='grpcapi.SvmInfo',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='non_exist', full_name='grpcapi.SvmInfo.non_exist', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='name', full_name='grpcapi.SvmInfo.name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='uuid', full_name='grpcapi.SvmInfo.uuid', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ipspace', full_name='grpcapi.SvmInfo.ipspace', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='root_aggr', full_name='grpcapi.SvmInfo.root_aggr', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='root_sec_style', full_name='grpcapi.SvmInfo.root_sec_style', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='root_name', full_name='grpcapi.SvmInfo.root_name', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='root_retent', full_name='grpcapi.SvmInfo.root_retent', index=7,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='locked', full_name='grpcapi.SvmInfo.locked', index=8,
+      number=9, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='oper_state', full_name='grpcapi.SvmInfo.oper_state', index=9,
+      number=10, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='svm_state', full_name='grpcapi.SvmInfo.svm_state', index=10,
+      number=11, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='proto_enabled', full_name='grpcapi.SvmInfo.proto_enabled', index=11,
+      number=12, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='proto_inactive', full_name='grpcapi.SvmInfo.proto_inactive', index=12,
+      number=13, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3479,
+  serialized_end=3737,
+)
+
+
+_SVMJOBRESULT = _descriptor.Descriptor(
+  name='SvmJobResult',
+  full_name='grpcapi.SvmJobResult',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='non_exist', full_name='grpcapi.SvmJobResult.non_exist', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='name', full_name='grpcapi.SvmJobResult.name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='uuid', full_name='grpcapi.SvmJobResult.uuid', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='ipspace', full_name='grpcapi.SvmJobResult.ipspace', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='root_aggr', full_name='grpcapi.SvmJobResult.root_aggr', index=4,
+      number=5, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='root_sec_style', full_name='grpcapi.SvmJobResult.root_sec_style', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='root_name', full_name='grpcapi.SvmJobResult.root_name', index=6,
+      number=7, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='root_retent', full_name='grpcapi.SvmJobResult.root_retent', index=7,
+      number=8, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='locked', full_name='grpcapi.SvmJobResult.locked', index=8,
+      number=9, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='oper_state', full_name='grpcapi.SvmJobResult.oper_state', index=9,
+      number=10, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='svm_state', full_name='grpcapi.SvmJobResult.svm_state', index=10,
+      number=11, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='proto_enabled', full_name='grpcapi.SvmJobResult.proto_enabled', index=11,
+      number=12, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='proto_inactive', full_name='grpcapi.SvmJobResult.proto_inactive', index=12,
+      number=13, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='status', full_name='grpcapi.SvmJobResult.status', index=13,
+      number=14, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='jobid', full_name='grpcapi.SvmJobResult.jobid', index=14,
+      number=15, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='errno', full_name='grpcapi.SvmJobResult.errno', index=15,
+      number=16, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='errmsg', full_name='grpcapi.SvmJobResult.errmsg', index=16,
+      number=17, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=3740,
+  serialized_end=4065,
+)
+
+
+_VOLUMEREQUEST = _descriptor.Descriptor(
+  name='VolumeRequest',
+  full_name='grpcapi.VolumeRequest',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='svm_name', full_name='grpcapi.VolumeRequest.svm_name', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='name', full_name='grpcapi.VolumeRequest.name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='size', full_name='grpcapi.VolumeRequest.size', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4067,
+  serialized_end=4128,
+)
+
+
+_VOLUMEINFO = _descriptor.Descriptor(
+  name='VolumeInfo',
+  full_name='grpcapi.VolumeInfo',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='non_exist', full_name='grpcapi.VolumeInfo.non_exist', index=0,
+      number=1, type=8, cpp_type=7, label=1,
+      has_default_value=False, default_value=False,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='svm_name', full_name='grpcapi.VolumeInfo.svm_name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='name', full_name='grpcapi.VolumeInfo.name', index=2,
+      number=3, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+    _descriptor.FieldDescriptor(
+      name='size', full_name='grpcapi.VolumeInfo.size', index=3,
+      number=4, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=_b("").decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=4130,
+  serialized_end=4207,
+)
+
+_BATCHREQUEST.fields_by_name['calls'].message_type = _CALLREQUEST
+_BATCHRESPONSE.fields_by_name['results'].message_type = _CALLRESPONSE
+_BCDOMAININFO.fields_by_name['ports'].message_type = _BCDOMAINPORTINFO
+DESCRIPTOR.message_types_by_name['CallRequest'] = _CALLREQUEST
+DESCRIPTOR.message_types_by_name['CallResponse'] = _CALLRESPONSE
+DESCRIPTOR.message_types_by_name['BatchRequest'] = _BATCHREQUEST
+DESCRIPTOR.message_types_by_name['BatchResponse'] = _BATCHRESPONSE
+DESCRIPTOR.message_types_by_name['ShutdownRequest'] = _SHUTDOWNREQUEST
+DESCRIPTOR.message_types_by_name['ShutdownResponse'] = _SHUTDOWNRESPONSE
+DESCRIPTOR.message_types_by_name['VersionRequest'] = _VERSIONREQUEST
+DESCRIPTOR.message_types_by_name['VersionResponse'] = _VERSIONRESPONSE
+DESCRIPTOR.message_types_by_name['EmptyResponse'] = _EMPTYRESPONSE
+DESCRIPTOR.message_types_by_name['LogRequest'] = _LOGREQUEST
+DESCRIPTOR.message_types_by_name['LogRecord'] = _LOGRECORD
+DESCRIPTOR.message_types_by_name['NodeRequest'] = _NODEREQUEST
+DESCRIPTOR.message_types_by_name['NodeInfo'] = _NODEINFO
+DESCRIPTOR.message_types_by_name['PortRequest'] = _PORTREQUEST
+DESCRIPTOR.message_types_by_name['PortInfo'] = _PORTINFO
+DESCRIPTOR.message_types_by_name['PortModifyRequest'] = _PORTMODIFYREQUEST
+DESCRIPTOR.message_types_by_name['PortFindResponse'] = _PORTFINDRESPONSE
+DESCRIPTOR.message_types_by_name['PortGroupRequest'] = _PORTGROUPREQUEST
+DESCRIPTOR.message_types_by_name['PortGroupInfo'] = _PORTGROUPINFO
+DESCRIPTOR.message_types_by_name['AggrRequest'] = _AGGRREQUEST
+DESCRIPTOR.message_types_by_name['AggrInfo'] = _AGGRINFO
+DESCRIPTOR.message_types_by_name['JobRequest'] = _JOBREQUEST
+DESCRIPTOR.message_types_by_name['JobInfo'] = _JOBINFO
+DESCRIPTOR.message_types_by_name['WatchJobRequest'] = _WATCHJOBREQUEST
+DESCRIPTOR.message_types_by_name['VlanRequest'] = _VLANREQUEST
+DESCRIPTOR.message_types_by_name['VlanInfo'] = _VLANINFO
+DESCRIPTOR.message_types_by_name['IPSpaceRequest'] = _IPSPACEREQUEST
+DESCRIPTOR.message_types_by_name['IPSpaceInfo'] = _IPSPACEINFO
+DESCRIPTOR.message_types_by_name['BcDomainRequest'] = _BCDOMAINREQUEST
+DESCRIPTOR.message_types_by_name['BcDomainPortInfo'] = _BCDOMAINPORTINFO
+DESCRIPTOR.message_types_by_name['BcDomainInfo'] = _BCDOMAININFO
+DESCRIPTOR.message_types_by_name['SubnetRequest'] = _SUBNETREQUEST
+DESCRIPTOR.message_types_by_name['SubnetInfo'] = _SUBNETINFO
+DESCRIPTOR.message_types_by_name['SvmRequest'] = _SVMREQUEST
+DESCRIPTOR.message_types_by_name['SvmInfo'] = _SVMINFO
+DESCRIPTOR.message_types_by_name['SvmJobResult'] = _SVMJOBRESULT
+DESCRIPTOR.message_types_by_name['VolumeRequest'] = _VOLUMEREQUEST
+DESCRIPTOR.message_types_by_name['VolumeInfo'] = _VOLUMEINFO
+_sym_db.RegisterFileDescriptor(DESCRIPTOR)
+
+CallRequest = _reflection.GeneratedProtocolMessageType('CallRequest', (_message.Message,), dict(
+  DESCRIPTOR = _CALLREQUEST,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.CallRequest)
+  ))
+_sym_db.RegisterMessage(CallRequest)
+
+CallResponse = _reflection.GeneratedProtocolMessageType('CallResponse', (_message.Message,), dict(
+  DESCRIPTOR = _CALLRESPONSE,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.CallResponse)
+  ))
+_sym_db.RegisterMessage(CallResponse)
+
+BatchRequest = _reflection.GeneratedProtocolMessageType('BatchRequest', (_message.Message,), dict(
+  DESCRIPTOR = _BATCHREQUEST,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.BatchRequest)
+  ))
+_sym_db.RegisterMessage(BatchRequest)
+
+BatchResponse = _reflection.GeneratedProtocolMessageType('BatchResponse', (_message.Message,), dict(
+  DESCRIPTOR = _BATCHRESPONSE,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.BatchResponse)
+  ))
+_sym_db.RegisterMessage(BatchResponse)
+
+ShutdownRequest = _reflection.GeneratedProtocolMessageType('ShutdownRequest', (_message.Message,), dict(
+  DESCRIPTOR = _SHUTDOWNREQUEST,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.ShutdownRequest)
+  ))
+_sym_db.RegisterMessage(ShutdownRequest)
+
+ShutdownResponse = _reflection.GeneratedProtocolMessageType('ShutdownResponse', (_message.Message,), dict(
+  DESCRIPTOR = _SHUTDOWNRESPONSE,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.ShutdownResponse)
+  ))
+_sym_db.RegisterMessage(ShutdownResponse)
+
+VersionRequest = _reflection.GeneratedProtocolMessageType('VersionRequest', (_message.Message,), dict(
+  DESCRIPTOR = _VERSIONREQUEST,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.VersionRequest)
+  ))
+_sym_db.RegisterMessage(VersionRequest)
+
+VersionResponse = _reflection.GeneratedProtocolMessageType('VersionResponse', (_message.Message,), dict(
+  DESCRIPTOR = _VERSIONRESPONSE,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.VersionResponse)
+  ))
+_sym_db.RegisterMessage(VersionResponse)
+
+EmptyResponse = _reflection.GeneratedProtocolMessageType('EmptyResponse', (_message.Message,), dict(
+  DESCRIPTOR = _EMPTYRESPONSE,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.EmptyResponse)
+  ))
+_sym_db.RegisterMessage(EmptyResponse)
+
+LogRequest = _reflection.GeneratedProtocolMessageType('LogRequest', (_message.Message,), dict(
+  DESCRIPTOR = _LOGREQUEST,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.LogRequest)
+  ))
+_sym_db.RegisterMessage(LogRequest)
+
+LogRecord = _reflection.GeneratedProtocolMessageType('LogRecord', (_message.Message,), dict(
+  DESCRIPTOR = _LOGRECORD,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.LogRecord)
+  ))
+_sym_db.RegisterMessage(LogRecord)
+
+NodeRequest = _reflection.GeneratedProtocolMessageType('NodeRequest', (_message.Message,), dict(
+  DESCRIPTOR = _NODEREQUEST,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.NodeRequest)
+  ))
+_sym_db.RegisterMessage(NodeRequest)
+
+NodeInfo = _reflection.GeneratedProtocolMessageType('NodeInfo', (_message.Message,), dict(
+  DESCRIPTOR = _NODEINFO,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.NodeInfo)
+  ))
+_sym_db.RegisterMessage(NodeInfo)
+
+PortRequest = _reflection.GeneratedProtocolMessageType('PortRequest', (_message.Message,), dict(
+  DESCRIPTOR = _PORTREQUEST,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.PortRequest)
+  ))
+_sym_db.RegisterMessage(PortRequest)
+
+PortInfo = _reflection.GeneratedProtocolMessageType('PortInfo', (_message.Message,), dict(
+  DESCRIPTOR = _PORTINFO,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.PortInfo)
+  ))
+_sym_db.RegisterMessage(PortInfo)
+
+PortModifyRequest = _reflection.GeneratedProtocolMessageType('PortModifyRequest', (_message.Message,), dict(
+  DESCRIPTOR = _PORTMODIFYREQUEST,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.PortModifyRequest)
+  ))
+_sym_db.RegisterMessage(PortModifyRequest)
+
+PortFindResponse = _reflection.GeneratedProtocolMessageType('PortFindResponse', (_message.Message,), dict(
+  DESCRIPTOR = _PORTFINDRESPONSE,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.PortFindResponse)
+  ))
+_sym_db.RegisterMessage(PortFindResponse)
+
+PortGroupRequest = _reflection.GeneratedProtocolMessageType('PortGroupRequest', (_message.Message,), dict(
+  DESCRIPTOR = _PORTGROUPREQUEST,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.PortGroupRequest)
+  ))
+_sym_db.RegisterMessage(PortGroupRequest)
+
+PortGroupInfo = _reflection.GeneratedProtocolMessageType('PortGroupInfo', (_message.Message,), dict(
+  DESCRIPTOR = _PORTGROUPINFO,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.PortGroupInfo)
+  ))
+_sym_db.RegisterMessage(PortGroupInfo)
+
+AggrRequest = _reflection.GeneratedProtocolMessageType('AggrRequest', (_message.Message,), dict(
+  DESCRIPTOR = _AGGRREQUEST,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.AggrRequest)
+  ))
+_sym_db.RegisterMessage(AggrRequest)
+
+AggrInfo = _reflection.GeneratedProtocolMessageType('AggrInfo', (_message.Message,), dict(
+  DESCRIPTOR = _AGGRINFO,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.AggrInfo)
+  ))
+_sym_db.RegisterMessage(AggrInfo)
+
+JobRequest = _reflection.GeneratedProtocolMessageType('JobRequest', (_message.Message,), dict(
+  DESCRIPTOR = _JOBREQUEST,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.JobRequest)
+  ))
+_sym_db.RegisterMessage(JobRequest)
+
+JobInfo = _reflection.GeneratedProtocolMessageType('JobInfo', (_message.Message,), dict(
+  DESCRIPTOR = _JOBINFO,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.JobInfo)
+  ))
+_sym_db.RegisterMessage(JobInfo)
+
+WatchJobRequest = _reflection.GeneratedProtocolMessageType('WatchJobRequest', (_message.Message,), dict(
+  DESCRIPTOR = _WATCHJOBREQUEST,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.WatchJobRequest)
+  ))
+_sym_db.RegisterMessage(WatchJobRequest)
+
+VlanRequest = _reflection.GeneratedProtocolMessageType('VlanRequest', (_message.Message,), dict(
+  DESCRIPTOR = _VLANREQUEST,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.VlanRequest)
+  ))
+_sym_db.RegisterMessage(VlanRequest)
+
+VlanInfo = _reflection.GeneratedProtocolMessageType('VlanInfo', (_message.Message,), dict(
+  DESCRIPTOR = _VLANINFO,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.VlanInfo)
+  ))
+_sym_db.RegisterMessage(VlanInfo)
+
+IPSpaceRequest = _reflection.GeneratedProtocolMessageType('IPSpaceRequest', (_message.Message,), dict(
+  DESCRIPTOR = _IPSPACEREQUEST,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.IPSpaceRequest)
+  ))
+_sym_db.RegisterMessage(IPSpaceRequest)
+
+IPSpaceInfo = _reflection.GeneratedProtocolMessageType('IPSpaceInfo', (_message.Message,), dict(
+  DESCRIPTOR = _IPSPACEINFO,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.IPSpaceInfo)
+  ))
+_sym_db.RegisterMessage(IPSpaceInfo)
+
+BcDomainRequest = _reflection.GeneratedProtocolMessageType('BcDomainRequest', (_message.Message,), dict(
+  DESCRIPTOR = _BCDOMAINREQUEST,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.BcDomainRequest)
+  ))
+_sym_db.RegisterMessage(BcDomainRequest)
+
+BcDomainPortInfo = _reflection.GeneratedProtocolMessageType('BcDomainPortInfo', (_message.Message,), dict(
+  DESCRIPTOR = _BCDOMAINPORTINFO,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.BcDomainPortInfo)
+  ))
+_sym_db.RegisterMessage(BcDomainPortInfo)
+
+BcDomainInfo = _reflection.GeneratedProtocolMessageType('BcDomainInfo', (_message.Message,), dict(
+  DESCRIPTOR = _BCDOMAININFO,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.BcDomainInfo)
+  ))
+_sym_db.RegisterMessage(BcDomainInfo)
+
+SubnetRequest = _reflection.GeneratedProtocolMessageType('SubnetRequest', (_message.Message,), dict(
+  DESCRIPTOR = _SUBNETREQUEST,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.SubnetRequest)
+  ))
+_sym_db.RegisterMessage(SubnetRequest)
+
+SubnetInfo = _reflection.GeneratedProtocolMessageType('SubnetInfo', (_message.Message,), dict(
+  DESCRIPTOR = _SUBNETINFO,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.SubnetInfo)
+  ))
+_sym_db.RegisterMessage(SubnetInfo)
+
+SvmRequest = _reflection.GeneratedProtocolMessageType('SvmRequest', (_message.Message,), dict(
+  DESCRIPTOR = _SVMREQUEST,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.SvmRequest)
+  ))
+_sym_db.RegisterMessage(SvmRequest)
+
+SvmInfo = _reflection.GeneratedProtocolMessageType('SvmInfo', (_message.Message,), dict(
+  DESCRIPTOR = _SVMINFO,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.SvmInfo)
+  ))
+_sym_db.RegisterMessage(SvmInfo)
+
+SvmJobResult = _reflection.GeneratedProtocolMessageType('SvmJobResult', (_message.Message,), dict(
+  DESCRIPTOR = _SVMJOBRESULT,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.SvmJobResult)
+  ))
+_sym_db.RegisterMessage(SvmJobResult)
+
+VolumeRequest = _reflection.GeneratedProtocolMessageType('VolumeRequest', (_message.Message,), dict(
+  DESCRIPTOR = _VOLUMEREQUEST,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.VolumeRequest)
+  ))
+_sym_db.RegisterMessage(VolumeRequest)
+
+VolumeInfo = _reflection.GeneratedProtocolMessageType('VolumeInfo', (_message.Message,), dict(
+  DESCRIPTOR = _VOLUMEINFO,
+  __module__ = 'grpcapi_pb2'
+  # @@protoc_insertion_point(class_scope:grpcapi.VolumeInfo)
+  ))
+_sym_db.RegisterMessage(VolumeInfo)
+
+
+
+_GRPCNETAPPAPI = _descriptor.ServiceDescriptor(
+  name='GRPCNetAppApi',
+  full_name='grpcapi.GRPCNetAppApi',
+  file=DESCRIPTOR,
+  index=0,
+  serialized_options=None,
+  serialized_start=4210,
+  serialized_end=7402,
+  methods=[
+  _descriptor.MethodDescriptor(
+    name='Call',
+    full_name='grpcapi.GRPCNetAppApi.Call',
+    index=0,
+    containing_service=None,
+    input_type=_CALLREQUEST,
+    output_type=_CALLRESPONSE,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='CallBatch',
+    full_name='grpcapi.GRPCNetAppApi.CallBatch',
+    index=1,
+    containing_service=None,
+    input_type=_BATCHREQUEST,
+    output_type=_BATCHRESPONSE,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='Shutdown',
+    full_name='grpcapi.GRPCNetAppApi.Shutdown',
+    index=2,
+    containing_service=None,
+    input_type=_SHUTDOWNREQUEST,
+    output_type=_SHUTDOWNRESPONSE,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='GetVersion',
+    full_name='grpcapi.GRPCNetAppApi.GetVersion',
+    index=3,
+    containing_service=None,
+    input_type=_VERSIONREQUEST,
+    output_type=_VERSIONRESPONSE,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='StreamLogs',
+    full_name='grpcapi.GRPCNetAppApi.StreamLogs',
+    index=4,
+    containing_service=None,
+    input_type=_LOGREQUEST,
+    output_type=_LOGRECORD,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='NodeGet',
+    full_name='grpcapi.GRPCNetAppApi.NodeGet',
+    index=5,
+    containing_service=None,
+    input_type=_NODEREQUEST,
+    output_type=_NODEINFO,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='PortGet',
+    full_name='grpcapi.GRPCNetAppApi.PortGet',
+    index=6,
+    containing_service=None,
+    input_type=_PORTREQUEST,
+    output_type=_PORTINFO,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='PortModify',
+    full_name='grpcapi.GRPCNetAppApi.PortModify',
+    index=7,
+    containing_service=None,
+    input_type=_PORTMODIFYREQUEST,
+    output_type=_EMPTYRESPONSE,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='PortFindPattern',
+    full_name='grpcapi.GRPCNetAppApi.PortFindPattern',
+    index=8,
+    containing_service=None,
+    input_type=_PORTREQUEST,
+    output_type=_PORTFINDRESPONSE,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='PortGroupGet',
+    full_name='grpcapi.GRPCNetAppApi.PortGroupGet',
+    index=9,
+    containing_service=None,
+    input_type=_PORTGROUPREQUEST,
+    output_type=_PORTGROUPINFO,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='PortGroupCreate',
+    full_name='grpcapi.GRPCNetAppApi.PortGroupCreate',
+    index=10,
+    containing_service=None,
+    input_type=_PORTGROUPREQUEST,
+    output_type=_EMPTYRESPONSE,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='PortGroupPortAdd',
+    full_name='grpcapi.GRPCNetAppApi.PortGroupPortAdd',
+    index=11,
+    containing_service=None,
+    input_type=_PORTGROUPREQUEST,
+    output_type=_EMPTYRESPONSE,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='PortGroupPortRemove',
+    full_name='grpcapi.GRPCNetAppApi.PortGroupPortRemove',
+    index=12,
+    containing_service=None,
+    input_type=_PORTGROUPREQUEST,
+    output_type=_EMPTYRESPONSE,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='PortGroupDelete',
+    full_name='grpcapi.GRPCNetAppApi.PortGroupDelete',
+    index=13,
+    containing_service=None,
+    input_type=_PORTGROUPREQUEST,
+    output_type=_EMPTYRESPONSE,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='AggrGet',
+    full_name='grpcapi.GRPCNetAppApi.AggrGet',
+    index=14,
+    containing_service=None,
+    input_type=_AGGRREQUEST,
+    output_type=_AGGRINFO,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='JobGet',
+    full_name='grpcapi.GRPCNetAppApi.JobGet',
+    index=15,
+    containing_service=None,
+    input_type=_JOBREQUEST,
+    output_type=_JOBINFO,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='WatchJob',
+    full_name='grpcapi.GRPCNetAppApi.WatchJob',
+    index=16,
+    containing_service=None,
+    input_type=_WATCHJOBREQUEST,
+    output_type=_JOBINFO,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='VlanGet',
+    full_name='grpcapi.GRPCNetAppApi.VlanGet',
+    index=17,
+    containing_service=None,
+    input_type=_VLANREQUEST,
+    output_type=_VLANINFO,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='VlanCreate',
+    full_name='grpcapi.GRPCNetAppApi.VlanCreate',
+    index=18,
+    containing_service=None,
+    input_type=_VLANREQUEST,
+    output_type=_EMPTYRESPONSE,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='VlanDelete',
+    full_name='grpcapi.GRPCNetAppApi.VlanDelete',
+    index=19,
+    containing_service=None,
+    input_type=_VLANREQUEST,
+    output_type=_EMPTYRESPONSE,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='IPSpaceGet',
+    full_name='grpcapi.GRPCNetAppApi.IPSpaceGet',
+    index=20,
+    containing_service=None,
+    input_type=_IPSPACEREQUEST,
+    output_type=_IPSPACEINFO,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='IPSpaceCreate',
+    full_name='grpcapi.GRPCNetAppApi.IPSpaceCreate',
+    index=21,
+    containing_service=None,
+    input_type=_IPSPACEREQUEST,
+    output_type=_IPSPACEINFO,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='IPSpaceUpdate',
+    full_name='grpcapi.GRPCNetAppApi.IPSpaceUpdate',
+    index=22,
+    containing_service=None,
+    input_type=_IPSPACEREQUEST,
+    output_type=_EMPTYRESPONSE,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='IPSpaceDelete',
+    full_name='grpcapi.GRPCNetAppApi.IPSpaceDelete',
+    index=23,
+    containing_service=None,
+    input_type=_IPSPACEREQUEST,
+    output_type=_EMPTYRESPONSE,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='BcDomainGet',
+    full_name='grpcapi.GRPCNetAppApi.BcDomainGet',
+    index=24,
+    containing_service=None,
+    input_type=_BCDOMAINREQUEST,
+    output_type=_BCDOMAININFO,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='BcDomainStatus',
+    full_name='grpcapi.GRPCNetAppApi.BcDomainStatus',
+    index=25,
+    containing_service=None,
+    input_type=_BCDOMAINREQUEST,
+    output_type=_BCDOMAININFO,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='BcDomainCreate',
+    full_name='grpcapi.GRPCNetAppApi.BcDomainCreate',
+    index=26,
+    containing_service=None,
+    input_type=_BCDOMAINREQUEST,
+    output_type=_BCDOMAININFO,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='BcDomainRename',
+    full_name='grpcapi.GRPCNetAppApi.BcDomainRename',
+    index=27,
+    containing_service=None,
+    input_type=_BCDOMAINREQUEST,
+    output_type=_EMPTYRESPONSE,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='BcDomainPortAdd',
+    full_name='grpcapi.GRPCNetAppApi.BcDomainPortAdd',
+    index=28,
+    containing_service=None,
+    input_type=_BCDOMAINREQUEST,
+    output_type=_BCDOMAININFO,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='BcDomainPortRemove',
+    full_name='grpcapi.GRPCNetAppApi.BcDomainPortRemove',
+    index=29,
+    containing_service=None,
+    input_type=_BCDOMAINREQUEST,
+    output_type=_BCDOMAININFO,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='BcDomainUpdate',
+    full_name='grpcapi.GRPCNetAppApi.BcDomainUpdate',
+    index=30,
+    containing_service=None,
+    input_type=_BCDOMAINREQUEST,
+    output_type=_BCDOMAININFO,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='BcDomainDelete',
+    full_name='grpcapi.GRPCNetAppApi.BcDomainDelete',
+    index=31,
+    containing_service=None,
+    input_type=_BCDOMAINREQUEST,
+    output_type=_BCDOMAININFO,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='SubnetGet',
+    full_name='grpcapi.GRPCNetAppApi.SubnetGet',
+    index=32,
+    containing_service=None,
+    input_type=_SUBNETREQUEST,
+    output_type=_SUBNETINFO,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='SubnetCreate',
+    full_name='grpcapi.GRPCNetAppApi.SubnetCreate',
+    index=33,
+    containing_service=None,
+    input_type=_SUBNETREQUEST,
+    output_type=_SUBNETINFO,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='SubnetDelete',
+    full_name='grpcapi.GRPCNetAppApi.SubnetDelete',
+    index=34,
+    containing_service=None,
+    input_type=_SUBNETREQUEST,
+    output_type=_EMPTYRESPONSE,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='SubnetRename',
+    full_name='grpcapi.GRPCNetAppApi.SubnetRename',
+    index=35,
+    containing_service=None,
+    input_type=_SUBNETREQUEST,
+    output_type=_EMPTYRESPONSE,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='SubnetIPRangeAdd',
+    full_name='grpcapi.GRPCNetAppApi.SubnetIPRangeAdd',
+    index=36,
+    containing_service=None,
+    input_type=_SUBNETREQUEST,
+    output_type=_EMPTYRESPONSE,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='SubnetIPRangeRemove',
+    full_name='grpcapi.GRPCNetAppApi.SubnetIPRangeRemove',
+    index=37,
+    containing_service=None,
+    input_type=_SUBNETREQUEST,
+    output_type=_EMPTYRESPONSE,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='SubnetModify',
+    full_name='grpcapi.GRPCNetAppApi.SubnetModify',
+    index=38,
+    containing_service=None,
+    input_type=_SUBNETREQUEST,
+    output_type=_EMPTYRESPONSE,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='SvmGet',
+    full_name='grpcapi.GRPCNetAppApi.SvmGet',
+    index=39,
+    containing_service=None,
+    input_type=_SVMREQUEST,
+    output_type=_SVMINFO,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='SvmCreate',
+    full_name='grpcapi.GRPCNetAppApi.SvmCreate',
+    index=40,
+    containing_service=None,
+    input_type=_SVMREQUEST,
+    output_type=_SVMJOBRESULT,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='SvmDelete',
+    full_name='grpcapi.GRPCNetAppApi.SvmDelete',
+    index=41,
+    containing_service=None,
+    input_type=_SVMREQUEST,
+    output_type=_SVMJOBRESULT,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='SvmStart',
+    full_name='grpcapi.GRPCNetAppApi.SvmStart',
+    index=42,
+    containing_service=None,
+    input_type=_SVMREQUEST,
+    output_type=_EMPTYRESPONSE,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='SvmStop',
+    full_name='grpcapi.GRPCNetAppApi.SvmStop',
+    index=43,
+    containing_service=None,
+    input_type=_SVMREQUEST,
+    output_type=_EMPTYRESPONSE,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='SvmUnlock',
+    full_name='grpcapi.GRPCNetAppApi.SvmUnlock',
+    index=44,
+    containing_service=None,
+    input_type=_SVMREQUEST,
+    output_type=_EMPTYRESPONSE,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='SvmRename',
+    full_name='grpcapi.GRPCNetAppApi.SvmRename',
+    index=45,
+    containing_service=None,
+    input_type=_SVMREQUEST,
+    output_type=_EMPTYRESPONSE,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='VolumeOnline',
+    full_name='grpcapi.GRPCNetAppApi.VolumeOnline',
+    index=46,
+    containing_service=None,
+    input_type=_VOLUMEREQUEST,
+    output_type=_EMPTYRESPONSE,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='VolumeOffline',
+    full_name='grpcapi.GRPCNetAppApi.VolumeOffline',
+    index=47,
+    containing_service=None,
+    input_type=_VOLUMEREQUEST,
+    output_type=_EMPTYRESPONSE,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='VolumeRestrict',
+    full_name='grpcapi.GRPCNetAppApi.VolumeRestrict',
+    index=48,
+    containing_service=None,
+    input_type=_VOLUMEREQUEST,
+    output_type=_EMPTYRESPONSE,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='VolumeDelete',
+    full_name='grpcapi.GRPCNetAppApi.VolumeDelete',
+    index=49,
+    containing_service=None,
+    input_type=_VOLUMEREQUEST,
+    output_type=_EMPTYRESPONSE,
+    serialized_options=None,
+  ),
+  _descriptor.MethodDescriptor(
+    name='VolumeSize',
+    full_name='grpcapi.GRPCNetAppApi.VolumeSize',
+    index=50,
+    containing_service=None,
+    input_type=_VOLUMEREQUEST,
+    output_type=_VOLUMEINFO,
     serialized_options=None,
   ),
 ])
@@ -19,11 +19,256 @@ class GRPCNetAppApiStub(object):
         request_serializer=grpcapi__pb2.CallRequest.SerializeToString,
         response_deserializer=grpcapi__pb2.CallResponse.FromString,
         )
+    self.CallBatch = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/CallBatch',
+        request_serializer=grpcapi__pb2.BatchRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.BatchResponse.FromString,
+        )
     self.Shutdown = channel.unary_unary(
         '/grpcapi.GRPCNetAppApi/Shutdown',
         request_serializer=grpcapi__pb2.ShutdownRequest.SerializeToString,
         response_deserializer=grpcapi__pb2.ShutdownResponse.FromString,
         )
+    self.GetVersion = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/GetVersion',
+        request_serializer=grpcapi__pb2.VersionRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.VersionResponse.FromString,
+        )
+    self.StreamLogs = channel.unary_stream(
+        '/grpcapi.GRPCNetAppApi/StreamLogs',
+        request_serializer=grpcapi__pb2.LogRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.LogRecord.FromString,
+        )
+    self.NodeGet = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/NodeGet',
+        request_serializer=grpcapi__pb2.NodeRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.NodeInfo.FromString,
+        )
+    self.PortGet = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/PortGet',
+        request_serializer=grpcapi__pb2.PortRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.PortInfo.FromString,
+        )
+    self.PortModify = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/PortModify',
+        request_serializer=grpcapi__pb2.PortModifyRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.EmptyResponse.FromString,
+        )
+    self.PortFindPattern = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/PortFindPattern',
+        request_serializer=grpcapi__pb2.PortRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.PortFindResponse.FromString,
+        )
+    self.PortGroupGet = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/PortGroupGet',
+        request_serializer=grpcapi__pb2.PortGroupRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.PortGroupInfo.FromString,
+        )
+    self.PortGroupCreate = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/PortGroupCreate',
+        request_serializer=grpcapi__pb2.PortGroupRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.EmptyResponse.FromString,
+        )
+    self.PortGroupPortAdd = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/PortGroupPortAdd',
+        request_serializer=grpcapi__pb2.PortGroupRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.EmptyResponse.FromString,
+        )
+    self.PortGroupPortRemove = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/PortGroupPortRemove',
+        request_serializer=grpcapi__pb2.PortGroupRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.EmptyResponse.FromString,
+        )
+    self.PortGroupDelete = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/PortGroupDelete',
+        request_serializer=grpcapi__pb2.PortGroupRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.EmptyResponse.FromString,
+        )
+    self.AggrGet = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/AggrGet',
+        request_serializer=grpcapi__pb2.AggrRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.AggrInfo.FromString,
+        )
+    self.JobGet = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/JobGet',
+        request_serializer=grpcapi__pb2.JobRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.JobInfo.FromString,
+        )
+    self.WatchJob = channel.unary_stream(
+        '/grpcapi.GRPCNetAppApi/WatchJob',
+        request_serializer=grpcapi__pb2.WatchJobRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.JobInfo.FromString,
+        )
+    self.VlanGet = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/VlanGet',
+        request_serializer=grpcapi__pb2.VlanRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.VlanInfo.FromString,
+        )
+    self.VlanCreate = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/VlanCreate',
+        request_serializer=grpcapi__pb2.VlanRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.EmptyResponse.FromString,
+        )
+    self.VlanDelete = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/VlanDelete',
+        request_serializer=grpcapi__pb2.VlanRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.EmptyResponse.FromString,
+        )
+    self.IPSpaceGet = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/IPSpaceGet',
+        request_serializer=grpcapi__pb2.IPSpaceRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.IPSpaceInfo.FromString,
+        )
+    self.IPSpaceCreate = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/IPSpaceCreate',
+        request_serializer=grpcapi__pb2.IPSpaceRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.IPSpaceInfo.FromString,
+        )
+    self.IPSpaceUpdate = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/IPSpaceUpdate',
+        request_serializer=grpcapi__pb2.IPSpaceRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.EmptyResponse.FromString,
+        )
+    self.IPSpaceDelete = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/IPSpaceDelete',
+        request_serializer=grpcapi__pb2.IPSpaceRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.EmptyResponse.FromString,
+        )
+    self.BcDomainGet = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/BcDomainGet',
+        request_serializer=grpcapi__pb2.BcDomainRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.BcDomainInfo.FromString,
+        )
+    self.BcDomainStatus = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/BcDomainStatus',
+        request_serializer=grpcapi__pb2.BcDomainRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.BcDomainInfo.FromString,
+        )
+    self.BcDomainCreate = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/BcDomainCreate',
+        request_serializer=grpcapi__pb2.BcDomainRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.BcDomainInfo.FromString,
+        )
+    self.BcDomainRename = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/BcDomainRename',
+        request_serializer=grpcapi__pb2.BcDomainRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.EmptyResponse.FromString,
+        )
+    self.BcDomainPortAdd = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/BcDomainPortAdd',
+        request_serializer=grpcapi__pb2.BcDomainRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.BcDomainInfo.FromString,
+        )
+    self.BcDomainPortRemove = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/BcDomainPortRemove',
+        request_serializer=grpcapi__pb2.BcDomainRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.BcDomainInfo.FromString,
+        )
+    self.BcDomainUpdate = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/BcDomainUpdate',
+        request_serializer=grpcapi__pb2.BcDomainRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.BcDomainInfo.FromString,
+        )
+    self.BcDomainDelete = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/BcDomainDelete',
+        request_serializer=grpcapi__pb2.BcDomainRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.BcDomainInfo.FromString,
+        )
+    self.SubnetGet = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/SubnetGet',
+        request_serializer=grpcapi__pb2.SubnetRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.SubnetInfo.FromString,
+        )
+    self.SubnetCreate = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/SubnetCreate',
+        request_serializer=grpcapi__pb2.SubnetRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.SubnetInfo.FromString,
+        )
+    self.SubnetDelete = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/SubnetDelete',
+        request_serializer=grpcapi__pb2.SubnetRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.EmptyResponse.FromString,
+        )
+    self.SubnetRename = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/SubnetRename',
+        request_serializer=grpcapi__pb2.SubnetRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.EmptyResponse.FromString,
+        )
+    self.SubnetIPRangeAdd = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/SubnetIPRangeAdd',
+        request_serializer=grpcapi__pb2.SubnetRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.EmptyResponse.FromString,
+        )
+    self.SubnetIPRangeRemove = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/SubnetIPRangeRemove',
+        request_serializer=grpcapi__pb2.SubnetRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.EmptyResponse.FromString,
+        )
+    self.SubnetModify = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/SubnetModify',
+        request_serializer=grpcapi__pb2.SubnetRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.EmptyResponse.FromString,
+        )
+    self.SvmGet = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/SvmGet',
+        request_serializer=grpcapi__pb2.SvmRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.SvmInfo.FromString,
+        )
+    self.SvmCreate = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/SvmCreate',
+        request_serializer=grpcapi__pb2.SvmRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.SvmJobResult.FromString,
+        )
+    self.SvmDelete = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/SvmDelete',
+        request_serializer=grpcapi__pb2.SvmRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.SvmJobResult.FromString,
+        )
+    self.SvmStart = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/SvmStart',
+        request_serializer=grpcapi__pb2.SvmRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.EmptyResponse.FromString,
+        )
+    self.SvmStop = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/SvmStop',
+        request_serializer=grpcapi__pb2.SvmRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.EmptyResponse.FromString,
+        )
+    self.SvmUnlock = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/SvmUnlock',
+        request_serializer=grpcapi__pb2.SvmRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.EmptyResponse.FromString,
+        )
+    self.SvmRename = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/SvmRename',
+        request_serializer=grpcapi__pb2.SvmRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.EmptyResponse.FromString,
+        )
+    self.VolumeOnline = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/VolumeOnline',
+        request_serializer=grpcapi__pb2.VolumeRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.EmptyResponse.FromString,
+        )
+    self.VolumeOffline = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/VolumeOffline',
+        request_serializer=grpcapi__pb2.VolumeRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.EmptyResponse.FromString,
+        )
+    self.VolumeRestrict = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/VolumeRestrict',
+        request_serializer=grpcapi__pb2.VolumeRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.EmptyResponse.FromString,
+        )
+    self.VolumeDelete = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/VolumeDelete',
+        request_serializer=grpcapi__pb2.VolumeRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.EmptyResponse.FromString,
+        )
+    self.VolumeSize = channel.unary_unary(
+        '/grpcapi.GRPCNetAppApi/VolumeSize',
+        request_serializer=grpcapi__pb2.VolumeRequest.SerializeToString,
+        response_deserializer=grpcapi__pb2.VolumeInfo.FromString,
+        )
 
 
 class GRPCNetAppApiServicer(object):
@@ -31,8 +276,15 @@ class GRPCNetAppApiServicer(object):
   """
 
   def Call(self, request, context):
-    # missing associated documentation comment in .proto file
-    pass
+    """generic JSON call, used for commands without typed RPC
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def CallBatch(self, request, context):
+    """generic JSON calls in one round trip, e.g. the reads of a refresh
+    """
     context.set_code(grpc.StatusCode.UNIMPLEMENTED)
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
@@ -44,6 +296,343 @@ class GRPCNetAppApiServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def GetVersion(self, request, context):
+    """build and supported commands of the API server
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def StreamLogs(self, request, context):
+    """pushes the log records of the API server until the client cancels
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def NodeGet(self, request, context):
+    """SYS.NODE.GET
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def PortGet(self, request, context):
+    """SYS.PORT.GET
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def PortModify(self, request, context):
+    """SYS.PORT.MODIFY
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def PortFindPattern(self, request, context):
+    """SYS.PORT.FIND.PATTERN
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def PortGroupGet(self, request, context):
+    """SYS.PORTGROUP.GET
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def PortGroupCreate(self, request, context):
+    """SYS.PORTGROUP.CREATE
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def PortGroupPortAdd(self, request, context):
+    """SYS.PORTGROUP.PORT.ADD
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def PortGroupPortRemove(self, request, context):
+    """SYS.PORTGROUP.PORT.REMOVE
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def PortGroupDelete(self, request, context):
+    """SYS.PORTGROUP.DELETE
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def AggrGet(self, request, context):
+    """SYS.AGGR.GET
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def JobGet(self, request, context):
+    """SYS.JOB.GET
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def WatchJob(self, request, context):
+    """pushes the job info on every job state or progress change, the
+    stream ends once the job ended
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def VlanGet(self, request, context):
+    """NW.VLAN.GET
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def VlanCreate(self, request, context):
+    """NW.VLAN.CREATE
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def VlanDelete(self, request, context):
+    """NW.VLAN.DELETE
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def IPSpaceGet(self, request, context):
+    """NW.IPSPACE.GET
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def IPSpaceCreate(self, request, context):
+    """NW.IPSPACE.CREATE
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def IPSpaceUpdate(self, request, context):
+    """NW.IPSPACE.UPDATE
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def IPSpaceDelete(self, request, context):
+    """NW.IPSPACE.DELETE
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def BcDomainGet(self, request, context):
+    """NW.BRCDOM.GET
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def BcDomainStatus(self, request, context):
+    """NW.BRCDOM.STATUS
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def BcDomainCreate(self, request, context):
+    """NW.BRCDOM.CREATE
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def BcDomainRename(self, request, context):
+    """NW.BRCDOM.RENAME
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def BcDomainPortAdd(self, request, context):
+    """NW.BRCDOM.PORT.ADD
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def BcDomainPortRemove(self, request, context):
+    """NW.BRCDOM.PORT.REMOVE
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def BcDomainUpdate(self, request, context):
+    """NW.BRCDOM.UPDATE
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def BcDomainDelete(self, request, context):
+    """NW.BRCDOM.DELETE
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def SubnetGet(self, request, context):
+    """NW.SUBNET.GET
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def SubnetCreate(self, request, context):
+    """NW.SUBNET.CREATE
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def SubnetDelete(self, request, context):
+    """NW.SUBNET.DELETE
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def SubnetRename(self, request, context):
+    """NW.SUBNET.RENAME
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def SubnetIPRangeAdd(self, request, context):
+    """NW.SUBNET.IPR.ADD
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def SubnetIPRangeRemove(self, request, context):
+    """NW.SUBNET.IPR.REMOVE
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def SubnetModify(self, request, context):
+    """NW.SUBNET.MODIFY
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def SvmGet(self, request, context):
+    """SVM.GET
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def SvmCreate(self, request, context):
+    """SVM.CREATE
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def SvmDelete(self, request, context):
+    """SVM.DELETE
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def SvmStart(self, request, context):
+    """SVM.START
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def SvmStop(self, request, context):
+    """SVM.STOP
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def SvmUnlock(self, request, context):
+    """SVM.UNLOCK
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def SvmRename(self, request, context):
+    """SVM.RENAME
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def VolumeOnline(self, request, context):
+    """SVM.VOL.ONLINE
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def VolumeOffline(self, request, context):
+    """SVM.VOL.OFFLINE
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def VolumeRestrict(self, request, context):
+    """SVM.VOL.RESTRICT
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def VolumeDelete(self, request, context):
+    """SVM.VOL.DELETE
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
+  def VolumeSize(self, request, context):
+    """SVM.VOL.SIZE
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
 
 def add_GRPCNetAppApiServicer_to_server(servicer, server):
   rpc_method_handlers = {
@@ -52,11 +641,256 @@ def add_GRPCNetAppApiServicer_to_server(servicer, server):
           request_deserializer=grpcapi__pb2.CallRequest.FromString,
           response_serializer=grpcapi__pb2.CallResponse.SerializeToString,
       ),
+      'CallBatch': grpc.unary_unary_rpc_method_handler(
+          servicer.CallBatch,
+          request_deserializer=grpcapi__pb2.BatchRequest.FromString,
+          response_serializer=grpcapi__pb2.BatchResponse.SerializeToString,
+      ),
       'Shutdown': grpc.unary_unary_rpc_method_handler(
           servicer.Shutdown,
           request_deserializer=grpcapi__pb2.ShutdownRequest.FromString,
           response_serializer=grpcapi__pb2.ShutdownResponse.SerializeToString,
       ),
+      'GetVersion': grpc.unary_unary_rpc_method_handler(
+          servicer.GetVersion,
+          request_deserializer=grpcapi__pb2.VersionRequest.FromString,
+          response_serializer=grpcapi__pb2.VersionResponse.SerializeToString,
+      ),
+      'StreamLogs': grpc.unary_stream_rpc_method_handler(
+          servicer.StreamLogs,
+          request_deserializer=grpcapi__pb2.LogRequest.FromString,
+          response_serializer=grpcapi__pb2.LogRecord.SerializeToString,
+      ),
+      'NodeGet': grpc.unary_unary_rpc_method_handler(
+          servicer.NodeGet,
+          request_deserializer=grpcapi__pb2.NodeRequest.FromString,
+          response_serializer=grpcapi__pb2.NodeInfo.SerializeToString,
+      ),
+      'PortGet': grpc.unary_unary_rpc_method_handler(
+          servicer.PortGet,
+          request_deserializer=grpcapi__pb2.PortRequest.FromString,
+          response_serializer=grpcapi__pb2.PortInfo.SerializeToString,
+      ),
+      'PortModify': grpc.unary_unary_rpc_method_handler(
+          servicer.PortModify,
+          request_deserializer=grpcapi__pb2.PortModifyRequest.FromString,
+          response_serializer=grpcapi__pb2.EmptyResponse.SerializeToString,
+      ),
+      'PortFindPattern': grpc.unary_unary_rpc_method_handler(
+          servicer.PortFindPattern,
+          request_deserializer=grpcapi__pb2.PortRequest.FromString,
+          response_serializer=grpcapi__pb2.PortFindResponse.SerializeToString,
+      ),
+      'PortGroupGet': grpc.unary_unary_rpc_method_handler(
+          servicer.PortGroupGet,
+          request_deserializer=grpcapi__pb2.PortGroupRequest.FromString,
+          response_serializer=grpcapi__pb2.PortGroupInfo.SerializeToString,
+      ),
+      'PortGroupCreate': grpc.unary_unary_rpc_method_handler(
+          servicer.PortGroupCreate,
+          request_deserializer=grpcapi__pb2.PortGroupRequest.FromString,
+          response_serializer=grpcapi__pb2.EmptyResponse.SerializeToString,
+      ),
+      'PortGroupPortAdd': grpc.unary_unary_rpc_method_handler(
+          servicer.PortGroupPortAdd,
+          request_deserializer=grpcapi__pb2.PortGroupRequest.FromString,
+          response_serializer=grpcapi__pb2.EmptyResponse.SerializeToString,
+      ),
+      'PortGroupPortRemove': grpc.unary_unary_rpc_method_handler(
+          servicer.PortGroupPortRemove,
+          request_deserializer=grpcapi__pb2.PortGroupRequest.FromString,
+          response_serializer=grpcapi__pb2.EmptyResponse.SerializeToString,
+      ),
+      'PortGroupDelete': grpc.unary_unary_rpc_method_handler(
+          servicer.PortGroupDelete,
+          request_deserializer=grpcapi__pb2.PortGroupRequest.FromString,
+          response_serializer=grpcapi__pb2.EmptyResponse.SerializeToString,
+      ),
+      'AggrGet': grpc.unary_unary_rpc_method_handler(
+          servicer.AggrGet,
+          request_deserializer=grpcapi__pb2.AggrRequest.FromString,
+          response_serializer=grpcapi__pb2.AggrInfo.SerializeToString,
+      ),
+      'JobGet': grpc.unary_unary_rpc_method_handler(
+          servicer.JobGet,
+          request_deserializer=grpcapi__pb2.JobRequest.FromString,
+          response_serializer=grpcapi__pb2.JobInfo.SerializeToString,
+      ),
+      'WatchJob': grpc.unary_stream_rpc_method_handler(
+          servicer.WatchJob,
+          request_deserializer=grpcapi__pb2.WatchJobRequest.FromString,
+          response_serializer=grpcapi__pb2.JobInfo.SerializeToString,
+      ),
+      'VlanGet': grpc.unary_unary_rpc_method_handler(
+          servicer.VlanGet,
+          request_deserializer=grpcapi__pb2.VlanRequest.FromString,
+          response_serializer=grpcapi__pb2.VlanInfo.SerializeToString,
+      ),
+      'VlanCreate': grpc.unary_unary_rpc_method_handler(
+          servicer.VlanCreate,
+          request_deserializer=grpcapi__pb2.VlanRequest.FromString,
+          response_serializer=grpcapi__pb2.EmptyResponse.SerializeToString,
+      ),
+      'VlanDelete': grpc.unary_unary_rpc_method_handler(
+          servicer.VlanDelete,
+          request_deserializer=grpcapi__pb2.VlanRequest.FromString,
+          response_serializer=grpcapi__pb2.EmptyResponse.SerializeToString,
+      ),
+      'IPSpaceGet': grpc.unary_unary_rpc_method_handler(
+          servicer.IPSpaceGet,
+          request_deserializer=grpcapi__pb2.IPSpaceRequest.FromString,
+          response_serializer=grpcapi__pb2.IPSpaceInfo.SerializeToString,
+      ),
+      'IPSpaceCreate': grpc.unary_unary_rpc_method_handler(
+          servicer.IPSpaceCreate,
+          request_deserializer=grpcapi__pb2.IPSpaceRequest.FromString,
+          response_serializer=grpcapi__pb2.IPSpaceInfo.SerializeToString,
+      ),
+      'IPSpaceUpdate': grpc.unary_unary_rpc_method_handler(
+          servicer.IPSpaceUpdate,
+          request_deserializer=grpcapi__pb2.IPSpaceRequest.FromString,
+          response_serializer=grpcapi__pb2.EmptyResponse.SerializeToString,
+      ),
+      'IPSpaceDelete': grpc.unary_unary_rpc_method_handler(
+          servicer.IPSpaceDelete,
+          request_deserializer=grpcapi__pb2.IPSpaceRequest.FromString,
+          response_serializer=grpcapi__pb2.EmptyResponse.SerializeToString,
+      ),
+      'BcDomainGet': grpc.unary_unary_rpc_method_handler(
+          servicer.BcDomainGet,
+          request_deserializer=grpcapi__pb2.BcDomainRequest.FromString,
+          response_serializer=grpcapi__pb2.BcDomainInfo.SerializeToString,
+      ),
+      'BcDomainStatus': grpc.unary_unary_rpc_method_handler(
+          servicer.BcDomainStatus,
+          request_deserializer=grpcapi__pb2.BcDomainRequest.FromString,
+          response_serializer=grpcapi__pb2.BcDomainInfo.SerializeToString,
+      ),
+      'BcDomainCreate': grpc.unary_unary_rpc_method_handler(
+          servicer.BcDomainCreate,
+          request_deserializer=grpcapi__pb2.BcDomainRequest.FromString,
+          response_serializer=grpcapi__pb2.BcDomainInfo.SerializeToString,
+      ),
+      'BcDomainRename': grpc.unary_unary_rpc_method_handler(
+          servicer.BcDomainRename,
+          request_deserializer=grpcapi__pb2.BcDomainRequest.FromString,
+          response_serializer=grpcapi__pb2.EmptyResponse.SerializeToString,
+      ),
+      'BcDomainPortAdd': grpc.unary_unary_rpc_method_handler(
+          servicer.BcDomainPortAdd,
+          request_deserializer=grpcapi__pb2.BcDomainRequest.FromString,
+          response_serializer=grpcapi__pb2.BcDomainInfo.SerializeToString,
+      ),
+      'BcDomainPortRemove': grpc.unary_unary_rpc_method_handler(
+          servicer.BcDomainPortRemove,
+          request_deserializer=grpcapi__pb2.BcDomainRequest.FromString,
+          response_serializer=grpcapi__pb2.BcDomainInfo.SerializeToString,
+      ),
+      'BcDomainUpdate': grpc.unary_unary_rpc_method_handler(
+          servicer.BcDomainUpdate,
+          request_deserializer=grpcapi__pb2.BcDomainRequest.FromString,
+          response_serializer=grpcapi__pb2.BcDomainInfo.SerializeToString,
+      ),
+      'BcDomainDelete': grpc.unary_unary_rpc_method_handler(
+          servicer.BcDomainDelete,
+          request_deserializer=grpcapi__pb2.BcDomainRequest.FromString,
+          response_serializer=grpcapi__pb2.BcDomainInfo.SerializeToString,
+      ),
+      'SubnetGet': grpc.unary_unary_rpc_method_handler(
+          servicer.SubnetGet,
+          request_deserializer=grpcapi__pb2.SubnetRequest.FromString,
+          response_serializer=grpcapi__pb2.SubnetInfo.SerializeToString,
+      ),
+      'SubnetCreate': grpc.unary_unary_rpc_method_handler(
+          servicer.SubnetCreate,
+          request_deserializer=grpcapi__pb2.SubnetRequest.FromString,
+          response_serializer=grpcapi__pb2.SubnetInfo.SerializeToString,
+      ),
+      'SubnetDelete': grpc.unary_unary_rpc_method_handler(
+          servicer.SubnetDelete,
+          request_deserializer=grpcapi__pb2.SubnetRequest.FromString,
+          response_serializer=grpcapi__pb2.EmptyResponse.SerializeToString,
+      ),
+      'SubnetRename': grpc.unary_unary_rpc_method_handler(
+          servicer.SubnetRename,
+          request_deserializer=grpcapi__pb2.SubnetRequest.FromString,
+          response_serializer=grpcapi__pb2.EmptyResponse.SerializeToString,
+      ),
+      'SubnetIPRangeAdd': grpc.unary_unary_rpc_method_handler(
+          servicer.SubnetIPRangeAdd,
+          request_deserializer=grpcapi__pb2.SubnetRequest.FromString,
+          response_serializer=grpcapi__pb2.EmptyResponse.SerializeToString,
+      ),
+      'SubnetIPRangeRemove': grpc.unary_unary_rpc_method_handler(
+          servicer.SubnetIPRangeRemove,
+          request_deserializer=grpcapi__pb2.SubnetRequest.FromString,
+          response_serializer=grpcapi__pb2.EmptyResponse.SerializeToString,
+      ),
+      'SubnetModify': grpc.unary_unary_rpc_method_handler(
+          servicer.SubnetModify,
+          request_deserializer=grpcapi__pb2.SubnetRequest.FromString,
+          response_serializer=grpcapi__pb2.EmptyResponse.SerializeToString,
+      ),
+      'SvmGet': grpc.unary_unary_rpc_method_handler(
+          servicer.SvmGet,
+          request_deserializer=grpcapi__pb2.SvmRequest.FromString,
+          response_serializer=grpcapi__pb2.SvmInfo.SerializeToString,
+      ),
+      'SvmCreate': grpc.unary_unary_rpc_method_handler(
+          servicer.SvmCreate,
+          request_deserializer=grpcapi__pb2.SvmRequest.FromString,
+          response_serializer=grpcapi__pb2.SvmJobResult.SerializeToString,
+      ),
+      'SvmDelete': grpc.unary_unary_rpc_method_handler(
+          servicer.SvmDelete,
+          request_deserializer=grpcapi__pb2.SvmRequest.FromString,
+          response_serializer=grpcapi__pb2.SvmJobResult.SerializeToString,
+      ),
+      'SvmStart': grpc.unary_unary_rpc_method_handler(
+          servicer.SvmStart,
+          request_deserializer=grpcapi__pb2.SvmRequest.FromString,
+          response_serializer=grpcapi__pb2.EmptyResponse.SerializeToString,
+      ),
+      'SvmStop': grpc.unary_unary_rpc_method_handler(
+          servicer.SvmStop,
+          request_deserializer=grpcapi__pb2.SvmRequest.FromString,
+          response_serializer=grpcapi__pb2.EmptyResponse.SerializeToString,
+      ),
+      'SvmUnlock': grpc.unary_unary_rpc_method_handler(
+          servicer.SvmUnlock,
+          request_deserializer=grpcapi__pb2.SvmRequest.FromString,
+          response_serializer=grpcapi__pb2.EmptyResponse.SerializeToString,
+      ),
+      'SvmRename': grpc.unary_unary_rpc_method_handler(
+          servicer.SvmRename,
+          request_deserializer=grpcapi__pb2.SvmRequest.FromString,
+          response_serializer=grpcapi__pb2.EmptyResponse.SerializeToString,
+      ),
+      'VolumeOnline': grpc.unary_unary_rpc_method_handler(
+          servicer.VolumeOnline,
+          request_deserializer=grpcapi__pb2.VolumeRequest.FromString,
+          response_serializer=grpcapi__pb2.EmptyResponse.SerializeToString,
+      ),
+      'VolumeOffline': grpc.unary_unary_rpc_method_handler(
+          servicer.VolumeOffline,
+          request_deserializer=grpcapi__pb2.VolumeRequest.FromString,
+          response_serializer=grpcapi__pb2.EmptyResponse.SerializeToString,
+      ),
+      'VolumeRestrict': grpc.unary_unary_rpc_method_handler(
+          servicer.VolumeRestrict,
+          request_deserializer=grpcapi__pb2.VolumeRequest.FromString,
+          response_serializer=grpcapi__pb2.EmptyResponse.SerializeToString,
+      ),
+      'VolumeDelete': grpc.unary_unary_rpc_method_handler(
+          servicer.VolumeDelete,
+          request_deserializer=grpcapi__pb2.VolumeRequest.FromString,
+          response_serializer=grpcapi__pb2.EmptyResponse.SerializeToString,
+      ),
+      'VolumeSize': grpc.unary_unary_rpc_method_handler(
+          servicer.VolumeSize,
+          request_deserializer=grpcapi__pb2.VolumeRequest.FromString,
+          response_serializer=grpcapi__pb2.VolumeInfo.SerializeToString,
+      ),
   }
   generic_handler = grpc.method_handlers_generic_handler(
       'grpcapi.GRPCNetAppApi', rpc_method_handlers)
@@ -0,0 +1,83 @@
+'''
+redaction of secrets in log records, the secret keys match the Go
+side, see pythonapi/redact.go
+'''
+import logging
+import threading
+
+REDACTED = 'REDACTED'
+
+# JSON keys (lower case substring match) whose values are never logged
+SECRET_KEYS = ('password', 'passwd', 'pwd', 'secret', 'token', 'private_key')
+
+def is_secret_key(key):
+    lkey = str(key).lower()
+    return any(skey in lkey for skey in SECRET_KEYS)
+
+def redact_data(data):
+    '''
+    copy of the command data with all secret values replaced, for logging
+
+    :param data: the decoded JSON command data
+    :return: the data with the values of SECRET_KEYS redacted
+    '''
+    if isinstance(data, dict):
+        return {
+            key: REDACTED if is_secret_key(key) else redact_data(value)
+            for key, value in data.items()}
+    if isinstance(data, (list, tuple)):
+        return [redact_data(value) for value in data]
+
+    return data
+
+class SecretFilter(logging.Filter):
+    '''
+    replaces the registered secrets in the log messages, e.g. the
+    password of a connect quoted by an error message
+    '''
+
+    def __init__(self):
+        super(SecretFilter, self).__init__()
+        self.secrets_lock = threading.Lock()
+        self.secrets = set()
+
+    def register(self, secret):
+        if not secret:
+            return
+
+        with self.secrets_lock:
+            self.secrets.add(str(secret))
+
+    def redact(self, text):
+        with self.secrets_lock:
+            secrets = list(self.secrets)
+
+        # longest first, a secret may contain another one
+        for secret in sorted(secrets, key=len, reverse=True):
+            text = text.replace(secret, REDACTED)
+
+        return text
+
+    def filter(self, record):
+        message = record.getMessage()
+        redacted = self.redact(message)
+        if redacted != message:
+            record.msg = redacted
+            record.args = None
+
+        return True
+
+SECRET_FILTER = SecretFilter()
+
+def register_secret(secret):
+    '''
+    never log the secret, e.g. the password of a cluster connection
+    '''
+    SECRET_FILTER.register(secret)
+
+def redact_secrets(text):
+    '''
+    the text with the registered secrets replaced, e.g. an error message
+    returned to the client
+    '''
+    return SECRET_FILTER.redact(text)
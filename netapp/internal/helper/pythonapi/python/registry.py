@@ -208,6 +208,10 @@ class ClientRegistry(object):
 
         return len(cdict)
 
+    def has_client(self, id):
+        succ, cdict = self.__get_client_dict()
+        return succ and id in cdict
+
     def register_client(self, id, status):
         succ, cdict = self.__get_client_dict()
         if not succ:
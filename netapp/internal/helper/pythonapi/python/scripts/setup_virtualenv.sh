@@ -2,15 +2,17 @@
 #****************************************************************************
 # script to install virtualenv and requirements for NetApp python API
 # NOTE: requires virtualenv installed
-# NOTE: must be executed with API root folder as argument
-#	./scripts/setup_virtualenv.sh $PYTHON_API_ROOT$
+# NOTE: must be executed with API root folder as argument, an optional
+#	wheelhouse folder installs the requirements offline from its wheels
+#	./scripts/setup_virtualenv.sh $PYTHON_API_ROOT$ [$WHEELHOUSE$]
 #****************************************************************************
 
 APIROOT="$1"
+WHEELHOUSE="$2"
 echo "Installing/Updating NetApp Python API virtualenv in: ${APIROOT}"
 
 # navigate to Python API root directory
-cd $APIROOT
+cd "$APIROOT" || exit 1
 
 # check if virtualenv is already present, create if not
 if [ ! -d "./venv" ]
@@ -29,7 +31,13 @@ fi
 # activate virtualenv
 source venv/bin/activate
 # install pip requirements
-pip install -r requirements.txt
+if [ -n "${WHEELHOUSE}" ]
+then
+   echo "installing requirements offline from: ${WHEELHOUSE}"
+   pip install --no-index --find-links "${WHEELHOUSE}" -r requirements.txt
+else
+   pip install -r requirements.txt
+fi
 
 # TODO: create some sort of python script to execute that verifies all is well?!
 
@@ -15,16 +15,19 @@ REGPORT="$1"
 shift
 
 # navigate to Python API root directory
-cd $APIROOT
+cd "$APIROOT" || exit 1
 
-echo "Starting NetApp Python API in: ${APIROOT}" >> python_api.log
+# the file log of the API, relative to the API root directory
+LOGFILE="${NETAPP_API_LOG_FILE:-python_api.log}"
+
+echo "Starting NetApp Python API in: ${APIROOT}" >> "$LOGFILE"
 
 # define environment variables
 export NETAPP_API_CR_PORT="${REGPORT}"
-echo "NetApp Python API client registry on: $NETAPP_API_CR_PORT" >> python_api.log
+echo "NetApp Python API client registry on: $NETAPP_API_CR_PORT" >> "$LOGFILE"
 
 export NETAPP_MSDK_ROOT_PATH="${SDKROOT}"
-echo "NetApp SDK root at: $NETAPP_MSDK_ROOT_PATH" >> python_api.log
+echo "NetApp SDK root at: $NETAPP_MSDK_ROOT_PATH" >> "$LOGFILE"
 
 # check if virtualenv is already present, create if not
 if [ ! -d "./venv" ]
@@ -35,7 +38,5 @@ fi
 
 # activate virtualenv
 source venv/bin/activate
-# start python API
-nohup python "$@" &
-# save PID to file
-echo $! > python_api.pid
\ No newline at end of file
+# start python API, the API server writes its PID to API_PID
+nohup python "$@" &
\ No newline at end of file
@@ -1,11 +1,11 @@
 package pythonapi
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
 	"os/exec"
-	"path/filepath"
 	"time"
 
 	"github.com/segmentio/ksuid"
@@ -18,9 +18,22 @@ import (
 // NetAppAPI the structure for the Python API interaction
 // to be refined access to Python API
 type NetAppAPI struct {
-	grpcpyapi.PythonAPI
+	impl     grpcpyapi.PythonAPI
 	client   *plugin.Client
 	clientID string
+
+	// session of the calls, the clientID unless reused, see ReuseSession
+	session string
+
+	// folder and pid of the API server, empty for in-process APIs
+	folder string
+	pid    int
+
+	// commands of the API server, nil if not known, see checkVersion
+	commands map[string]bool
+
+	// stopLogs ends the log forwarding, nil without, see forwardLogs
+	stopLogs context.CancelFunc
 }
 
 var requiredAPIScripts = append([]string{
@@ -29,27 +42,46 @@ var requiredAPIScripts = append([]string{
 	"requirements.txt",
 }, grpcpyapi.APIScripts...)
 
-func apiUp(apiFolder string) bool {
-	if _, err := os.Stat(filepath.Join(
-		apiFolder, "API_UP")); !os.IsNotExist(err) {
-		return true
+// NewNetAppAPI wraps an in-process API implementation, e.g. the cluster
+// simulator, so it can be used in place of the Python API
+func NewNetAppAPI(impl grpcpyapi.PythonAPI) *NetAppAPI {
+	clientID := ksuid.New().String()
+	return &NetAppAPI{
+		impl:     impl,
+		clientID: clientID,
+		session:  clientID,
 	}
-
-	return false
 }
 
-func apiStopping(apiFolder string) bool {
-	if _, err := os.Stat(filepath.Join(
-		apiFolder, "API_STOPPING")); !os.IsNotExist(err) {
-		return true
+// shutdownTimeout limits the wait for the API shutdown acknowledge
+const shutdownTimeout = 10 * time.Second
+
+// ReuseSession switches the API to the session saved for key by an
+// earlier provider run on the same API server, else the current session
+// is saved for key. The reused session stays open on Stop, so later runs
+// find it connected
+func (api *NetAppAPI) ReuseSession(key string) {
+	if api.folder == "" {
+		return
+	}
+
+	session := ksuid.New().String()
+	saved, err := newAPIProcess(api.folder, "", "").reuseSession(api.pid, key, session)
+	if err != nil {
+		log.Printf("[WARN] could not reuse python API session, got: %s", err)
+		return
 	}
 
-	return false
+	api.session = saved
 }
 
-// Stop must be called before API is stopped being used, e.g. plugin shutdown
+// Stop must be called before API is stopped being used, e.g. plugin
+// shutdown. It closes the session of the client unless it is reused
 func (api NetAppAPI) Stop() error {
-	succ, err := api.Shutdown(api.clientID)
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	succ, err := api.impl.Shutdown(ctx, api.clientID)
 	if err != nil {
 		log.Printf("[ERROR] API shutdown returned [%v] with error: %s", succ, err)
 		if !succ {
@@ -57,14 +89,37 @@ func (api NetAppAPI) Stop() error {
 		}
 	}
 
+	if api.stopLogs != nil {
+		api.stopLogs()
+	}
+
 	return err
 }
 
-func ensureAPISetup(folder string, sdkroot string, syncResult *SyncResult) error {
+// ensureAPISetup installs the virtualenv of the API unless its packages
+// already match requirements.txt, a wheelhouse installs them offline
+func ensureAPISetup(
+	folder string, sdkroot string, wheelhouse string,
+	syncResult *SyncResult) error {
+
+	err := checkAPIRequirements(folder)
+	if err == nil {
+		log.Printf("[INFO] python API virtualenv matches %s", apiRequirementsFile)
+		return nil
+	}
+	log.Printf("[INFO] installing python API virtualenv: %s", err)
+
+	if wheelhouse != "" {
+		info, err := os.Stat(wheelhouse)
+		if err != nil || !info.IsDir() {
+			return fmt.Errorf("python API wheelhouse [%s] is no directory, got: %v", wheelhouse, err)
+		}
+		log.Printf("[INFO] installing python API packages offline from: %s", wheelhouse)
+	}
 
 	// check python version
-	out, err := exec.Command("sh", "-c",
-		"python -c 'import sys; print(sys.version_info[:])'").Output()
+	out, err := exec.Command(
+		"python", "-c", "import sys; print(sys.version_info[:])").Output()
 	if err != nil {
 		log.Printf("[ERROR] failed to execute python version command, Python installed?")
 		return err
@@ -72,7 +127,7 @@ func ensureAPISetup(folder string, sdkroot string, syncResult *SyncResult) error
 	log.Printf("[INFO] python version: %v", string(out))
 
 	// check virtualenv installed + version?
-	out, err = exec.Command("sh", "-c", "virtualenv --version").Output()
+	out, err = exec.Command("virtualenv", "--version").Output()
 	if err != nil {
 		log.Printf("[ERROR] failed to execute virtualenv version command, virtualenv installed?")
 		return err
@@ -84,30 +139,43 @@ func ensureAPISetup(folder string, sdkroot string, syncResult *SyncResult) error
 	if err != nil {
 		return err
 	}
-	// execute API virtualenv setup and requirements install
-	out, err = exec.Command("sh", "-c",
-		fmt.Sprintf("%v %v", setupFilePath, folder)).Output()
+	// execute API virtualenv setup and requirements install, the paths are
+	// passed as arguments so that no shell interprets them
+	out, err = exec.Command(setupFilePath, folder, wheelhouse).Output()
 	if err != nil {
 		log.Printf("[ERROR] could not setup virtualenv, got: %v", err)
 		return err
 	}
 	log.Printf("[INFO] virtualenv setup returned: %v", string(out))
 
-	return nil
+	// e.g. a wheelhouse with other package versions
+	return checkAPIRequirements(folder)
 }
 
-// CreateAPI TODO: doc for create API call
+// CreateAPI starts the Python API in folder, or connects to the running
+// one. The virtualenv packages are installed from the wheelhouse folder
+// if set, otherwise from the package index. The log records of the API
+// server are forwarded to the provider log at the TF_LOG level
 func CreateAPI(
 	folder string, sdkroot string, regport string,
-	apiport string) (*NetAppAPI, error) {
+	apiport string, wheelhouse string,
+	logConfig LogConfig) (*NetAppAPI, error) {
+
+	proc := newAPIProcess(folder, apiport, regport)
 
-	for apiStopping(folder) {
-		// wait for previous server instance to stop
-		time.Sleep(200 * time.Millisecond)
+	// only one provider at a time checks/starts the API server
+	unlock, err := proc.lock(apiLockTimeout)
+	if err != nil {
+		return nil, err
 	}
+	defer unlock()
 
-	// check if API is already running via running file
-	apiRunning := apiUp(folder)
+	// wait for a previous server instance to stop, clean up after a
+	// crashed one and check a running one is healthy
+	apiRunning, err := proc.prepare()
+	if err != nil {
+		return nil, err
+	}
 
 	// synchronize the packr / python source files to OS filesystem / API folder
 	syncResult, err := SynchBoxToOS(folder, &requiredAPIScripts)
@@ -116,7 +184,7 @@ func CreateAPI(
 	}
 
 	if !apiRunning {
-		if err = ensureAPISetup(folder, sdkroot, syncResult); err != nil {
+		if err = ensureAPISetup(folder, sdkroot, wheelhouse, syncResult); err != nil {
 			return nil, err
 		}
 	}
@@ -134,15 +202,18 @@ func CreateAPI(
 	// source: https://blog.kowalczyk.info/article/JyRZ/generating-good-unique-ids-in-go.html
 	clientID := ksuid.New().String()
 
-	// start by launching the plugin process.
+	// start by launching the plugin process, a new API server reports the
+	// build of this provider
+	cmd := exec.Command(startupFilePath,
+		folder, sdkroot, regport, // shift arguments
+		grpcpyapi.APIMain, apiport, clientID)
+	cmd.Env = append(os.Environ(), apiBuildEnv+"="+BuildHash())
+	cmd.Env = append(cmd.Env, logConfig.env()...)
+
 	client := plugin.NewClient(&plugin.ClientConfig{
-		HandshakeConfig: grpcpyapi.Handshake,
-		Plugins:         grpcpyapi.PluginMap,
-		Cmd: exec.Command("sh", "-c",
-			fmt.Sprintf("%v %v %v %v %v %v %v",
-				startupFilePath,
-				folder, sdkroot, regport, // shift arguments
-				grpcpyapi.APIMain, apiport, clientID)),
+		HandshakeConfig:  grpcpyapi.Handshake,
+		Plugins:          grpcpyapi.PluginMap,
+		Cmd:              cmd,
 		AllowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC},
 	})
 
@@ -171,15 +242,52 @@ func CreateAPI(
 
 	log.Printf("[INFO] client plugin dispensed")
 
+	if !apiRunning {
+		// the next provider must find the new server up
+		if err = proc.waitUp(); err != nil {
+			log.Printf("[ERROR] Plugin start Error: %s", err)
+			rpcClient.Close()
+			client.Kill()
+			return nil, err
+		}
+	}
+
+	// save for reattach by later provider runs, the launched process is
+	// only a client of the server if that was already running
+	if config := client.ReattachConfig(); config != nil {
+		reattach := *config
+		reattach.Pid = proc.pid()
+		if err = proc.saveReattach(&reattach); err != nil {
+			log.Printf("[WARN] could not save python API reattach config, got: %s", err)
+		}
+	}
+
 	// We should have an API now! This feels like a normal interface
 	// implementation but is in fact over an RPC connection.
 	apiplug := raw.(grpcpyapi.PythonAPI)
 
 	log.Printf("[INFO] client plugin interface taken")
 
-	return &NetAppAPI{
-		PythonAPI: apiplug,
-		client:    client,
-		clientID:  clientID,
-	}, nil
+	ctx, cancel := context.WithTimeout(context.Background(), apiVersionTimeout)
+	defer cancel()
+	commands, err := checkVersion(ctx, apiplug)
+	if err != nil {
+		log.Printf("[ERROR] Plugin version Error: %s", err)
+		rpcClient.Close()
+		client.Kill()
+		return nil, err
+	}
+
+	api := &NetAppAPI{
+		impl:     apiplug,
+		client:   client,
+		clientID: clientID,
+		session:  clientID,
+		folder:   folder,
+		pid:      proc.pid(),
+		commands: commands,
+	}
+	api.forwardLogs(forwardLevel())
+
+	return api, nil
 }
@@ -24,7 +24,7 @@ type KeyValueResponse struct {
 }
 
 // TestKeyValue executes a KeyValue API test call
-func testKeyValue(client *NetAppAPI, request *KeyValueRequest) (*KeyValueResponse, error) {
+func testKeyValue(client Backend, request *KeyValueRequest) (*KeyValueResponse, error) {
 	resp := KeyValueResponse{}
 	err := MakeAPICall(client, testKeyValueCmd, request, &resp)
 	return &resp, err
@@ -54,7 +54,7 @@ func Test_Python_Api_Create(t *testing.T) {
 	r := require.New(t)
 	api, err := CreateAPI(
 		tmpDir, "/home/gmueller/software/netapp/netapp-manageability-sdk-9.4",
-		"56789", "1234")
+		"56789", "1234", "", LogConfig{})
 
 	r.NoError(err)
 
@@ -68,7 +68,7 @@ func Test_Python_Api_MultiCreate(t *testing.T) {
 	r := require.New(t)
 	api, err := CreateAPI(
 		tmpDir, "/home/gmueller/software/netapp/netapp-manageability-sdk-9.4",
-		"56789", "1234")
+		"56789", "1234", "", LogConfig{})
 	r.NoError(err)
 
 	rwTest(api, r, "testy-multi", "testing")
@@ -77,7 +77,7 @@ func Test_Python_Api_MultiCreate(t *testing.T) {
 	for i := 1; i < 6; i++ {
 		addapi, err := CreateAPI(
 			tmpDir, "/home/gmueller/software/netapp/netapp-manageability-sdk-9.4",
-			"56789", "1234")
+			"56789", "1234", "", LogConfig{})
 		r.NoError(err)
 
 		rwTest(addapi, r, fmt.Sprintf("testy-multi-%d", i), fmt.Sprintf("testing-%d", i))
@@ -0,0 +1,136 @@
+package pythonapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// redacted replaces secret values in logs and cassettes
+const redacted = "REDACTED"
+
+// secretTag marks request fields whose values are never logged, e.g.
+// Password string `json:"pwd" netapp:"secret"`
+const secretTag = "secret"
+
+// secretKeys are JSON keys (lower case substring match) whose values are
+// never logged, also for data without tagged struct, the Python API
+// redacts the same keys, see redact.py
+var secretKeys = []string{"password", "passwd", "pwd", "secret", "token", "private_key"}
+
+func isSecretKey(key string, tagged map[string]bool) bool {
+	if tagged[key] {
+		return true
+	}
+
+	lKey := strings.ToLower(key)
+	for _, sKey := range secretKeys {
+		if strings.Contains(lKey, sKey) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// taggedKeys adds the JSON keys of the secret tagged fields of the type
+// and its nested types, seen stops at recursive types
+func taggedKeys(typ reflect.Type, keys map[string]bool, seen map[reflect.Type]bool) {
+	for typ.Kind() == reflect.Ptr || typ.Kind() == reflect.Slice ||
+		typ.Kind() == reflect.Array || typ.Kind() == reflect.Map {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Struct || seen[typ] {
+		return
+	}
+	seen[typ] = true
+
+	for idx := 0; idx < typ.NumField(); idx++ {
+		field := typ.Field(idx)
+		key := strings.Split(field.Tag.Get("json"), ",")[0]
+		if key == "" {
+			key = field.Name
+		}
+
+		if field.Tag.Get("netapp") == secretTag {
+			keys[key] = true
+		} else {
+			taggedKeys(field.Type, keys, seen)
+		}
+	}
+}
+
+func redactValue(value interface{}, tagged map[string]bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if isSecretKey(key, tagged) {
+				v[key] = redacted
+			} else {
+				v[key] = redactValue(val, tagged)
+			}
+		}
+	case []interface{}:
+		for idx, val := range v {
+			v[idx] = redactValue(val, tagged)
+		}
+	}
+
+	return value
+}
+
+func redactJSON(data []byte, tagged map[string]bool) ([]byte, error) {
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(redactValue(value, tagged))
+}
+
+// Redact returns the JSON data with all secret values replaced, the
+// object keys of the result are sorted so equal data compares equal
+func Redact(data []byte) ([]byte, error) {
+	return redactJSON(data, nil)
+}
+
+// secretTagged returns the JSON keys of the secret tagged fields of the
+// values types
+func secretTagged(values ...interface{}) map[string]bool {
+	tagged := map[string]bool{}
+	seen := map[reflect.Type]bool{}
+	for _, value := range values {
+		if value != nil {
+			taggedKeys(reflect.TypeOf(value), tagged, seen)
+		}
+	}
+
+	return tagged
+}
+
+// MarshalRedacted JSON marshals the value with the secret keys and the
+// secret tagged fields redacted
+func MarshalRedacted(value interface{}) (json.RawMessage, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return redactJSON(data, secretTagged(value))
+}
+
+// redactedData returns the JSON data for log messages, never its secrets,
+// the data is e.g. the response of a call with the request and response
+// as values
+func redactedData(data []byte, values ...interface{}) string {
+	redactedData, err := redactJSON(data, secretTagged(values...))
+	if err != nil {
+		return fmt.Sprintf("<%d bytes invalid JSON>", len(data))
+	}
+
+	return string(redactedData)
+}
@@ -0,0 +1,144 @@
+package pythonapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "s3cr3t-pa55"
+
+// secretRequest has a secret tagged field without secret key name
+type secretRequest struct {
+	Login  string           `json:"login" netapp:"secret"`
+	Name   string           `json:"name"`
+	Nested []*secretRequest `json:"nested,omitempty"`
+}
+
+func Test_Redact_Tagged(t *testing.T) {
+	r := require.New(t)
+
+	data, err := MarshalRedacted(&secretRequest{
+		Login: testSecret, Name: "n",
+		Nested: []*secretRequest{{Login: testSecret, Name: "m"}}})
+	r.NoError(err)
+	r.Equal(
+		`{"login":"REDACTED","name":"n","nested":[{"login":"REDACTED","name":"m"}]}`,
+		string(data))
+
+	// secret keys without tag
+	data, err = MarshalRedacted(map[string]string{"pwd": testSecret, "user": "admin"})
+	r.NoError(err)
+	r.Equal(`{"pwd":"REDACTED","user":"admin"}`, string(data))
+}
+
+func Test_Redact_CallLog(t *testing.T) {
+	r := require.New(t)
+
+	var out bytes.Buffer
+	log.SetOutput(&out)
+	defer log.SetOutput(os.Stderr)
+
+	// echoed login does not unmarshal into the response
+	resp := struct {
+		Login int `json:"login"`
+	}{}
+	err := MakeAPICall(NewNetAppAPI(&fakeImpl{}), testKeyValueCmd,
+		&secretRequest{Login: testSecret}, &resp)
+	r.Error(err)
+	r.NotContains(err.Error(), testSecret)
+
+	// request with unsupported type, not marshalled
+	err = MakeAPICall(NewNetAppAPI(&fakeImpl{}), testKeyValueCmd,
+		map[string]interface{}{"pwd": testSecret, "ch": make(chan int)}, &resp)
+	r.Error(err)
+	r.NotContains(err.Error(), testSecret)
+
+	r.Contains(out.String(), `"login":"REDACTED"`)
+	r.NotContains(out.String(), testSecret)
+}
+
+// naServerStub fails every ZAPI call with an error quoting the password
+const naServerStub = `
+class NaElement(object):
+    def __init__(self, name):
+        self.name = name
+
+class NaResult(object):
+    def __init__(self, reason):
+        self.reason = reason
+    def results_errno(self):
+        return '13002'
+    def child_get_string(self, key):
+        return None
+    def sprintf(self):
+        return self.reason
+
+class NaServer(object):
+    def __init__(self, host, major, minor):
+        self.pwd = ''
+    def set_admin_user(self, user, pwd):
+        self.pwd = pwd
+    def invoke_elem(self, request):
+        return NaResult('login with password ' + self.pwd + ' failed')
+    def __getattr__(self, name):
+        return lambda *args: None
+`
+
+// logConnect connects through the API commands like grpcapi.py with its
+// file log configuration
+const logConnect = `
+import logging, sys
+from redact import SECRET_FILTER
+handler = logging.FileHandler('python_api.log')
+handler.addFilter(SECRET_FILTER)
+logging.getLogger().setLevel(logging.DEBUG)
+logging.getLogger().addHandler(handler)
+
+from apicmd import NetAppCommandExecutor
+succ, errmsg, data, errno = NetAppCommandExecutor().execute(
+    'SYS.CONNECT', sys.argv[1].encode())
+print(errmsg)
+`
+
+func Test_Redact_PythonLog(t *testing.T) {
+	python, err := exec.LookPath("python3")
+	if err != nil {
+		t.Skip("python3 required for the python API log")
+	}
+	r := require.New(t)
+
+	folder := filepath.Join(t.TempDir(), "api")
+	_, err = SynchBoxToOS(folder, &requiredAPIScripts)
+	r.NoError(err)
+
+	sdkroot := t.TempDir()
+	sdklib := filepath.Join(sdkroot, "lib", "python", "NetApp")
+	r.NoError(os.MkdirAll(sdklib, 0750))
+	r.NoError(ioutil.WriteFile(
+		filepath.Join(sdklib, "NaServer.py"), []byte(naServerStub), 0640))
+
+	request, err := json.Marshal(map[string]string{
+		"host": "cluster", "user": "admin", "pwd": testSecret})
+	r.NoError(err)
+
+	cmd := exec.Command(python, "-c", logConnect, string(request))
+	cmd.Dir = folder
+	cmd.Env = append(os.Environ(), "NETAPP_MSDK_ROOT_PATH="+sdkroot)
+	errmsg, err := cmd.CombinedOutput()
+	r.NoError(err, string(errmsg))
+	r.Contains(string(errmsg), "login with password REDACTED failed")
+
+	apiLog, err := ioutil.ReadFile(filepath.Join(folder, "python_api.log"))
+	r.NoError(err)
+	r.Contains(string(apiLog), "'pwd': 'REDACTED'")
+	r.Contains(string(apiLog), "login with password REDACTED failed")
+	r.NotContains(string(apiLog), testSecret)
+}
@@ -0,0 +1,185 @@
+package pythonapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// transientMessages match the ONTAP messages of commands rejected for the
+// time being without busy errno, e.g. while the ports of a broadcast
+// domain are moved. Other failures mentioning a lock, e.g. a locked user
+// account, are not transient
+var transientMessages = []*regexp.Regexp{
+	regexp.MustCompile(`\bport (move|update) operation (is )?(still )?in progress\b`),
+	regexp.MustCompile(`\banother [a-z -]*operation is (currently |already )?in progress\b`),
+	regexp.MustCompile(`\b(please )?(try again|retry the operation) later\b`),
+	regexp.MustCompile(`\btimed out\b`),
+}
+
+// readCommands are the read-only commands besides the *.GET commands
+var readCommands = map[string]bool{
+	"SYS.CONNECT":           true,
+	"SYS.CONNECT.INFO":      true,
+	"SYS.PORT.FIND.PATTERN": true,
+	"NW.BRCDOM.STATUS":      true,
+}
+
+// retrySafeCommands are mutating commands with the same result if they
+// are repeated after ONTAP rejected them, creates, deletes and renames
+// are never retried
+var retrySafeCommands = map[string]bool{
+	"SYS.PORT.MODIFY":           true,
+	"SYS.PORTGROUP.PORT.ADD":    true,
+	"SYS.PORTGROUP.PORT.REMOVE": true,
+	"NW.BRCDOM.PORT.ADD":        true,
+	"NW.BRCDOM.PORT.REMOVE":     true,
+	"NW.BRCDOM.UPDATE":          true,
+	"NW.SUBNET.MODIFY":          true,
+	"SVM.START":                 true,
+	"SVM.STOP":                  true,
+	"SVM.VOL.ONLINE":            true,
+	"SVM.VOL.OFFLINE":           true,
+	"SVM.VOL.RESTRICT":          true,
+}
+
+// isRead reports whether the command only reads, all others mutate
+func isRead(cmdName string) bool {
+	return strings.HasSuffix(cmdName, ".GET") || readCommands[cmdName]
+}
+
+// IsTransient reports whether the API command failed for the time being,
+// e.g. busy, in progress or timed out, and might succeed if repeated
+func IsTransient(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	if errors.Is(err, ErrBusy) || apiErr.Errno == ErrnoTimedOut {
+		return true
+	}
+
+	msg := strings.ToLower(apiErr.Msg)
+	for _, transient := range transientMessages {
+		if transient.MatchString(msg) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// timedOut reports whether the transient error might have been executed
+func timedOut(err error) bool {
+	return Errno(err) == ErrnoTimedOut ||
+		strings.Contains(strings.ToLower(err.Error()), "timed out")
+}
+
+// retryable reports whether the failed command can be repeated, reads on
+// every transient error, safe mutations only if they were rejected
+func retryable(cmdName string, err error) bool {
+	if !IsTransient(err) {
+		return false
+	}
+	if isRead(cmdName) {
+		return true
+	}
+
+	return retrySafeCommands[cmdName] && !timedOut(err)
+}
+
+// RetryPolicy is the exponential backoff of the transient API failures
+type RetryPolicy struct {
+	MaxRetries int           // retries after the first call, 0 disables
+	BaseWait   time.Duration // wait before the first retry, doubled per retry
+	MaxWait    time.Duration // upper bound of a single wait
+}
+
+// wait returns the backoff before the retry, half of it is jitter so that
+// concurrent calls rejected together do not retry together
+func (p RetryPolicy) wait(retry int) time.Duration {
+	wait := p.BaseWait << uint(retry)
+	if wait > p.MaxWait || wait <= 0 {
+		wait = p.MaxWait
+	}
+	if wait <= 0 {
+		return 0
+	}
+
+	half := wait / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// Retry returns a middleware repeating calls failed with a transient error
+// as long as the command can be repeated safely, see retryable. Waits end
+// early once parent is done, e.g. on Terraform interrupt. The failed items
+// of a batch are repeated as single calls, job watches are not repeated
+func Retry(parent context.Context, policy RetryPolicy) Middleware {
+	return func(next Backend) Backend {
+		// retry repeats the call failed with err
+		retry := func(
+			ctx context.Context, cmdName string,
+			request, response interface{}, err error) error {
+
+			for retry := 0; retry < policy.MaxRetries && err != nil; retry++ {
+				if !retryable(cmdName, err) {
+					return err
+				}
+
+				wait := policy.wait(retry)
+				log.Printf("[WARN] api call [%s] failed transient, retry %d/%d in %s, got: %s",
+					cmdName, retry+1, policy.MaxRetries, wait, err)
+
+				timer := time.NewTimer(wait)
+				select {
+				case <-parent.Done():
+					timer.Stop()
+					return fmt.Errorf("api call [%s] retry cancelled: %s", cmdName, err)
+				case <-ctx.Done():
+					timer.Stop()
+					return fmt.Errorf("api call [%s] retry cancelled: %s", cmdName, err)
+				case <-timer.C:
+				}
+
+				err = next.Call(ctx, cmdName, request, response)
+			}
+
+			return err
+		}
+
+		call := BackendFunc(func(
+			ctx context.Context, cmdName string,
+			request, response interface{}) error {
+			return retry(ctx, cmdName, request, response,
+				next.Call(ctx, cmdName, request, response))
+		})
+
+		watch := func(next JobWatcher) WatchFunc {
+			return next.WatchJob
+		}
+
+		batch := func(next Batcher) BatchFunc {
+			return func(ctx context.Context, items []*BatchItem) error {
+				if err := next.CallBatch(ctx, items); err != nil {
+					return err
+				}
+
+				for _, item := range items {
+					if item.Err != nil {
+						item.Err = retry(
+							ctx, item.Cmd, item.Request, item.Response, item.Err)
+					}
+				}
+
+				return nil
+			}
+		}
+
+		return extend(call, next, watch, batch)
+	}
+}
@@ -0,0 +1,145 @@
+package pythonapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// flakyBackend fails the first calls with err, then succeeds
+type flakyBackend struct {
+	fails int
+	err   error
+	calls []string
+}
+
+func (b *flakyBackend) Call(
+	ctx context.Context, cmdName string,
+	request, response interface{}) error {
+	b.calls = append(b.calls, cmdName)
+	if len(b.calls) <= b.fails {
+		return b.err
+	}
+
+	return nil
+}
+
+var testRetryPolicy = RetryPolicy{
+	MaxRetries: 3, BaseWait: time.Millisecond, MaxWait: 4 * time.Millisecond}
+
+func Test_Retry_Transient(t *testing.T) {
+	r := require.New(t)
+
+	for _, err := range []error{
+		NewAPIError("NW.BRCDOM.PORT.ADD", ErrnoBusy, "busy"),
+		NewAPIError("NW.BRCDOM.PORT.ADD", 13001, "port move operation in progress"),
+		NewAPIError("NW.BRCDOM.PORT.ADD", 13001, "Another port update operation is in progress"),
+		NewAPIError("NW.BRCDOM.PORT.ADD", 0, "Cluster is busy, try again later."),
+	} {
+		backend := &flakyBackend{fails: 2, err: err}
+		api := Wrap(backend, Retry(context.Background(), testRetryPolicy))
+
+		r.NoError(api.Call(context.Background(), "NW.BRCDOM.PORT.ADD", nil, nil))
+		r.Len(backend.calls, 3)
+	}
+
+	// gives up after MaxRetries
+	backend := &flakyBackend{fails: 10, err: NewAPIError("SYS.NODE.GET", ErrnoBusy, "busy")}
+	api := Wrap(backend, Retry(context.Background(), testRetryPolicy))
+	err := api.Call(context.Background(), "SYS.NODE.GET", nil, nil)
+	r.True(errors.Is(err, ErrBusy))
+	r.Len(backend.calls, 4)
+}
+
+func Test_Retry_NotRetryable(t *testing.T) {
+	r := require.New(t)
+
+	for _, test := range []struct {
+		cmd string
+		err error
+	}{
+		// not transient
+		{"SYS.NODE.GET", NewAPIError("SYS.NODE.GET", ErrnoObjectNotFound, "gone")},
+		{"SYS.NODE.GET", errors.New("connection refused")},
+		{"SYS.NODE.GET", NewAPIError("SYS.NODE.GET", 13001, "user account is locked")},
+		{"NW.BRCDOM.PORT.ADD", NewAPIError(
+			"NW.BRCDOM.PORT.ADD", 13001, "port is locked by another owner")},
+		{"SYS.NODE.GET", NewAPIError("SYS.NODE.GET", 13001, "job is not in progress")},
+		// no safe retry for creates, deletes and renames
+		{"SVM.CREATE", NewAPIError("SVM.CREATE", ErrnoBusy, "busy")},
+		{"NW.VLAN.DELETE", NewAPIError("NW.VLAN.DELETE", ErrnoBusy, "busy")},
+		// a timed out mutation might have been executed
+		{"NW.BRCDOM.PORT.ADD", NewAPIError("NW.BRCDOM.PORT.ADD", ErrnoTimedOut, "timed out")},
+	} {
+		backend := &flakyBackend{fails: 1, err: test.err}
+		api := Wrap(backend, Retry(context.Background(), testRetryPolicy))
+
+		r.Equal(test.err, api.Call(context.Background(), test.cmd, nil, nil), test.cmd)
+		r.Len(backend.calls, 1, test.cmd)
+	}
+
+	// reads are retried on timeouts
+	backend := &flakyBackend{fails: 1, err: NewAPIError("SVM.GET", ErrnoTimedOut, "timed out")}
+	api := Wrap(backend, Retry(context.Background(), testRetryPolicy))
+	r.NoError(api.Call(context.Background(), "SVM.GET", nil, nil))
+	r.Len(backend.calls, 2)
+
+	// disabled
+	backend = &flakyBackend{fails: 1, err: NewAPIError("SVM.GET", ErrnoBusy, "busy")}
+	api = Wrap(backend, Retry(context.Background(), RetryPolicy{}))
+	r.Error(api.Call(context.Background(), "SVM.GET", nil, nil))
+	r.Len(backend.calls, 1)
+}
+
+func Test_Retry_Backoff(t *testing.T) {
+	r := require.New(t)
+	policy := RetryPolicy{MaxRetries: 10, BaseWait: time.Second, MaxWait: 8 * time.Second}
+
+	for retry, max := range []time.Duration{
+		time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 8 * time.Second} {
+		for i := 0; i < 20; i++ {
+			wait := policy.wait(retry)
+			r.True(wait >= max/2 && wait <= max, "retry %d wait %s", retry, wait)
+		}
+	}
+
+	// the shift overflow is bounded by MaxWait as well
+	wait := policy.wait(100)
+	r.True(wait >= 4*time.Second && wait <= 8*time.Second, "overflow wait %s", wait)
+}
+
+func Test_Retry_Cancel(t *testing.T) {
+	r := require.New(t)
+
+	parent, cancel := context.WithCancel(context.Background())
+	backend := &flakyBackend{fails: 10, err: NewAPIError("SVM.GET", ErrnoBusy, "busy")}
+	api := Wrap(backend, Retry(parent, RetryPolicy{
+		MaxRetries: 3, BaseWait: time.Minute, MaxWait: time.Minute}))
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+
+	start := time.Now()
+	err := api.Call(context.Background(), "SVM.GET", nil, nil)
+	r.Error(err)
+	r.Contains(err.Error(), "api call [SVM.GET] retry cancelled")
+	r.True(time.Since(start) < time.Second)
+	r.Len(backend.calls, 1)
+}
+
+func Test_Retry_JobWatch(t *testing.T) {
+	r := require.New(t)
+
+	api := Wrap(hungWatcher{hungBackend}, Retry(context.Background(), testRetryPolicy))
+	watcher, ok := api.(JobWatcher)
+	r.True(ok)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	r.Equal(context.DeadlineExceeded, watcher.WatchJob(ctx, nil, nil, nil))
+}
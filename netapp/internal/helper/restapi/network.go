@@ -0,0 +1,885 @@
+package restapi
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/network"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/rest"
+)
+
+func init() {
+	registerCommands(map[string]command{
+		"NW.VLAN.GET":    vlanGet,
+		"NW.VLAN.CREATE": vlanCreate,
+		"NW.VLAN.DELETE": vlanDelete,
+
+		"NW.IPSPACE.GET":    ipspaceGet,
+		"NW.IPSPACE.CREATE": ipspaceCreate,
+		"NW.IPSPACE.DELETE": ipspaceDelete,
+		"NW.IPSPACE.UPDATE": ipspaceUpdate,
+
+		"NW.BRCDOM.GET":         bcDomainGet,
+		"NW.BRCDOM.STATUS":      bcDomainStatus,
+		"NW.BRCDOM.CREATE":      bcDomainCreate,
+		"NW.BRCDOM.DELETE":      bcDomainDelete,
+		"NW.BRCDOM.RENAME":      bcDomainRename,
+		"NW.BRCDOM.PORT.ADD":    bcDomainPortAdd,
+		"NW.BRCDOM.PORT.REMOVE": bcDomainPortRemove,
+		"NW.BRCDOM.UPDATE":      bcDomainUpdate,
+
+		"NW.SUBNET.GET":        subnetGet,
+		"NW.SUBNET.CREATE":     subnetCreate,
+		"NW.SUBNET.DELETE":     subnetDelete,
+		"NW.SUBNET.RENAME":     subnetRename,
+		"NW.SUBNET.IPR.ADD":    subnetIPRangeAdd,
+		"NW.SUBNET.IPR.REMOVE": subnetIPRangeRemove,
+		"NW.SUBNET.MODIFY":     subnetModify,
+	})
+}
+
+const (
+	portsPath     = "/api/network/ethernet/ports"
+	ipspacesPath  = "/api/network/ipspaces"
+	bcDomainsPath = "/api/network/ethernet/broadcast-domains"
+	subnetsPath   = "/api/network/ip/subnets"
+
+	// REST port updates are synchronous, report the ZAPI 'done' status
+	portUpdateComplete = "complete"
+
+	defaultIPSpace = "Default"
+)
+
+// portUUID returns the UUID of the qualified port 'node:port'
+func portUUID(s *session, qualifiedName string) (string, error) {
+	node, port, err := splitPortName(qualifiedName)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := getPorts(s, "uuid", "node.name", node, "name", port)
+	if err != nil {
+		return "", err
+	}
+	if err := singleRecord(resp.NumRecords, "port", qualifiedName); err != nil {
+		return "", err
+	}
+
+	return resp.Records[0].UUID, nil
+}
+
+//*****************************************************************************
+// VLAN commands
+
+func decodeVlanRequest(data interface{}, request *network.VlanRequest) error {
+	if err := decodeRequest(data, request); err != nil {
+		return err
+	}
+
+	if request.ParentName == "" || request.VlanID == "" {
+		return fmt.Errorf(
+			"vlan request must have parent name and vlan id defined, got: %+v",
+			*request)
+	}
+
+	return nil
+}
+
+func getVlans(s *session, request *network.VlanRequest) (*portsResponse, error) {
+	return getPorts(s, "uuid,name,node,vlan",
+		"type", "vlan",
+		"node.name", request.NodeName,
+		"vlan.base_port.name", request.ParentName,
+		"vlan.tag", request.VlanID)
+}
+
+func vlanGet(s *session, data interface{}) (interface{}, error) {
+	request := network.VlanRequest{}
+	if err := decodeVlanRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	resp, err := getVlans(s, &request)
+	if err != nil {
+		return nil, err
+	}
+	if resp.NumRecords == 0 {
+		return nonExistResponse(), nil
+	}
+
+	vlan := resp.Records[0]
+	info := &network.VlanInfo{Name: vlan.Name}
+	info.NodeName = vlan.Node.Name
+	if vlan.Vlan != nil {
+		info.ParentName = vlan.Vlan.BasePort.Name
+		info.VlanID = strconv.Itoa(vlan.Vlan.Tag)
+	}
+
+	return info, nil
+}
+
+func vlanCreate(s *session, data interface{}) (interface{}, error) {
+	request := network.VlanRequest{}
+	if err := decodeVlanRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.NodeName == "" {
+		return nil, fmt.Errorf(
+			"create vlan request must have node name defined, got: %+v", request)
+	}
+	tag, err := strconv.Atoi(request.VlanID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vlan id [%s], got: %s", request.VlanID, err)
+	}
+
+	existing, err := getVlans(s, &request)
+	if err != nil {
+		return nil, err
+	}
+	if existing.NumRecords > 0 {
+		// conflict is the ZAPI duplicate entry, the vlan resource adopts
+		// the vlan or hints at its import
+		return nil, &rest.Error{
+			Method: "POST", Path: portsPath, Status: http.StatusConflict,
+			Message: "duplicate entry",
+		}
+	}
+
+	node := rest.NameRef{Name: request.NodeName}
+	err = s.client.Post(portsPath, nil, map[string]interface{}{
+		"type": "vlan",
+		"node": node,
+		"vlan": map[string]interface{}{
+			"tag": tag,
+			"base_port": map[string]interface{}{
+				"name": request.ParentName,
+				"node": node,
+			},
+		},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return emptyResponse(), nil
+}
+
+func vlanDelete(s *session, data interface{}) (interface{}, error) {
+	request := network.VlanRequest{}
+	if err := decodeVlanRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	resp, err := getVlans(s, &request)
+	if err != nil {
+		return nil, err
+	}
+	if err := singleRecord(resp.NumRecords, "vlan", request); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.client.Delete(portsPath + "/" + resp.Records[0].UUID); err != nil {
+		return nil, err
+	}
+
+	return emptyResponse(), nil
+}
+
+//*****************************************************************************
+// IPSpace commands
+
+type namedRecords struct {
+	rest.Response
+	Records []rest.NameRef `json:"records"`
+}
+
+func (r *namedRecords) names() []string {
+	names := []string{}
+	for _, record := range r.Records {
+		names = append(names, record.Name)
+	}
+
+	return names
+}
+
+// findUUID returns the UUID of the single object found for the filters
+func findUUID(s *session, path, kind string, filters ...string) (string, error) {
+	resp := namedRecords{}
+	if err := s.client.Get(path, query("uuid,name", filters...), &resp); err != nil {
+		return "", err
+	}
+	if err := singleRecord(resp.NumRecords, kind, filters); err != nil {
+		return "", err
+	}
+
+	return resp.Records[0].UUID, nil
+}
+
+func ipspaceGet(s *session, data interface{}) (interface{}, error) {
+	request := network.IPSpaceRequest{}
+	if err := decodeRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.UUID == "" && request.Name == "" {
+		return nil, fmt.Errorf(
+			"get IPSpace request must have uuid or name defined, got: %+v",
+			request)
+	}
+
+	resp := namedRecords{}
+	err := s.client.Get(ipspacesPath, query("name,uuid",
+		"uuid", request.UUID, "name", request.Name), &resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.NumRecords == 0 {
+		return nonExistResponse(), nil
+	}
+	if err := singleRecord(resp.NumRecords, "ipspace", request); err != nil {
+		return nil, err
+	}
+
+	info := &network.IPSpaceInfo{
+		Name: resp.Records[0].Name,
+		UUID: resp.Records[0].UUID,
+	}
+
+	bcDomains := namedRecords{}
+	err = s.client.Get(bcDomainsPath, query("name", "ipspace.name", info.Name), &bcDomains)
+	if err != nil {
+		return nil, err
+	}
+	info.BroadCastDomains = bcDomains.names()
+
+	ports, err := getPorts(s, "name,node", "broadcast_domain.ipspace.name", info.Name)
+	if err != nil {
+		return nil, err
+	}
+	info.Ports = []string{}
+	for _, port := range ports.Records {
+		info.Ports = append(info.Ports, port.Node.Name+":"+port.Name)
+	}
+
+	svms := namedRecords{}
+	if err = s.client.Get(svmsPath, query("name", "ipspace.name", info.Name), &svms); err != nil {
+		return nil, err
+	}
+	info.VServers = svms.names()
+
+	return info, nil
+}
+
+func decodeIPSpaceRequest(data interface{}, request *network.IPSpaceRequest) error {
+	if err := decodeRequest(data, request); err != nil {
+		return err
+	}
+
+	if request.Name == "" {
+		return fmt.Errorf(
+			"ipspace request must have name defined, got: %+v", *request)
+	}
+
+	return nil
+}
+
+func ipspaceCreate(s *session, data interface{}) (interface{}, error) {
+	request := network.IPSpaceRequest{}
+	if err := decodeIPSpaceRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	resp := namedRecords{}
+	err := s.client.Post(ipspacesPath, query("", "return_records", "true"),
+		map[string]string{"name": request.Name}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Records) < 1 {
+		return nil, fmt.Errorf(
+			"no ipspace info received from create, got: %+v", resp)
+	}
+
+	return &network.IPSpaceInfo{
+		Name: request.Name,
+		UUID: resp.Records[0].UUID,
+	}, nil
+}
+
+func ipspaceDelete(s *session, data interface{}) (interface{}, error) {
+	request := network.IPSpaceRequest{}
+	if err := decodeIPSpaceRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	uuid, err := findUUID(s, ipspacesPath, "ipspace", "name", request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.client.Delete(ipspacesPath + "/" + uuid); err != nil {
+		return nil, err
+	}
+
+	return emptyResponse(), nil
+}
+
+func ipspaceUpdate(s *session, data interface{}) (interface{}, error) {
+	request := network.IPSpaceRequest{}
+	if err := decodeIPSpaceRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.NewName == "" {
+		return nil, fmt.Errorf(
+			"update/rename ipspace must have name and new_name defined, got: %+v",
+			request)
+	}
+
+	uuid, err := findUUID(s, ipspacesPath, "ipspace", "name", request.Name)
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.client.Patch(ipspacesPath+"/"+uuid,
+		map[string]string{"name": request.NewName})
+	if err != nil {
+		return nil, err
+	}
+
+	return emptyResponse(), nil
+}
+
+//*****************************************************************************
+// broadcast domain commands
+
+type bcDomainRecord struct {
+	Name    string       `json:"name"`
+	UUID    string       `json:"uuid"`
+	Mtu     int          `json:"mtu"`
+	IPSpace rest.NameRef `json:"ipspace"`
+	Ports   []struct {
+		Name string       `json:"name"`
+		Node rest.NameRef `json:"node"`
+	} `json:"ports"`
+}
+
+func decodeBcDomainRequest(data interface{}, request *network.BcDomainRequest, ipspace bool) error {
+	if err := decodeRequest(data, request); err != nil {
+		return err
+	}
+
+	if request.Name == "" || (ipspace && request.IPSpace == "") {
+		return fmt.Errorf(
+			"broadcast domain request must have name and ipspace defined, got: %+v",
+			*request)
+	}
+
+	return nil
+}
+
+func bcDomainStatusResponse() interface{} {
+	return &network.BcDomainInfo{PortUpdateStatus: portUpdateComplete}
+}
+
+func getBcDomain(s *session, request *network.BcDomainRequest) (*bcDomainRecord, int, error) {
+	resp := struct {
+		rest.Response
+		Records []bcDomainRecord `json:"records"`
+	}{}
+	err := s.client.Get(bcDomainsPath, query("name,uuid,mtu,ipspace,ports",
+		"name", request.Name, "ipspace.name", request.IPSpace), &resp)
+	if err != nil || resp.NumRecords == 0 {
+		return nil, resp.NumRecords, err
+	}
+	if err := singleRecord(resp.NumRecords, "broadcast domain", *request); err != nil {
+		return nil, resp.NumRecords, err
+	}
+
+	return &resp.Records[0], 1, nil
+}
+
+func bcDomainGet(s *session, data interface{}) (interface{}, error) {
+	request := network.BcDomainRequest{}
+	if err := decodeBcDomainRequest(data, &request, false); err != nil {
+		return nil, err
+	}
+
+	bcDomain, cnt, err := getBcDomain(s, &request)
+	if err != nil {
+		return nil, err
+	}
+	if cnt == 0 {
+		return nonExistResponse(), nil
+	}
+
+	info := &network.BcDomainInfo{
+		Name:             bcDomain.Name,
+		Mtu:              strconv.Itoa(bcDomain.Mtu),
+		IPSpace:          bcDomain.IPSpace.Name,
+		PortUpdateStatus: portUpdateComplete,
+		Ports:            []network.BcDomainPortInfo{},
+		// failover groups are not exposed by the REST API
+		FailoverGroups: []string{},
+	}
+
+	for _, port := range bcDomain.Ports {
+		info.Ports = append(info.Ports, network.BcDomainPortInfo{
+			Name:         port.Node.Name + ":" + port.Name,
+			UpdateStatus: portUpdateComplete,
+		})
+	}
+
+	subnets := namedRecords{}
+	err = s.client.Get(subnetsPath, query("name",
+		"broadcast_domain.name", bcDomain.Name,
+		"ipspace.name", bcDomain.IPSpace.Name), &subnets)
+	if err != nil {
+		return nil, err
+	}
+	info.SubnetNames = subnets.names()
+
+	return info, nil
+}
+
+func bcDomainStatus(s *session, data interface{}) (interface{}, error) {
+	request := network.BcDomainRequest{}
+	if err := decodeBcDomainRequest(data, &request, false); err != nil {
+		return nil, err
+	}
+
+	_, cnt, err := getBcDomain(s, &request)
+	if err != nil {
+		return nil, err
+	}
+	if cnt == 0 {
+		return nonExistResponse(), nil
+	}
+
+	return bcDomainStatusResponse(), nil
+}
+
+// bcDomainAddPorts moves the qualified ports into the broadcast domain
+func bcDomainAddPorts(s *session, name, ipspace string, ports []string) error {
+	for _, port := range ports {
+		uuid, err := portUUID(s, port)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.client.Patch(portsPath+"/"+uuid, map[string]interface{}{
+			"broadcast_domain": map[string]interface{}{
+				"name":    name,
+				"ipspace": rest.NameRef{Name: ipspace},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func bcDomainCreate(s *session, data interface{}) (interface{}, error) {
+	request := network.BcDomainRequest{}
+	if err := decodeBcDomainRequest(data, &request, false); err != nil {
+		return nil, err
+	}
+	mtu, err := strconv.Atoi(request.Mtu)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"broadcast domain create commands must have name and mtu defined, got: %+v",
+			request)
+	}
+	if request.IPSpace == "" {
+		request.IPSpace = defaultIPSpace
+	}
+
+	err = s.client.Post(bcDomainsPath, nil, map[string]interface{}{
+		"name":    request.Name,
+		"mtu":     mtu,
+		"ipspace": rest.NameRef{Name: request.IPSpace},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := bcDomainAddPorts(s, request.Name, request.IPSpace, request.Ports); err != nil {
+		return nil, err
+	}
+
+	return bcDomainStatusResponse(), nil
+}
+
+func bcDomainUUID(s *session, request *network.BcDomainRequest) (string, error) {
+	return findUUID(s, bcDomainsPath, "broadcast domain",
+		"name", request.Name, "ipspace.name", request.IPSpace)
+}
+
+func bcDomainDelete(s *session, data interface{}) (interface{}, error) {
+	request := network.BcDomainRequest{}
+	if err := decodeBcDomainRequest(data, &request, true); err != nil {
+		return nil, err
+	}
+
+	uuid, err := bcDomainUUID(s, &request)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.client.Delete(bcDomainsPath + "/" + uuid); err != nil {
+		return nil, err
+	}
+
+	return bcDomainStatusResponse(), nil
+}
+
+func bcDomainPatch(s *session, request *network.BcDomainRequest, body interface{}) error {
+	uuid, err := bcDomainUUID(s, request)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Patch(bcDomainsPath+"/"+uuid, body)
+	return err
+}
+
+func bcDomainRename(s *session, data interface{}) (interface{}, error) {
+	request := network.BcDomainRequest{}
+	if err := decodeBcDomainRequest(data, &request, true); err != nil {
+		return nil, err
+	}
+	if request.NewName == "" {
+		return nil, fmt.Errorf(
+			"broadcast domain rename commands must have name, new name"+
+				" and ipspace defined, got: %+v", request)
+	}
+
+	err := bcDomainPatch(s, &request, map[string]string{"name": request.NewName})
+	if err != nil {
+		return nil, err
+	}
+
+	return emptyResponse(), nil
+}
+
+func bcDomainPortAdd(s *session, data interface{}) (interface{}, error) {
+	request := network.BcDomainRequest{}
+	if err := decodeBcDomainRequest(data, &request, true); err != nil {
+		return nil, err
+	}
+
+	if err := bcDomainAddPorts(s, request.Name, request.IPSpace, request.Ports); err != nil {
+		return nil, err
+	}
+
+	return bcDomainStatusResponse(), nil
+}
+
+func bcDomainPortRemove(s *session, data interface{}) (interface{}, error) {
+	request := network.BcDomainRequest{}
+	if err := decodeBcDomainRequest(data, &request, true); err != nil {
+		return nil, err
+	}
+
+	// REST ports always belong to a broadcast domain, use the CLI
+	// passthrough to remove them without moving to another domain
+	err := s.client.Post(
+		"/api/private/cli/network/port/broadcast-domain/remove-ports", nil,
+		map[string]interface{}{
+			"broadcast-domain": request.Name,
+			"ipspace":          request.IPSpace,
+			"ports":            request.Ports,
+		}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return bcDomainStatusResponse(), nil
+}
+
+func bcDomainUpdate(s *session, data interface{}) (interface{}, error) {
+	request := network.BcDomainRequest{}
+	if err := decodeBcDomainRequest(data, &request, true); err != nil {
+		return nil, err
+	}
+	mtu, err := strconv.Atoi(request.Mtu)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"broadcast domain update commands must have name, ipspace"+
+				" and mtu defined, got: %+v", request)
+	}
+
+	if err := bcDomainPatch(s, &request, map[string]int{"mtu": mtu}); err != nil {
+		return nil, err
+	}
+
+	return bcDomainStatusResponse(), nil
+}
+
+//*****************************************************************************
+// subnet commands
+
+type ipAddress struct {
+	Address string `json:"address"`
+	Netmask string `json:"netmask,omitempty"`
+}
+
+type ipRange struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+type subnetRecord struct {
+	Name            string       `json:"name"`
+	UUID            string       `json:"uuid"`
+	IPSpace         rest.NameRef `json:"ipspace"`
+	BroadcastDomain rest.NameRef `json:"broadcast_domain"`
+	Subnet          ipAddress    `json:"subnet"`
+	Gateway         string       `json:"gateway"`
+	IPRanges        []ipRange    `json:"ip_ranges"`
+	TotalCount      *int         `json:"total_count"`
+	UsedCount       *int         `json:"used_count"`
+	AvailableCount  *int         `json:"available_count"`
+}
+
+type subnetsResponse struct {
+	rest.Response
+	Records []subnetRecord `json:"records"`
+}
+
+const subnetFields = "name,uuid,ipspace,broadcast_domain,subnet,gateway," +
+	"ip_ranges,total_count,used_count,available_count"
+
+// parseSubnet converts a ZAPI subnet 'address/netmask' to REST
+func parseSubnet(subnet string) (*ipAddress, error) {
+	parts := strings.SplitN(subnet, "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("subnet [%s] must be 'address/netmask'", subnet)
+	}
+
+	return &ipAddress{Address: parts[0], Netmask: parts[1]}, nil
+}
+
+// parseIPRanges converts ZAPI 'start-end' or single address ranges
+func parseIPRanges(ranges []string) []ipRange {
+	result := []ipRange{}
+	for _, ipr := range ranges {
+		parts := strings.SplitN(ipr, "-", 2)
+		if len(parts) == 1 {
+			parts = append(parts, parts[0])
+		}
+		result = append(result, ipRange{Start: parts[0], End: parts[1]})
+	}
+
+	return result
+}
+
+func subnetInfo(subnet *subnetRecord) *network.SubnetInfo {
+	info := &network.SubnetInfo{
+		IPCount:     optInt(subnet.TotalCount),
+		IPUsed:      optInt(subnet.UsedCount),
+		IPAvailable: optInt(subnet.AvailableCount),
+	}
+	info.Name = subnet.Name
+	info.BroadCastDomain = subnet.BroadcastDomain.Name
+	info.Gateway = subnet.Gateway
+	info.IPSpace = subnet.IPSpace.Name
+	info.Subnet = subnet.Subnet.Address + "/" + subnet.Subnet.Netmask
+
+	info.IPRanges = []string{}
+	for _, ipr := range subnet.IPRanges {
+		if ipr.Start == ipr.End {
+			info.IPRanges = append(info.IPRanges, ipr.Start)
+		} else {
+			info.IPRanges = append(info.IPRanges, ipr.Start+"-"+ipr.End)
+		}
+	}
+
+	return info
+}
+
+func decodeSubnetRequest(data interface{}, request *network.SubnetRequest) error {
+	if err := decodeRequest(data, request); err != nil {
+		return err
+	}
+
+	if request.Name == "" || request.IPSpace == "" {
+		return fmt.Errorf(
+			"subnet request must have name and ipspace defined, got: %+v",
+			*request)
+	}
+
+	return nil
+}
+
+func subnetGet(s *session, data interface{}) (interface{}, error) {
+	request := network.SubnetRequest{}
+	if err := decodeRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.Name == "" || request.BroadCastDomain == "" {
+		return nil, fmt.Errorf(
+			"subnet get commands must have name and broadcast domain"+
+				" defined, got: %+v", request)
+	}
+
+	resp := subnetsResponse{}
+	err := s.client.Get(subnetsPath, query(subnetFields,
+		"name", request.Name,
+		"broadcast_domain.name", request.BroadCastDomain), &resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.NumRecords == 0 {
+		return nonExistResponse(), nil
+	}
+	if err := singleRecord(resp.NumRecords, "subnet", request); err != nil {
+		return nil, err
+	}
+
+	return subnetInfo(&resp.Records[0]), nil
+}
+
+func subnetCreate(s *session, data interface{}) (interface{}, error) {
+	request := network.SubnetRequest{}
+	if err := decodeSubnetRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.BroadCastDomain == "" || request.Subnet == "" {
+		return nil, fmt.Errorf(
+			"subnet create commands must have name, broadcast domain,"+
+				" ipspace and subnet defined, got: %+v", request)
+	}
+
+	subnet, err := parseSubnet(request.Subnet)
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"name":             request.Name,
+		"ipspace":          rest.NameRef{Name: request.IPSpace},
+		"broadcast_domain": rest.NameRef{Name: request.BroadCastDomain},
+		"subnet":           subnet,
+	}
+	if request.Gateway != "" {
+		body["gateway"] = request.Gateway
+	}
+	if len(request.IPRanges) > 0 {
+		body["ip_ranges"] = parseIPRanges(request.IPRanges)
+	}
+
+	if err := s.client.Post(subnetsPath, nil, body, nil); err != nil {
+		return nil, err
+	}
+
+	// read back the created subnet for the IP counts
+	resp := subnetsResponse{}
+	err = s.client.Get(subnetsPath, query(subnetFields,
+		"name", request.Name, "ipspace.name", request.IPSpace), &resp)
+	if err != nil {
+		return nil, err
+	}
+	if err := singleRecord(resp.NumRecords, "subnet", request); err != nil {
+		return nil, fmt.Errorf(
+			"no result data for create subnet with input: [%+v] got: %s",
+			request, err)
+	}
+
+	return subnetInfo(&resp.Records[0]), nil
+}
+
+func subnetPatch(s *session, request *network.SubnetRequest, body interface{}) (interface{}, error) {
+	uuid, err := findUUID(s, subnetsPath, "subnet",
+		"name", request.Name, "ipspace.name", request.IPSpace)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.client.Patch(subnetsPath+"/"+uuid, body); err != nil {
+		return nil, err
+	}
+
+	return emptyResponse(), nil
+}
+
+func subnetDelete(s *session, data interface{}) (interface{}, error) {
+	request := network.SubnetRequest{}
+	if err := decodeSubnetRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	uuid, err := findUUID(s, subnetsPath, "subnet",
+		"name", request.Name, "ipspace.name", request.IPSpace)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.client.Delete(subnetsPath + "/" + uuid); err != nil {
+		return nil, err
+	}
+
+	return emptyResponse(), nil
+}
+
+func subnetRename(s *session, data interface{}) (interface{}, error) {
+	request := network.SubnetRequest{}
+	if err := decodeSubnetRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.NewName == "" {
+		return nil, fmt.Errorf(
+			"subnet rename commands must have name, new name and"+
+				" ipspace defined, got: %+v", request)
+	}
+
+	return subnetPatch(s, &request, map[string]string{"name": request.NewName})
+}
+
+func subnetIPRangesModify(s *session, data interface{}, cmdType string) (interface{}, error) {
+	request := network.SubnetRequest{}
+	if err := decodeSubnetRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if len(request.IPRanges) < 1 {
+		return nil, fmt.Errorf(
+			"subnet ip range %s commands must have name, ipspace"+
+				" and ip_ranges defined, got: %+v", cmdType, request)
+	}
+
+	return subnetPatch(s, &request, map[string]interface{}{
+		cmdType + "_ip_ranges": parseIPRanges(request.IPRanges),
+	})
+}
+
+func subnetIPRangeAdd(s *session, data interface{}) (interface{}, error) {
+	return subnetIPRangesModify(s, data, "add")
+}
+
+func subnetIPRangeRemove(s *session, data interface{}) (interface{}, error) {
+	return subnetIPRangesModify(s, data, "remove")
+}
+
+func subnetModify(s *session, data interface{}) (interface{}, error) {
+	request := network.SubnetRequest{}
+	if err := decodeSubnetRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.Gateway == "" && request.Subnet == "" {
+		return nil, fmt.Errorf(
+			"subnet modify must have name, ipspace and either gateway"+
+				" or subnet defined, got: %+v", request)
+	}
+
+	body := map[string]interface{}{}
+	if request.Gateway != "" {
+		body["gateway"] = request.Gateway
+	}
+	if request.Subnet != "" {
+		subnet, err := parseSubnet(request.Subnet)
+		if err != nil {
+			return nil, err
+		}
+		body["subnet"] = subnet
+	}
+
+	return subnetPatch(s, &request, body)
+}
@@ -0,0 +1,329 @@
+package restapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/pythonapi"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/system"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/rest"
+)
+
+const (
+	connectCmd = "SYS.CONNECT"
+	jobGetCmd  = "SYS.JOB.GET"
+)
+
+// jobPollInterval between the job requests of a job watch
+const jobPollInterval = 500 * time.Millisecond
+
+// session is the connected REST client with the async job registry
+type session struct {
+	client *rest.Client
+	jobs   *jobRegistry
+}
+
+// withContext returns a copy of the session using ctx for its requests
+func (s *session) withContext(ctx context.Context) *session {
+	return &session{client: s.client.WithContext(ctx), jobs: s.jobs}
+}
+
+// wait waits for the job of an asynchronous PATCH/DELETE response
+func (s *session) wait(resp *rest.Response, err error) error {
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.WaitJob(resp.Job)
+	return err
+}
+
+// command executes one NetApp API command via REST, the data is the
+// request as passed by the helpers, see decodeRequest
+type command func(s *session, data interface{}) (interface{}, error)
+
+var commands = map[string]command{}
+
+func registerCommands(cmds map[string]command) {
+	for name, cmd := range cmds {
+		commands[name] = cmd
+	}
+}
+
+// jobRegistry maps REST job UUIDs to the integer job IDs
+// used by the helpers, e.g. system.JobWaitDone
+type jobRegistry struct {
+	lock  sync.Mutex
+	next  int
+	uuids map[int]string
+}
+
+func (r *jobRegistry) add(uuid string) int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.uuids == nil {
+		r.uuids = make(map[int]string)
+	}
+	r.next++
+	r.uuids[r.next] = uuid
+	return r.next
+}
+
+func (r *jobRegistry) get(id int) (string, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	uuid, ok := r.uuids[id]
+	return uuid, ok
+}
+
+// NetAppREST implements the NetApp API commands natively in Go via
+// the ONTAP REST API (ONTAP 9.6+) as backend
+type NetAppREST struct {
+	lock       sync.Mutex
+	session    *session
+	httpClient *http.Client
+	connected  bool
+
+	request      system.ConnectRequest
+	ontapVersion string
+	osVersion    string
+}
+
+// CreateAPI returns the NetApp API backend using the ONTAP REST
+// implementation
+func CreateAPI() (*NetAppREST, error) {
+	return &NetAppREST{}, nil
+}
+
+// decodeRequest stores the request data of the helpers in request
+func decodeRequest(data interface{}, request interface{}) error {
+	if err := pythonapi.Convert(data, request); err != nil {
+		return fmt.Errorf("request decode error: %s", err)
+	}
+
+	return nil
+}
+
+func emptyResponse() interface{} {
+	return &pythonapi.EmptyResponse{Dummy: 1}
+}
+
+func nonExistResponse() interface{} {
+	return &pythonapi.ResourceInfo{NonExist: true}
+}
+
+// query creates the collection query for fields and key/value filters
+func query(fields string, filters ...string) url.Values {
+	values := url.Values{}
+	if fields != "" {
+		values.Set("fields", fields)
+	}
+	for idx := 0; idx+1 < len(filters); idx += 2 {
+		if filters[idx+1] != "" {
+			values.Set(filters[idx], filters[idx+1])
+		}
+	}
+
+	return values
+}
+
+// singleRecord checks that a collection query returned exactly one record
+func singleRecord(numRecords int, kind string, request interface{}) error {
+	if numRecords != 1 {
+		return fmt.Errorf(
+			"not exactly one %s found for query: [%+v] got: %d",
+			kind, request, numRecords)
+	}
+
+	return nil
+}
+
+// splitPortName splits a qualified port name 'node:port'
+func splitPortName(name string) (string, string, error) {
+	parts := strings.SplitN(name, ":", 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf(
+			"port name [%s] not qualified as 'node:port'", name)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// ontapiVersion returns the ONTAPI version equivalent for the ONTAP
+// release, e.g. 9.6 is ONTAPI 1.160 and 9.9.1 is ONTAPI 1.191
+func ontapiVersion(major, minor int) string {
+	return fmt.Sprintf("1.%d", 100+10*major+minor)
+}
+
+type clusterInfo struct {
+	Version struct {
+		Full       string `json:"full"`
+		Generation int    `json:"generation"`
+		Major      int    `json:"major"`
+		Minor      int    `json:"minor"`
+	} `json:"version"`
+}
+
+func (api *NetAppREST) connect(ctx context.Context, data interface{}) (interface{}, error) {
+	request := system.ConnectRequest{}
+	if err := decodeRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	api.lock.Lock()
+	defer api.lock.Unlock()
+
+	if api.connected && api.request == request {
+		// already connected and all setup, just return data
+		return &system.ConnectResponse{
+			OntapVersion: api.ontapVersion,
+			OsVersion:    api.osVersion,
+		}, nil
+	}
+
+	api.connected = false
+	tlsConfig, err := request.TLSConfig()
+	if err != nil {
+		return nil, fmt.Errorf("API connect failed with: %s", err)
+	}
+	client := rest.NewClient(&rest.Config{
+		Host: request.Host, User: request.User, Password: request.Password,
+		Transport: strings.ToLower(request.Transport), Port: request.Port,
+		TLSConfig: tlsConfig, HTTPClient: api.httpClient,
+	})
+
+	cluster := clusterInfo{}
+	if err := client.WithContext(ctx).Get("/api/cluster", query("version"), &cluster); err != nil {
+		return nil, fmt.Errorf("API connect failed with: %s", err)
+	}
+	log.Printf("[INFO] REST connected to [%s], OS: %s",
+		request.Host, cluster.Version.Full)
+
+	api.session = &session{client: client, jobs: &jobRegistry{}}
+	api.request = request
+	api.ontapVersion = ontapiVersion(cluster.Version.Major, cluster.Version.Minor)
+	api.osVersion = cluster.Version.Full
+	api.connected = true
+
+	return &system.ConnectResponse{
+		OntapVersion: api.ontapVersion,
+		OsVersion:    api.osVersion,
+	}, nil
+}
+
+func (api *NetAppREST) execute(
+	ctx context.Context, cmdName string, data interface{}) (interface{}, error) {
+	if cmdName == connectCmd {
+		return api.connect(ctx, data)
+	}
+
+	cmd, ok := commands[cmdName]
+	if !ok {
+		return nil, fmt.Errorf("could not get command: %s", cmdName)
+	}
+
+	api.lock.Lock()
+	session := api.session
+	connected := api.connected
+	api.lock.Unlock()
+
+	if !connected {
+		return nil, fmt.Errorf("API not connected, call Connect() first")
+	}
+
+	return cmd(session.withContext(ctx), data)
+}
+
+// statusErrnos maps the HTTP status of failed REST requests to the ZAPI
+// errno of the same error kind
+var statusErrnos = map[int]int{
+	http.StatusUnauthorized: pythonapi.ErrnoAuthFailed,
+	http.StatusForbidden:    pythonapi.ErrnoPrivilege,
+	http.StatusNotFound:     pythonapi.ErrnoObjectNotFound,
+	http.StatusConflict:     pythonapi.ErrnoDuplicateEntry,
+}
+
+// errno returns the ZAPI errno of the REST error in the chain of err
+func errno(err error) int {
+	var restErr *rest.Error
+	if errors.As(err, &restErr) {
+		return statusErrnos[restErr.Status]
+	}
+
+	return 0
+}
+
+// Call executes the named command, the result is stored in response
+func (api *NetAppREST) Call(
+	ctx context.Context, cmdName string,
+	request, response interface{}) error {
+
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("api call [%s] not executed, got: %s", cmdName, err)
+	}
+
+	result, err := api.execute(ctx, cmdName, request)
+	if err != nil {
+		log.Printf("[WARN] REST cmd [%s] failed with: %s", cmdName, err)
+		return pythonapi.NewAPIError(
+			cmdName, errno(err),
+			fmt.Sprintf("failed cmd [%s] with %s", cmdName, err))
+	}
+
+	if err := pythonapi.Convert(result, response); err != nil {
+		return fmt.Errorf(
+			"api call [%s] result conversion error: %s", cmdName, err)
+	}
+
+	return nil
+}
+
+// WatchJob polls the job until it ended, each changed job state is stored
+// in response before update is called
+func (api *NetAppREST) WatchJob(
+	ctx context.Context, request, response interface{},
+	update func() error) error {
+
+	var last system.JobInfo
+	for {
+		result, err := api.execute(ctx, jobGetCmd, request)
+		if err != nil {
+			return pythonapi.NewAPIError(
+				jobGetCmd, errno(err),
+				fmt.Sprintf("failed cmd [%s] with %s", jobGetCmd, err))
+		}
+
+		info := system.JobInfo{}
+		if err := pythonapi.Convert(result, &info); err != nil {
+			return fmt.Errorf("job watch result conversion error: %s", err)
+		}
+		if info != last {
+			if err := pythonapi.Convert(&info, response); err != nil {
+				return fmt.Errorf("job watch result conversion error: %s", err)
+			}
+			if err := update(); err != nil {
+				return err
+			}
+			last = info
+		}
+
+		if system.JobEnded(info.Status) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(jobPollInterval):
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package restapi
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/network"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/pythonapi"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/svm"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/system"
+)
+
+type fakeRequest struct {
+	Method string
+	Path   string
+	Query  string
+	Body   map[string]interface{}
+}
+
+type fakeResponse struct {
+	Status int
+	Body   string
+}
+
+// fakeCluster answers REST requests with canned responses per 'METHOD path'
+type fakeCluster struct {
+	lock      sync.Mutex
+	responses map[string]fakeResponse
+	requests  []fakeRequest
+}
+
+func (f *fakeCluster) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	request := fakeRequest{
+		Method: req.Method, Path: req.URL.Path, Query: req.URL.RawQuery}
+	if data, _ := ioutil.ReadAll(req.Body); len(data) > 0 {
+		json.Unmarshal(data, &request.Body)
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.requests = append(f.requests, request)
+
+	resp, ok := f.responses[req.Method+" "+req.URL.Path]
+	if !ok {
+		resp = fakeResponse{http.StatusNotFound,
+			`{"error":{"message":"API not found","code":"3"}}`}
+	}
+	if resp.Status == 0 {
+		resp.Status = http.StatusOK
+	}
+
+	w.WriteHeader(resp.Status)
+	w.Write([]byte(resp.Body))
+}
+
+func (f *fakeCluster) lastRequest() fakeRequest {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.requests[len(f.requests)-1]
+}
+
+func testAPI(t *testing.T, responses map[string]fakeResponse) (*NetAppREST, *fakeCluster, func()) {
+	responses["GET /api/cluster"] = fakeResponse{Body: `{"version":{"full":` +
+		`"NetApp Release 9.7P2","generation":9,"major":7,"minor":0}}`}
+
+	cluster := &fakeCluster{responses: responses}
+	srv := httptest.NewTLSServer(cluster)
+
+	api := &NetAppREST{httpClient: srv.Client()}
+
+	resp, err := system.Connect(api, &system.ConnectRequest{
+		Host:     strings.TrimPrefix(srv.URL, "https://"),
+		User:     "admin",
+		Password: "secret",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "1.170", resp.OntapVersion)
+	require.Equal(t, "NetApp Release 9.7P2", resp.OsVersion)
+
+	return api, cluster, srv.Close
+}
+
+func Test_REST_NotConnected(t *testing.T) {
+	r := require.New(t)
+	api, err := CreateAPI()
+	r.NoError(err)
+
+	_, err = network.VlanGet(api, &network.VlanRequest{ParentName: "e0c", VlanID: "12"})
+	r.Error(err)
+	r.Contains(err.Error(), "API not connected")
+}
+
+func Test_REST_VlanGet(t *testing.T) {
+	r := require.New(t)
+	api, cluster, done := testAPI(t, map[string]fakeResponse{
+		"GET /api/network/ethernet/ports": {Body: `{"records":[{"uuid":"p-1",` +
+			`"name":"e0c-12","node":{"name":"node-01"},"vlan":{"tag":12,` +
+			`"base_port":{"name":"e0c","node":{"name":"node-01"}}}}],"num_records":1}`},
+	})
+	defer done()
+
+	info, err := network.VlanGet(api, &network.VlanRequest{
+		NodeName: "node-01", ParentName: "e0c", VlanID: "12"})
+	r.NoError(err)
+	r.False(info.NonExist)
+	r.Equal("e0c-12", info.Name)
+	r.Equal("node-01", info.NodeName)
+	r.Equal("e0c", info.ParentName)
+	r.Equal("12", info.VlanID)
+
+	query := cluster.lastRequest().Query
+	r.Contains(query, "type=vlan")
+	r.Contains(query, "vlan.tag=12")
+	r.Contains(query, "node.name=node-01")
+}
+
+func Test_REST_NonExist(t *testing.T) {
+	r := require.New(t)
+	api, _, done := testAPI(t, map[string]fakeResponse{
+		"GET /api/network/ethernet/broadcast-domains": {Body: `{"records":[],"num_records":0}`},
+	})
+	defer done()
+
+	info, err := network.BcDomainGet(api, "bcd1")
+	r.NoError(err)
+	r.True(info.NonExist)
+}
+
+func Test_REST_DuplicateEntry(t *testing.T) {
+	r := require.New(t)
+	api, cluster, done := testAPI(t, map[string]fakeResponse{
+		"GET /api/network/ethernet/ports": {Body: `{"records":[{"uuid":"p-1",` +
+			`"name":"e0c-12"}],"num_records":1}`},
+	})
+	defer done()
+
+	err := network.VlanCreate(api, &network.VlanRequest{
+		NodeName: "node-01", ParentName: "e0c", VlanID: "12"})
+	r.Error(err)
+	r.Contains(err.Error(), "reason=\"duplicate entry\"")
+	// vlan resource create relies on the error kind of the conflict
+	r.True(errors.Is(err, pythonapi.ErrAlreadyExists))
+	r.Equal("GET", cluster.lastRequest().Method)
+}
+
+func Test_REST_SvmCreateJob(t *testing.T) {
+	r := require.New(t)
+	api, cluster, done := testAPI(t, map[string]fakeResponse{
+		"POST /api/svm/svms": {http.StatusAccepted,
+			`{"job":{"uuid":"job-1234"}}`},
+		"GET /api/cluster/jobs/job-1234": {Body: `{"uuid":"job-1234",` +
+			`"state":"success","code":0,"message":"success","svm":{"name":"svm1"}}`},
+	})
+	defer done()
+
+	result, err := svm.Create(api, &svm.Request{
+		Name: "svm1", IPSpace: "Default", RootAggr: "aggr1", RootName: "svm1_root"})
+	r.NoError(err)
+	r.Equal("in_progress", result.Status)
+	r.Equal("svm1", result.Name)
+
+	post := cluster.requests[len(cluster.requests)-1]
+	r.Equal("created by Terraform", post.Body["comment"])
+
+	// the local job id maps to the REST job for the ZAPI job semantics,
+	// the job is watched by the backend
+	var _ pythonapi.JobWatcher = api
+	info, err := system.JobWaitDone(api, result.JobID)
+	r.NoError(err)
+	r.Equal("success", info.Status)
+	r.Equal(0, info.ErrNo)
+	r.Equal("svm1", info.SVM)
+
+	_, err = system.JobGetByID(api, result.JobID+1)
+	r.Error(err)
+
+	_, err = svm.Create(api, &svm.Request{
+		Name: "svm1", IPSpace: "Default", RootAggr: "aggr1", RootName: "other"})
+	r.Error(err)
+	r.Contains(err.Error(), "root volume name")
+}
+
+func Test_REST_VolumeSize(t *testing.T) {
+	r := require.New(t)
+	api, cluster, done := testAPI(t, map[string]fakeResponse{
+		"GET /api/storage/volumes": {Body: `{"records":[{"uuid":"v-1",` +
+			`"name":"svm1_root","size":1073741824}],"num_records":1}`},
+		"PATCH /api/storage/volumes/v-1": {Body: `{}`},
+	})
+	defer done()
+
+	request := &svm.VolumeRequest{VolumeName: "svm1_root"}
+	request.SvmInstanceName = "svm1"
+	info, err := svm.VolumeSizeCommand(api, request)
+	r.NoError(err)
+	r.Equal("1g", info.Size)
+	r.Contains(cluster.lastRequest().Query, "svm.name=svm1")
+
+	request.Size = "2g"
+	info, err = svm.VolumeSizeCommand(api, request)
+	r.NoError(err)
+	r.Equal("2g", info.Size)
+	r.Equal("PATCH", cluster.lastRequest().Method)
+	r.Equal(float64(2<<30), cluster.lastRequest().Body["size"])
+}
@@ -0,0 +1,475 @@
+package restapi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/svm"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/rest"
+)
+
+func init() {
+	registerCommands(map[string]command{
+		"SVM.GET":    svmGet,
+		"SVM.CREATE": svmCreate,
+		"SVM.DELETE": svmDelete,
+		"SVM.START":  svmStateCommand("running"),
+		"SVM.STOP":   svmStateCommand("stopped"),
+		"SVM.UNLOCK": svmUnlock,
+		"SVM.RENAME": svmRename,
+
+		"SVM.VOL.ONLINE":   volumeStateCommand("online"),
+		"SVM.VOL.OFFLINE":  volumeStateCommand("offline"),
+		"SVM.VOL.RESTRICT": volumeStateCommand("restricted"),
+		"SVM.VOL.DELETE":   volumeDelete,
+		"SVM.VOL.SIZE":     volumeSize,
+	})
+}
+
+const (
+	svmsPath    = "/api/svm/svms"
+	volumesPath = "/api/storage/volumes"
+	cliSvmPath  = "/api/private/cli/vserver"
+
+	// the root volume security style of SVMs created via REST
+	defaultRootSecStyle = "unix"
+)
+
+// svmProtocols are the REST SVM protocol services
+var svmProtocols = []string{"cifs", "fcp", "iscsi", "nfs", "nvme"}
+
+type svmRecord struct {
+	Name    string       `json:"name"`
+	UUID    string       `json:"uuid"`
+	State   string       `json:"state"`
+	IPSpace rest.NameRef `json:"ipspace"`
+
+	Cifs  *protocolService `json:"cifs"`
+	Fcp   *protocolService `json:"fcp"`
+	Iscsi *protocolService `json:"iscsi"`
+	Nfs   *protocolService `json:"nfs"`
+	Nvme  *protocolService `json:"nvme"`
+}
+
+type protocolService struct {
+	Enabled bool `json:"enabled"`
+}
+
+func (r *svmRecord) services() map[string]*protocolService {
+	return map[string]*protocolService{
+		"cifs": r.Cifs, "fcp": r.Fcp, "iscsi": r.Iscsi,
+		"nfs": r.Nfs, "nvme": r.Nvme,
+	}
+}
+
+type volumeRecord struct {
+	Name       string         `json:"name"`
+	UUID       string         `json:"uuid"`
+	Size       *int64         `json:"size"`
+	Aggregates []rest.NameRef `json:"aggregates"`
+	Nas        struct {
+		SecurityStyle string `json:"security_style"`
+	} `json:"nas"`
+}
+
+type volumesResponse struct {
+	rest.Response
+	Records []volumeRecord `json:"records"`
+}
+
+func svmGet(s *session, data interface{}) (interface{}, error) {
+	request := svm.Request{}
+	if err := decodeRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.Name == "" && request.UUID == "" {
+		return nil, fmt.Errorf(
+			"get SVM request must have name or uuid defined, got: %+v",
+			request)
+	}
+
+	filters := []string{"name", request.Name}
+	if request.Name == "" {
+		filters = []string{"uuid", request.UUID}
+	}
+
+	resp := struct {
+		rest.Response
+		Records []svmRecord `json:"records"`
+	}{}
+	err := s.client.Get(svmsPath, query(
+		"name,uuid,state,ipspace,"+strings.Join(svmProtocols, ".enabled,")+".enabled",
+		filters...), &resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.NumRecords == 0 {
+		return nonExistResponse(), nil
+	}
+	if err := singleRecord(resp.NumRecords, "SVM", request); err != nil {
+		return nil, err
+	}
+	vserver := resp.Records[0]
+
+	info := &svm.Info{
+		OperState:     vserver.State,
+		SvmState:      vserver.State,
+		ProtoEnabled:  []string{},
+		ProtoInactive: []string{},
+	}
+	info.Name = vserver.Name
+	info.UUID = vserver.UUID
+	info.IPSpace = vserver.IPSpace.Name
+
+	services := vserver.services()
+	for _, proto := range svmProtocols {
+		if service := services[proto]; service != nil && service.Enabled {
+			info.ProtoEnabled = append(info.ProtoEnabled, proto)
+		} else {
+			info.ProtoInactive = append(info.ProtoInactive, proto)
+		}
+	}
+
+	volumes := volumesResponse{}
+	err = s.client.Get(volumesPath, query("name,aggregates,nas.security_style",
+		"svm.name", vserver.Name, "is_svm_root", "true"), &volumes)
+	if err != nil {
+		return nil, err
+	}
+	if volumes.NumRecords > 0 {
+		root := volumes.Records[0]
+		info.RootName = root.Name
+		info.RootSecStyle = root.Nas.SecurityStyle
+		if len(root.Aggregates) > 0 {
+			info.RootAggr = root.Aggregates[0].Name
+		}
+	}
+
+	// volume retention and lock state are only available via the CLI
+	settings := struct {
+		rest.Response
+		Records []struct {
+			Retention *int `json:"volume-delete-retention-hours"`
+			Locked    bool `json:"is-config-locked-for-changes"`
+		} `json:"records"`
+	}{}
+	err = s.client.Get(cliSvmPath, query(
+		"volume-delete-retention-hours,is-config-locked-for-changes",
+		"vserver", vserver.Name), &settings)
+	if err != nil {
+		return nil, err
+	}
+	if settings.NumRecords > 0 {
+		info.RootRetention = optString(settings.Records[0].Retention)
+		info.ConfigLocked = settings.Records[0].Locked
+	}
+
+	return info, nil
+}
+
+// asyncJobResult registers the job of an async SVM request for SYS.JOB.GET
+func asyncJobResult(s *session, job *rest.JobRef, result *svm.JobResult) *svm.JobResult {
+	if job == nil || job.UUID == "" {
+		result.Status = "succeeded"
+		return result
+	}
+
+	result.Status = "in_progress"
+	result.JobID = s.jobs.add(job.UUID)
+	return result
+}
+
+func svmCreate(s *session, data interface{}) (interface{}, error) {
+	request := svm.Request{}
+	if err := decodeRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.Name == "" || request.IPSpace == "" || request.RootAggr == "" {
+		return nil, fmt.Errorf(
+			"create SVM request must have name, ipspace and root aggregate"+
+				" defined, got: %+v", request)
+	}
+
+	// REST always creates the root volume as <name>_root with unix style
+	if request.RootName != "" && request.RootName != request.Name+"_root" {
+		return nil, fmt.Errorf(
+			"REST API root volume name must be [%s_root], got: %s",
+			request.Name, request.RootName)
+	}
+	if request.RootSecStyle != "" && request.RootSecStyle != defaultRootSecStyle {
+		return nil, fmt.Errorf(
+			"REST API root volume security style must be [%s], got: %s",
+			defaultRootSecStyle, request.RootSecStyle)
+	}
+
+	resp := rest.Response{}
+	err := s.client.Post(svmsPath, nil, map[string]interface{}{
+		"name":       request.Name,
+		"ipspace":    rest.NameRef{Name: request.IPSpace},
+		"aggregates": []rest.NameRef{{Name: request.RootAggr}},
+		"comment":    "created by Terraform",
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &svm.JobResult{}
+	result.Name = request.Name
+	result.IPSpace = request.IPSpace
+	result.RootAggr = request.RootAggr
+
+	return asyncJobResult(s, resp.Job, result), nil
+}
+
+func svmUUID(s *session, name string) (string, error) {
+	return findUUID(s, svmsPath, "SVM", "name", name)
+}
+
+func decodeSvmNameRequest(data interface{}, request *svm.Request, cmdType string) error {
+	if err := decodeRequest(data, request); err != nil {
+		return err
+	}
+	if request.Name == "" {
+		return fmt.Errorf(
+			"%s SVM request must have name defined, got: %+v",
+			cmdType, *request)
+	}
+
+	return nil
+}
+
+func svmDelete(s *session, data interface{}) (interface{}, error) {
+	request := svm.Request{}
+	if err := decodeSvmNameRequest(data, &request, "delete"); err != nil {
+		return nil, err
+	}
+
+	uuid, err := svmUUID(s, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Delete(svmsPath + "/" + uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	return asyncJobResult(s, resp.Job, &svm.JobResult{}), nil
+}
+
+func svmStateCommand(state string) command {
+	return func(s *session, data interface{}) (interface{}, error) {
+		request := svm.Request{}
+		if err := decodeSvmNameRequest(data, &request, state); err != nil {
+			return nil, err
+		}
+
+		uuid, err := svmUUID(s, request.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.wait(s.client.Patch(svmsPath+"/"+uuid,
+			map[string]string{"state": state}))
+		if err != nil {
+			return nil, err
+		}
+
+		return emptyResponse(), nil
+	}
+}
+
+func svmUnlock(s *session, data interface{}) (interface{}, error) {
+	request := svm.Request{}
+	if err := decodeSvmNameRequest(data, &request, "unlock"); err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{"vserver": request.Name}
+	// force will only be present if set otherwise ommitted
+	if request.Force != "" {
+		body["force"] = true
+	}
+
+	if err := s.client.Post(cliSvmPath+"/unlock", nil, body, nil); err != nil {
+		return nil, err
+	}
+
+	return emptyResponse(), nil
+}
+
+func svmRename(s *session, data interface{}) (interface{}, error) {
+	request := svm.Request{}
+	if err := decodeRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.Name == "" || request.NewName == "" {
+		return nil, fmt.Errorf(
+			"SVM rename request must have name and new_name defined, got: %+v",
+			request)
+	}
+
+	uuid, err := svmUUID(s, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.wait(s.client.Patch(svmsPath+"/"+uuid,
+		map[string]string{"name": request.NewName}))
+	if err != nil {
+		return nil, err
+	}
+
+	return emptyResponse(), nil
+}
+
+//*****************************************************************************
+// SVM volume commands
+
+func decodeVolumeRequest(data interface{}, request *svm.VolumeRequest) error {
+	if err := decodeRequest(data, request); err != nil {
+		return err
+	}
+
+	if request.SvmInstanceName == "" {
+		return fmt.Errorf(
+			"NetAPP SVM command must have SVM name [svm_name] defined, got: %+v",
+			*request)
+	}
+
+	if request.VolumeName == "" {
+		return fmt.Errorf(
+			"SVM volume request must have name defined, got: %+v", *request)
+	}
+
+	return nil
+}
+
+// getVolume returns the volume of the request SVM
+func getVolume(s *session, request *svm.VolumeRequest) (*volumeRecord, error) {
+	resp := volumesResponse{}
+	err := s.client.Get(volumesPath, query("name,uuid,size",
+		"svm.name", request.SvmInstanceName, "name", request.VolumeName), &resp)
+	if err != nil {
+		return nil, err
+	}
+	if err := singleRecord(resp.NumRecords, "volume", *request); err != nil {
+		return nil, err
+	}
+
+	return &resp.Records[0], nil
+}
+
+func volumeStateCommand(state string) command {
+	return func(s *session, data interface{}) (interface{}, error) {
+		request := svm.VolumeRequest{}
+		if err := decodeVolumeRequest(data, &request); err != nil {
+			return nil, err
+		}
+
+		volume, err := getVolume(s, &request)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.wait(s.client.Patch(volumesPath+"/"+volume.UUID,
+			map[string]string{"state": state}))
+		if err != nil {
+			return nil, err
+		}
+
+		return emptyResponse(), nil
+	}
+}
+
+func volumeDelete(s *session, data interface{}) (interface{}, error) {
+	request := svm.VolumeRequest{}
+	if err := decodeVolumeRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	volume, err := getVolume(s, &request)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.wait(s.client.Delete(volumesPath + "/" + volume.UUID)); err != nil {
+		return nil, err
+	}
+
+	return emptyResponse(), nil
+}
+
+// sizeUnits are the ZAPI volume size suffixes, largest first
+var sizeUnits = []struct {
+	suffix string
+	bytes  int64
+}{
+	{"t", 1 << 40}, {"g", 1 << 30}, {"m", 1 << 20}, {"k", 1 << 10},
+}
+
+// parseSize converts a ZAPI volume size, e.g. 20m or 1g, to bytes
+func parseSize(size string) (int64, error) {
+	size = strings.ToLower(strings.TrimSpace(size))
+	multiplier := int64(1)
+	for _, unit := range sizeUnits {
+		if strings.HasSuffix(size, unit.suffix) {
+			size = strings.TrimSuffix(size, unit.suffix)
+			multiplier = unit.bytes
+			break
+		}
+	}
+
+	value, err := strconv.ParseInt(size, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid volume size [%s], got: %s", size, err)
+	}
+
+	return value * multiplier, nil
+}
+
+// formatSize converts bytes to the largest even ZAPI volume size unit
+func formatSize(bytes int64) string {
+	for _, unit := range sizeUnits {
+		if bytes > 0 && bytes%unit.bytes == 0 {
+			return strconv.FormatInt(bytes/unit.bytes, 10) + unit.suffix
+		}
+	}
+
+	return strconv.FormatInt(bytes, 10)
+}
+
+func volumeSize(s *session, data interface{}) (interface{}, error) {
+	request := svm.VolumeRequest{}
+	if err := decodeVolumeRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	volume, err := getVolume(s, &request)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &svm.VolumeInfo{}
+	if request.Size == "" {
+		if volume.Size != nil {
+			info.Size = formatSize(*volume.Size)
+		}
+		return info, nil
+	}
+
+	size, err := parseSize(request.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.wait(s.client.Patch(volumesPath+"/"+volume.UUID,
+		map[string]int64{"size": size}))
+	if err != nil {
+		return nil, err
+	}
+
+	info.Size = formatSize(size)
+	return info, nil
+}
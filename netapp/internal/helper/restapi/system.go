@@ -0,0 +1,580 @@
+package restapi
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/system"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/rest"
+)
+
+func init() {
+	registerCommands(map[string]command{
+		"SYS.NODE.GET":              nodeGet,
+		"SYS.PORT.GET":              portGet,
+		"SYS.PORT.FIND.PATTERN":     portFindByPattern,
+		"SYS.PORT.MODIFY":           portModify,
+		"SYS.PORTGROUP.GET":         portGroupGet,
+		"SYS.PORTGROUP.CREATE":      portGroupCreate,
+		"SYS.PORTGROUP.PORT.ADD":    portGroupPortAdd,
+		"SYS.PORTGROUP.PORT.REMOVE": portGroupPortRemove,
+		"SYS.PORTGROUP.DELETE":      portGroupDelete,
+		"SYS.AGGR.GET":              aggrGet,
+		"SYS.JOB.GET":               jobGet,
+	})
+}
+
+// the ONTAP REST API has no native endpoints for some port settings and
+// interface groups with user defined names, use the CLI passthrough
+const (
+	cliPortPath       = "/api/private/cli/network/port"
+	cliPortGroupPath  = "/api/private/cli/network/port/ifgrp"
+	cliPortAdminField = "autorevert-delay,ignore-health-status,up-admin,mtu-admin," +
+		"autonegotiate-admin,speed-admin,duplex-admin,flowcontrol-admin"
+)
+
+func optString(value *int) string {
+	if value == nil {
+		return ""
+	}
+
+	return strconv.Itoa(*value)
+}
+
+func optBool(value *bool) string {
+	if value == nil {
+		return ""
+	}
+
+	return strconv.FormatBool(*value)
+}
+
+func optInt(value *int) int {
+	if value == nil {
+		return -1
+	}
+
+	return *value
+}
+
+type nodeRecord struct {
+	Name         string `json:"name"`
+	UUID         string `json:"uuid"`
+	SerialNumber string `json:"serial_number"`
+	SystemID     string `json:"system_id"`
+	State        string `json:"state"`
+	Uptime       *int   `json:"uptime"`
+	Version      struct {
+		Full string `json:"full"`
+	} `json:"version"`
+}
+
+func nodeGet(s *session, data interface{}) (interface{}, error) {
+	request := system.NodeGetRequest{}
+	if err := decodeRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.Name == "" && request.UUID == "" {
+		return nil, fmt.Errorf(
+			"need at least one query parameter, got: %+v", request)
+	}
+
+	resp := struct {
+		rest.Response
+		Records []nodeRecord `json:"records"`
+	}{}
+	err := s.client.Get("/api/cluster/nodes", query(
+		"name,uuid,serial_number,system_id,state,uptime,version",
+		"name", request.Name, "uuid", request.UUID), &resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.NumRecords == 0 {
+		return nonExistResponse(), nil
+	}
+	if err := singleRecord(resp.NumRecords, "node", request); err != nil {
+		return nil, err
+	}
+
+	node := resp.Records[0]
+	return &system.NodeInfo{
+		Name:    node.Name,
+		Serial:  node.SerialNumber,
+		ID:      node.SystemID,
+		UUID:    node.UUID,
+		Version: node.Version.Full,
+		Healty:  node.State == "up",
+		Uptime:  optInt(node.Uptime),
+	}, nil
+}
+
+type portRecord struct {
+	UUID            string       `json:"uuid"`
+	Name            string       `json:"name"`
+	Node            rest.NameRef `json:"node"`
+	Type            string       `json:"type"`
+	MacAddress      string       `json:"mac_address"`
+	Enabled         *bool        `json:"enabled"`
+	State           string       `json:"state"`
+	Mtu             *int         `json:"mtu"`
+	BroadcastDomain struct {
+		Name    string       `json:"name"`
+		IPSpace rest.NameRef `json:"ipspace"`
+	} `json:"broadcast_domain"`
+	Speed *int `json:"speed"`
+	Vlan  *struct {
+		Tag      int `json:"tag"`
+		BasePort struct {
+			Name string       `json:"name"`
+			Node rest.NameRef `json:"node"`
+		} `json:"base_port"`
+	} `json:"vlan"`
+}
+
+type portsResponse struct {
+	rest.Response
+	Records []portRecord `json:"records"`
+}
+
+// portAdminRecord is the CLI passthrough 'network port show' record
+type portAdminRecord struct {
+	AutorevertDelay    *int   `json:"autorevert-delay"`
+	IgnoreHealthStatus *bool  `json:"ignore-health-status"`
+	UpAdmin            *bool  `json:"up-admin"`
+	MtuAdmin           *int   `json:"mtu-admin"`
+	AutonegAdmin       *bool  `json:"autonegotiate-admin"`
+	SpeedAdmin         string `json:"speed-admin"`
+	DuplexAdmin        string `json:"duplex-admin"`
+	FlowcontrolAdmin   string `json:"flowcontrol-admin"`
+	HealthStatus       string `json:"health-status"`
+	DuplexOper         string `json:"duplex-oper"`
+	FlowcontrolOper    string `json:"flowcontrol-oper"`
+	AutonegOper        *bool  `json:"autonegotiate-oper"`
+}
+
+// portTypes maps REST port types to the ZAPI port-type values
+var portTypes = map[string]string{
+	"physical": "physical",
+	"vlan":     "vlan",
+	"lag":      "if_group",
+}
+
+func decodePortRequest(data interface{}, request *system.PortGetRequest) error {
+	if err := decodeRequest(data, request); err != nil {
+		return err
+	}
+
+	if request.NodeName == "" || request.PortName == "" {
+		return fmt.Errorf(
+			"port request must have node and port defined, got: %+v",
+			*request)
+	}
+
+	return nil
+}
+
+// getPorts queries the ethernet ports, port names may contain wildcards
+func getPorts(s *session, fields string, filters ...string) (*portsResponse, error) {
+	resp := &portsResponse{}
+	err := s.client.Get("/api/network/ethernet/ports", query(fields, filters...), resp)
+	return resp, err
+}
+
+func portGet(s *session, data interface{}) (interface{}, error) {
+	request := system.PortGetRequest{}
+	if err := decodePortRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	resp, err := getPorts(s,
+		"name,node,type,mac_address,enabled,state,mtu,broadcast_domain,speed,vlan",
+		"node.name", request.NodeName, "name", request.PortName)
+	if err != nil {
+		return nil, err
+	}
+	if resp.NumRecords == 0 {
+		return nonExistResponse(), nil
+	}
+	if err := singleRecord(resp.NumRecords, "port", request); err != nil {
+		return nil, err
+	}
+	port := resp.Records[0]
+
+	admin := struct {
+		rest.Response
+		Records []portAdminRecord `json:"records"`
+	}{}
+	err = s.client.Get(cliPortPath, query(
+		cliPortAdminField+",health-status,duplex-oper,flowcontrol-oper,autonegotiate-oper",
+		"node", request.NodeName, "port", request.PortName), &admin)
+	if err != nil {
+		return nil, err
+	}
+	if err := singleRecord(admin.NumRecords, "port admin setting", request); err != nil {
+		return nil, err
+	}
+	settings := admin.Records[0]
+
+	info := &system.PortInfo{
+		AutoRevertDelay: optString(settings.AutorevertDelay),
+		IgnoreHealth:    optBool(settings.IgnoreHealthStatus),
+		IPSpace:         port.BroadcastDomain.IPSpace.Name,
+
+		AdminUp:     optBool(settings.UpAdmin),
+		AdminMtu:    optString(settings.MtuAdmin),
+		AdminAuto:   optBool(settings.AutonegAdmin),
+		AdminSpeed:  settings.SpeedAdmin,
+		AdminDuplex: settings.DuplexAdmin,
+		AdminFlow:   settings.FlowcontrolAdmin,
+
+		Status:          port.State,
+		Health:          settings.HealthStatus,
+		Mac:             port.MacAddress,
+		BroadCastDomain: port.BroadcastDomain.Name,
+		Mtu:             optString(port.Mtu),
+		Auto:            optBool(settings.AutonegOper),
+		Speed:           optString(port.Speed),
+		Duplex:          settings.DuplexOper,
+		Flow:            settings.FlowcontrolOper,
+
+		Type: portTypes[port.Type],
+	}
+	info.NodeName = port.Node.Name
+	info.PortName = port.Name
+
+	if port.Vlan != nil {
+		info.VlanID = strconv.Itoa(port.Vlan.Tag)
+		info.VlanNode = port.Vlan.BasePort.Node.Name
+		info.VlanPort = port.Vlan.BasePort.Name
+	}
+
+	return info, nil
+}
+
+func portFindByPattern(s *session, data interface{}) (interface{}, error) {
+	request := system.PortGetRequest{}
+	if err := decodePortRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	resp, err := getPorts(s, "name",
+		"node.name", request.NodeName, "name", request.PortName)
+	if err != nil {
+		return nil, err
+	}
+
+	ports := []string{}
+	for _, port := range resp.Records {
+		ports = append(ports, port.Name)
+	}
+
+	return &system.PortFindResult{Names: ports}, nil
+}
+
+func portModify(s *session, data interface{}) (interface{}, error) {
+	request := system.PortModifyRequest{}
+	if err := decodePortRequest(data, &request.PortGetRequest); err != nil {
+		return nil, err
+	}
+	if err := decodeRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.Role != "" {
+		return nil, fmt.Errorf(
+			"port role not supported by ONTAP REST API, got: %s", request.Role)
+	}
+
+	body := map[string]interface{}{}
+	for _, value := range []struct {
+		name, value string
+		kind        string
+	}{
+		{"duplex-admin", request.Duplex, "string"},
+		{"flowcontrol-admin", request.Flow, "string"},
+		{"speed-admin", request.Speed, "string"},
+		{"autorevert-delay", request.AutoRevertDelay, "int"},
+		{"ignore-health-status", request.IgnoreHealth, "bool"},
+		{"ipspace", request.IPSpace, "string"},
+		{"autonegotiate-admin", request.Auto, "bool"},
+		{"up-admin", request.Up, "bool"},
+		{"mtu", request.Mtu, "int"},
+	} {
+		if value.value == "" {
+			continue
+		}
+
+		var err error
+		switch value.kind {
+		case "int":
+			body[value.name], err = strconv.Atoi(value.value)
+		case "bool":
+			body[value.name], err = strconv.ParseBool(value.value)
+		default:
+			body[value.name] = value.value
+		}
+		if err != nil {
+			return nil, fmt.Errorf(
+				"port modify [%s] invalid value [%s], got: %s",
+				value.name, value.value, err)
+		}
+	}
+
+	if len(body) == 0 {
+		return emptyResponse(), nil
+	}
+
+	err := s.client.Do("PATCH", cliPortPath,
+		query("", "node", request.NodeName, "port", request.PortName),
+		body, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return emptyResponse(), nil
+}
+
+// portGroupRecord is the CLI passthrough 'network port ifgrp show' record
+type portGroupRecord struct {
+	Node      string   `json:"node"`
+	IfGrp     string   `json:"ifgrp"`
+	DistrFunc string   `json:"distr-func"`
+	Mode      string   `json:"mode"`
+	Activity  string   `json:"activity"`
+	Ports     []string `json:"ports"`
+	UpPorts   []string `json:"up-ports"`
+	DownPorts []string `json:"down-ports"`
+}
+
+func decodePortGroupRequest(data interface{}, request *system.PortGroupModifyRequest) error {
+	if err := decodeRequest(data, request); err != nil {
+		return err
+	}
+
+	if request.NodeName == "" || request.GroupName == "" {
+		return fmt.Errorf(
+			"port group request must have node and name defined, got: %+v",
+			*request)
+	}
+
+	return nil
+}
+
+func portGroupQuery(request *system.PortGroupModifyRequest, fields string) url.Values {
+	return query(fields, "node", request.NodeName, "ifgrp", request.GroupName)
+}
+
+func portGroupGet(s *session, data interface{}) (interface{}, error) {
+	request := system.PortGroupModifyRequest{}
+	if err := decodePortGroupRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	resp := struct {
+		rest.Response
+		Records []portGroupRecord `json:"records"`
+	}{}
+	err := s.client.Get(cliPortGroupPath, portGroupQuery(&request,
+		"node,ifgrp,distr-func,mode,activity,ports,up-ports,down-ports"), &resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.NumRecords == 0 {
+		return nonExistResponse(), nil
+	}
+	if err := singleRecord(resp.NumRecords, "port group", request); err != nil {
+		return nil, err
+	}
+
+	group := resp.Records[0]
+	info := &system.PortGroupInfo{
+		GroupLinkStatus: group.Activity,
+		PortsDown:       nonNil(group.DownPorts),
+		PortsUp:         nonNil(group.UpPorts),
+	}
+	info.NodeName = group.Node
+	info.GroupName = group.IfGrp
+	info.Mode = group.Mode
+	info.LoadDistribution = group.DistrFunc
+	info.Ports = nonNil(group.Ports)
+
+	return info, nil
+}
+
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+
+	return values
+}
+
+func portGroupCreate(s *session, data interface{}) (interface{}, error) {
+	request := system.PortGroupModifyRequest{}
+	if err := decodePortGroupRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.Mode == "" || request.LoadDistribution == "" {
+		return nil, fmt.Errorf(
+			"port group create commands must have node, name, mode"+
+				" and distribution defined, got: %+v", request)
+	}
+
+	err := s.client.Post(cliPortGroupPath, nil, map[string]string{
+		"node":       request.NodeName,
+		"ifgrp":      request.GroupName,
+		"distr-func": request.LoadDistribution,
+		"mode":       request.Mode,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return emptyResponse(), nil
+}
+
+func portGroupPortsModify(s *session, data interface{}, cmdType string) (interface{}, error) {
+	request := system.PortGroupModifyRequest{}
+	if err := decodePortGroupRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	// ifgrp only allows add/remove of single port...
+	for _, port := range request.Ports {
+		err := s.client.Post(cliPortGroupPath+"/"+cmdType+"-port", nil,
+			map[string]string{
+				"node":  request.NodeName,
+				"ifgrp": request.GroupName,
+				"port":  port,
+			}, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return emptyResponse(), nil
+}
+
+func portGroupPortAdd(s *session, data interface{}) (interface{}, error) {
+	return portGroupPortsModify(s, data, "add")
+}
+
+func portGroupPortRemove(s *session, data interface{}) (interface{}, error) {
+	return portGroupPortsModify(s, data, "remove")
+}
+
+func portGroupDelete(s *session, data interface{}) (interface{}, error) {
+	request := system.PortGroupModifyRequest{}
+	if err := decodePortGroupRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	err := s.client.Do("DELETE", cliPortGroupPath,
+		portGroupQuery(&request, ""), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return emptyResponse(), nil
+}
+
+type aggrRecord struct {
+	Name  string `json:"name"`
+	UUID  string `json:"uuid"`
+	Space *struct {
+		BlockStorage struct {
+			Size                *int `json:"size"`
+			Available           *int `json:"available"`
+			Used                *int `json:"used"`
+			PhysicalUsedPercent *int `json:"physical_used_percent"`
+		} `json:"block_storage"`
+	} `json:"space"`
+	VolumeCount *int `json:"volume_count"`
+}
+
+func aggrGet(s *session, data interface{}) (interface{}, error) {
+	request := system.AggrGetRequest{}
+	if err := decodeRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.Name == "" && request.UUID == "" {
+		return nil, fmt.Errorf(
+			"get aggr request must have either name or uuid defined, got: %+v",
+			request)
+	}
+
+	resp := struct {
+		rest.Response
+		Records []aggrRecord `json:"records"`
+	}{}
+	err := s.client.Get("/api/storage/aggregates", query(
+		"name,uuid,space,volume_count",
+		"name", request.Name, "uuid", request.UUID,
+		"node.name", strings.Join(request.Nodes, "|")), &resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.NumRecords == 0 {
+		return nonExistResponse(), nil
+	}
+	if err := singleRecord(resp.NumRecords, "aggregate", request); err != nil {
+		return nil, err
+	}
+
+	aggr := resp.Records[0]
+	info := &system.AggrInfo{
+		FlexVolCount:    optInt(aggr.VolumeCount),
+		PctUsedCapacity: -1,
+		PctUsedPhysical: -1,
+		SizeTotal:       -1,
+		SizeUsed:        -1,
+		SizeAvailable:   -1,
+		// no reserved space equivalent available in REST
+		SizeReserved: -1,
+	}
+	info.Name = aggr.Name
+	info.UUID = aggr.UUID
+
+	if aggr.Space != nil {
+		space := aggr.Space.BlockStorage
+		info.SizeTotal = optInt(space.Size)
+		info.SizeUsed = optInt(space.Used)
+		info.SizeAvailable = optInt(space.Available)
+		info.PctUsedPhysical = optInt(space.PhysicalUsedPercent)
+		if info.SizeTotal > 0 && info.SizeUsed >= 0 {
+			info.PctUsedCapacity = info.SizeUsed * 100 / info.SizeTotal
+		}
+	}
+
+	return info, nil
+}
+
+func jobGet(s *session, data interface{}) (interface{}, error) {
+	request := system.JobGetRequest{}
+	if err := decodeRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	uuid, ok := s.jobs.get(request.ID)
+	if !ok {
+		return nil, fmt.Errorf("no REST job known for id: %d", request.ID)
+	}
+
+	job, err := s.client.GetJob(uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	// REST job states queued, running, paused, success and failure
+	// are a subset of the ZAPI job-state values
+	info := &system.JobInfo{
+		Message: job.Message,
+		Status:  job.State,
+		ErrNo:   job.Code,
+	}
+	info.ID = request.ID
+	info.SVM = job.SVM.Name
+
+	return info, nil
+}
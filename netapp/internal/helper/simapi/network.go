@@ -0,0 +1,1106 @@
+package simapi
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/network"
+)
+
+func init() {
+	registerCommands(map[string]command{
+		"NW.VLAN.GET":    vlanGet,
+		"NW.VLAN.CREATE": vlanCreate,
+		"NW.VLAN.DELETE": vlanDelete,
+
+		"NW.IPSPACE.GET":    ipspaceGet,
+		"NW.IPSPACE.CREATE": ipspaceCreate,
+		"NW.IPSPACE.DELETE": ipspaceDelete,
+		"NW.IPSPACE.UPDATE": ipspaceUpdate,
+
+		"NW.BRCDOM.GET":         bcDomainGet,
+		"NW.BRCDOM.STATUS":      bcDomainStatus,
+		"NW.BRCDOM.CREATE":      bcDomainCreate,
+		"NW.BRCDOM.DELETE":      bcDomainDelete,
+		"NW.BRCDOM.RENAME":      bcDomainRename,
+		"NW.BRCDOM.PORT.ADD":    bcDomainPortAdd,
+		"NW.BRCDOM.PORT.REMOVE": bcDomainPortRemove,
+		"NW.BRCDOM.UPDATE":      bcDomainUpdate,
+
+		"NW.SUBNET.GET":        subnetGet,
+		"NW.SUBNET.CREATE":     subnetCreate,
+		"NW.SUBNET.DELETE":     subnetDelete,
+		"NW.SUBNET.RENAME":     subnetRename,
+		"NW.SUBNET.IPR.ADD":    subnetIPRangeAdd,
+		"NW.SUBNET.IPR.REMOVE": subnetIPRangeRemove,
+		"NW.SUBNET.MODIFY":     subnetModify,
+	})
+}
+
+// defaultIPSpace is used for ports and broadcast domains without ipspace
+const defaultIPSpace = "Default"
+
+// systemIPSpaces can neither be renamed nor deleted
+var systemIPSpaces = map[string]bool{defaultIPSpace: true, "Cluster": true}
+
+type ipspace struct {
+	name string
+	uuid string
+}
+
+type bcDomain struct {
+	name    string
+	ipspace string
+	mtu     int
+	ports   []string // net qualified port names
+
+	// pending is the number of status polls the port update is in progress
+	pending int
+}
+
+type subnet struct {
+	name     string
+	ipspace  string
+	bcDomain string
+	network  *net.IPNet
+	gateway  string
+	ranges   []string
+}
+
+//*****************************************************************************
+// VLAN commands
+
+func decodeVlanRequest(data []byte, request *network.VlanRequest) error {
+	if err := decodeRequest(data, request); err != nil {
+		return err
+	}
+
+	if request.ParentName == "" || request.VlanID == "" {
+		return fmt.Errorf(
+			"vlan request must have parent name and vlan id defined, got: %+v",
+			*request)
+	}
+
+	return nil
+}
+
+// findVlan returns the VLAN port for the request or nil
+func (c *Cluster) findVlan(request *network.VlanRequest) *port {
+	vlanID, err := strconv.Atoi(request.VlanID)
+	if err != nil {
+		return nil
+	}
+
+	for _, p := range c.ports {
+		if p.portType == portTypeVlan && p.vlanID == vlanID &&
+			p.vlanPort == request.ParentName &&
+			(request.NodeName == "" || request.NodeName == p.node) {
+			return p
+		}
+	}
+
+	return nil
+}
+
+func vlanGet(c *Cluster, data []byte) (interface{}, error) {
+	request := network.VlanRequest{}
+	if err := decodeVlanRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	vlan := c.findVlan(&request)
+	if vlan == nil {
+		return nonExistResponse(), nil
+	}
+
+	info := &network.VlanInfo{Name: vlan.name}
+	info.NodeName = vlan.node
+	info.ParentName = vlan.vlanPort
+	info.VlanID = strconv.Itoa(vlan.vlanID)
+
+	return info, nil
+}
+
+func vlanCreate(c *Cluster, data []byte) (interface{}, error) {
+	const api = "net-vlan-create"
+
+	request := network.VlanRequest{}
+	if err := decodeVlanRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.NodeName == "" {
+		return nil, fmt.Errorf(
+			"vlan create request must have node defined, got: %+v", request)
+	}
+
+	vlanID, err := strconv.Atoi(request.VlanID)
+	if err != nil || vlanID < 1 || vlanID > 4094 {
+		return nil, apiError(api, errnoInvalidInput,
+			"invalid vlan id [%s]", request.VlanID)
+	}
+
+	parent, err := c.getPort(api, request.NodeName, request.ParentName)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case parent.portType == portTypeVlan:
+		return nil, apiError(api, errnoInvalidInput,
+			"parent port [%s] must not be a VLAN", parent.name)
+	case parent.group != "":
+		return nil, apiError(api, errnoInUse,
+			"parent port [%s] is member of port group [%s]",
+			parent.name, parent.group)
+	}
+
+	if c.findVlan(&request) != nil {
+		return nil, apiError(api, errnoDuplicateEntry, "duplicate entry")
+	}
+
+	vlan := c.newPort(request.NodeName,
+		fmt.Sprintf("%s-%d", parent.name, vlanID), portTypeVlan)
+	vlan.vlanID = vlanID
+	vlan.vlanPort = parent.name
+	vlan.mac = parent.mac
+	if parent.mtu < vlan.mtu {
+		vlan.mtu = parent.mtu
+	}
+
+	return emptyResponse(), nil
+}
+
+func vlanDelete(c *Cluster, data []byte) (interface{}, error) {
+	const api = "net-vlan-delete"
+
+	request := network.VlanRequest{}
+	if err := decodeVlanRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	vlan := c.findVlan(&request)
+	if vlan == nil {
+		return nil, apiError(api, errnoObjectNotFound,
+			"vlan [%s] on port [%s] does not exist",
+			request.VlanID, request.ParentName)
+	}
+	if vlan.bcDomain != "" {
+		return nil, apiError(api, errnoInUse,
+			"vlan [%s] is in broadcast domain [%s]", vlan.name, vlan.bcDomain)
+	}
+
+	delete(c.ports, qualifiedName(vlan.node, vlan.name))
+
+	return emptyResponse(), nil
+}
+
+//*****************************************************************************
+// IPSpace commands
+
+func ipspaceGet(c *Cluster, data []byte) (interface{}, error) {
+	request := network.IPSpaceRequest{}
+	if err := decodeRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.UUID == "" && request.Name == "" {
+		return nil, fmt.Errorf(
+			"get IPSpace request must have uuid or name defined, got: %+v",
+			request)
+	}
+
+	for _, ips := range c.ipspaces {
+		if (request.Name != "" && request.Name != ips.name) ||
+			(request.UUID != "" && request.UUID != ips.uuid) {
+			continue
+		}
+
+		bcDomains := map[string]bool{}
+		for _, bcd := range c.bcDomains {
+			if bcd.ipspace == ips.name {
+				bcDomains[bcd.name] = true
+			}
+		}
+		ports := map[string]bool{}
+		for name, p := range c.ports {
+			if p.ipspace == ips.name {
+				ports[name] = true
+			}
+		}
+		vservers := map[string]bool{}
+		for _, vs := range c.svms {
+			if vs.ipspace == ips.name {
+				vservers[vs.name] = true
+			}
+		}
+
+		return &network.IPSpaceInfo{
+			Name:             ips.name,
+			UUID:             ips.uuid,
+			BroadCastDomains: sortedKeys(bcDomains),
+			Ports:            sortedKeys(ports),
+			VServers:         sortedKeys(vservers),
+		}, nil
+	}
+
+	return nonExistResponse(), nil
+}
+
+func decodeIPSpaceRequest(data []byte, request *network.IPSpaceRequest) error {
+	if err := decodeRequest(data, request); err != nil {
+		return err
+	}
+
+	if request.Name == "" {
+		return fmt.Errorf(
+			"ipspace request must have name defined, got: %+v", *request)
+	}
+
+	return nil
+}
+
+// getIPSpace returns the ipspace or an object not found error
+func (c *Cluster) getIPSpace(api, name string) (*ipspace, error) {
+	ips, ok := c.ipspaces[name]
+	if !ok {
+		return nil, apiError(api, errnoObjectNotFound,
+			"ipspace [%s] does not exist", name)
+	}
+
+	return ips, nil
+}
+
+func ipspaceCreate(c *Cluster, data []byte) (interface{}, error) {
+	request := network.IPSpaceRequest{}
+	if err := decodeIPSpaceRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	if _, ok := c.ipspaces[request.Name]; ok {
+		return nil, apiError("net-ipspaces-create", errnoDuplicateEntry,
+			"duplicate entry")
+	}
+
+	ips := &ipspace{name: request.Name, uuid: newUUID()}
+	c.ipspaces[ips.name] = ips
+
+	return &network.IPSpaceInfo{Name: ips.name, UUID: ips.uuid}, nil
+}
+
+func ipspaceDelete(c *Cluster, data []byte) (interface{}, error) {
+	const api = "net-ipspaces-destroy"
+
+	request := network.IPSpaceRequest{}
+	if err := decodeIPSpaceRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	ips, err := c.getIPSpace(api, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if systemIPSpaces[ips.name] {
+		return nil, apiError(api, errnoInvalidInput,
+			"system ipspace [%s] cannot be deleted", ips.name)
+	}
+	for _, bcd := range c.bcDomains {
+		if bcd.ipspace == ips.name {
+			return nil, apiError(api, errnoInUse,
+				"ipspace [%s] has broadcast domain [%s]", ips.name, bcd.name)
+		}
+	}
+	for _, vs := range c.svms {
+		if vs.ipspace == ips.name {
+			return nil, apiError(api, errnoInUse,
+				"ipspace [%s] is used by SVM [%s]", ips.name, vs.name)
+		}
+	}
+
+	// ports of deleted broadcast domains fall back to the default ipspace
+	for _, p := range c.ports {
+		if p.ipspace == ips.name {
+			p.ipspace = defaultIPSpace
+		}
+	}
+	delete(c.ipspaces, ips.name)
+
+	return emptyResponse(), nil
+}
+
+func ipspaceUpdate(c *Cluster, data []byte) (interface{}, error) {
+	const api = "net-ipspaces-rename"
+
+	request := network.IPSpaceRequest{}
+	if err := decodeIPSpaceRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.NewName == "" {
+		return nil, fmt.Errorf(
+			"update/rename ipspace must have name and new_name defined, got: %+v",
+			request)
+	}
+
+	ips, err := c.getIPSpace(api, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if systemIPSpaces[ips.name] {
+		return nil, apiError(api, errnoInvalidInput,
+			"system ipspace [%s] cannot be renamed", ips.name)
+	}
+	if _, ok := c.ipspaces[request.NewName]; ok {
+		return nil, apiError(api, errnoDuplicateEntry, "duplicate entry")
+	}
+
+	delete(c.ipspaces, ips.name)
+	ips.name = request.NewName
+	c.ipspaces[ips.name] = ips
+
+	for _, p := range c.ports {
+		if p.ipspace == request.Name {
+			p.ipspace = ips.name
+		}
+	}
+	for _, vs := range c.svms {
+		if vs.ipspace == request.Name {
+			vs.ipspace = ips.name
+		}
+	}
+	for key, bcd := range c.bcDomains {
+		if bcd.ipspace == request.Name {
+			delete(c.bcDomains, key)
+			bcd.ipspace = ips.name
+			c.bcDomains[qualifiedName(bcd.ipspace, bcd.name)] = bcd
+		}
+	}
+	for key, sn := range c.subnets {
+		if sn.ipspace == request.Name {
+			delete(c.subnets, key)
+			sn.ipspace = ips.name
+			c.subnets[qualifiedName(sn.ipspace, sn.name)] = sn
+		}
+	}
+
+	return emptyResponse(), nil
+}
+
+//*****************************************************************************
+// broadcast domain commands
+
+func decodeBcDomainRequest(data []byte, request *network.BcDomainRequest, ipspace bool) error {
+	if err := decodeRequest(data, request); err != nil {
+		return err
+	}
+
+	if request.Name == "" || (ipspace && request.IPSpace == "") {
+		return fmt.Errorf(
+			"broadcast domain request must have name and ipspace defined, got: %+v",
+			*request)
+	}
+
+	return nil
+}
+
+func (bcd *bcDomain) status() string {
+	if bcd.pending > 0 {
+		return "in_progress"
+	}
+
+	return "complete"
+}
+
+func bcDomainStatusResponse(bcd *bcDomain) interface{} {
+	return &network.BcDomainInfo{PortUpdateStatus: bcd.status()}
+}
+
+// findBcDomain returns the broadcast domain by name, the name must be
+// unique across all ipspaces
+func (c *Cluster) findBcDomain(name string) (*bcDomain, error) {
+	var found *bcDomain
+	for _, bcd := range c.bcDomains {
+		if bcd.name != name {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf(
+				"no broadcast domain or too many found for name [%s]", name)
+		}
+		found = bcd
+	}
+
+	return found, nil
+}
+
+// getBcDomain returns the broadcast domain or an object not found error
+func (c *Cluster) getBcDomain(api, ipspaceName, name string) (*bcDomain, error) {
+	bcd, ok := c.bcDomains[qualifiedName(ipspaceName, name)]
+	if !ok {
+		return nil, apiError(api, errnoObjectNotFound,
+			"broadcast domain [%s] in ipspace [%s] does not exist",
+			name, ipspaceName)
+	}
+
+	return bcd, nil
+}
+
+// checkPortMtu validates the MTU for a port against its VLAN hierarchy,
+// a VLAN must not exceed the parent port MTU
+func (c *Cluster) checkPortMtu(api string, p *port, mtu int) error {
+	if p.portType == portTypeVlan {
+		parent := c.ports[qualifiedName(p.node, p.vlanPort)]
+		if parent != nil && mtu > parent.mtu {
+			return apiError(api, errnoInvalidInput,
+				"MTU [%d] for VLAN [%s] exceeds MTU [%d] of parent port [%s]",
+				mtu, p.name, parent.mtu, parent.name)
+		}
+		return nil
+	}
+
+	for _, vlan := range c.vlansOf(p) {
+		if vlan.mtu > mtu {
+			return apiError(api, errnoInvalidInput,
+				"MTU [%d] of port [%s] is below MTU [%d] of VLAN [%s]",
+				mtu, p.name, vlan.mtu, vlan.name)
+		}
+	}
+
+	return nil
+}
+
+// bcDomainPorts validates and returns the ports to add to the domain
+func (c *Cluster) bcDomainPorts(api string, bcd *bcDomain, names []string) ([]*port, error) {
+	ports := []*port{}
+	for _, name := range names {
+		nodeName, portName, err := splitQualifiedName(api, name)
+		if err != nil {
+			return nil, err
+		}
+		p, err := c.getPort(api, nodeName, portName)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case p.bcDomain != "":
+			return nil, apiError(api, errnoInUse,
+				"port [%s] is already member of broadcast domain [%s]",
+				name, p.bcDomain)
+		case p.group != "":
+			return nil, apiError(api, errnoInUse,
+				"port [%s] is member of port group [%s]", name, p.group)
+		}
+		if err := c.checkPortMtu(api, p, bcd.mtu); err != nil {
+			return nil, err
+		}
+		for _, other := range ports {
+			if other == p {
+				return nil, apiError(api, errnoInvalidInput,
+					"port [%s] listed more than once", name)
+			}
+		}
+
+		ports = append(ports, p)
+	}
+
+	return ports, nil
+}
+
+// addPorts assigns the ports to the domain and starts a port update
+func (c *Cluster) addPorts(bcd *bcDomain, ports []*port) {
+	for _, p := range ports {
+		p.bcDomain = bcd.name
+		p.ipspace = bcd.ipspace
+		p.mtu = bcd.mtu
+		bcd.ports = append(bcd.ports, qualifiedName(p.node, p.name))
+	}
+	sort.Strings(bcd.ports)
+
+	if len(ports) > 0 {
+		bcd.pending = c.JobPolls
+	}
+}
+
+func bcDomainGet(c *Cluster, data []byte) (interface{}, error) {
+	request := network.BcDomainRequest{}
+	if err := decodeBcDomainRequest(data, &request, false); err != nil {
+		return nil, err
+	}
+
+	bcd, err := c.findBcDomain(request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if bcd == nil {
+		return nonExistResponse(), nil
+	}
+
+	info := &network.BcDomainInfo{
+		Name:             bcd.name,
+		Mtu:              strconv.Itoa(bcd.mtu),
+		IPSpace:          bcd.ipspace,
+		PortUpdateStatus: bcd.status(),
+		Ports:            []network.BcDomainPortInfo{},
+		FailoverGroups:   []string{bcd.name},
+		SubnetNames:      []string{},
+	}
+
+	for _, name := range bcd.ports {
+		info.Ports = append(info.Ports, network.BcDomainPortInfo{
+			Name: name, UpdateStatus: bcd.status(),
+		})
+	}
+
+	for _, sn := range c.subnets {
+		if sn.ipspace == bcd.ipspace && sn.bcDomain == bcd.name {
+			info.SubnetNames = append(info.SubnetNames, sn.name)
+		}
+	}
+	sort.Strings(info.SubnetNames)
+
+	return info, nil
+}
+
+func bcDomainStatus(c *Cluster, data []byte) (interface{}, error) {
+	request := network.BcDomainRequest{}
+	if err := decodeBcDomainRequest(data, &request, false); err != nil {
+		return nil, err
+	}
+
+	bcd, err := c.findBcDomain(request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if bcd == nil {
+		return nonExistResponse(), nil
+	}
+
+	result := bcDomainStatusResponse(bcd)
+	if bcd.pending > 0 {
+		bcd.pending--
+	}
+
+	return result, nil
+}
+
+func bcDomainCreate(c *Cluster, data []byte) (interface{}, error) {
+	const api = "net-port-broadcast-domain-create"
+
+	request := network.BcDomainRequest{}
+	if err := decodeBcDomainRequest(data, &request, false); err != nil {
+		return nil, err
+	}
+	if request.Mtu == "" {
+		return nil, fmt.Errorf(
+			"broadcast domain create commands must have name and mtu defined, got: %+v",
+			request)
+	}
+	if request.IPSpace == "" {
+		request.IPSpace = defaultIPSpace
+	}
+
+	if _, err := c.getIPSpace(api, request.IPSpace); err != nil {
+		return nil, err
+	}
+	key := qualifiedName(request.IPSpace, request.Name)
+	if _, ok := c.bcDomains[key]; ok {
+		return nil, apiError(api, errnoDuplicateEntry, "duplicate entry")
+	}
+
+	bcd := &bcDomain{name: request.Name, ipspace: request.IPSpace, ports: []string{}}
+	if err := parseOptInt(api, "mtu", request.Mtu, &bcd.mtu); err != nil {
+		return nil, err
+	}
+
+	ports, err := c.bcDomainPorts(api, bcd, request.Ports)
+	if err != nil {
+		return nil, err
+	}
+
+	c.bcDomains[key] = bcd
+	c.addPorts(bcd, ports)
+
+	return bcDomainStatusResponse(bcd), nil
+}
+
+func bcDomainDelete(c *Cluster, data []byte) (interface{}, error) {
+	const api = "net-port-broadcast-domain-destroy"
+
+	request := network.BcDomainRequest{}
+	if err := decodeBcDomainRequest(data, &request, true); err != nil {
+		return nil, err
+	}
+
+	bcd, err := c.getBcDomain(api, request.IPSpace, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	for _, sn := range c.subnets {
+		if sn.ipspace == bcd.ipspace && sn.bcDomain == bcd.name {
+			return nil, apiError(api, errnoInUse,
+				"broadcast domain [%s] is used by subnet [%s]", bcd.name, sn.name)
+		}
+	}
+
+	for _, name := range bcd.ports {
+		c.ports[name].bcDomain = ""
+	}
+	delete(c.bcDomains, qualifiedName(bcd.ipspace, bcd.name))
+
+	return &network.BcDomainInfo{PortUpdateStatus: "complete"}, nil
+}
+
+func bcDomainRename(c *Cluster, data []byte) (interface{}, error) {
+	const api = "net-port-broadcast-domain-rename"
+
+	request := network.BcDomainRequest{}
+	if err := decodeBcDomainRequest(data, &request, true); err != nil {
+		return nil, err
+	}
+	if request.NewName == "" {
+		return nil, fmt.Errorf(
+			"broadcast domain rename commands must have name, new name"+
+				" and ipspace defined, got: %+v", request)
+	}
+
+	bcd, err := c.getBcDomain(api, request.IPSpace, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	newKey := qualifiedName(bcd.ipspace, request.NewName)
+	if _, ok := c.bcDomains[newKey]; ok {
+		return nil, apiError(api, errnoDuplicateEntry, "duplicate entry")
+	}
+
+	delete(c.bcDomains, qualifiedName(bcd.ipspace, bcd.name))
+	bcd.name = request.NewName
+	c.bcDomains[newKey] = bcd
+
+	for _, name := range bcd.ports {
+		c.ports[name].bcDomain = bcd.name
+	}
+	for _, sn := range c.subnets {
+		if sn.ipspace == bcd.ipspace && sn.bcDomain == request.Name {
+			sn.bcDomain = bcd.name
+		}
+	}
+
+	return emptyResponse(), nil
+}
+
+func decodeBcDomainPortsRequest(data []byte, request *network.BcDomainRequest, cmdType string) error {
+	if err := decodeBcDomainRequest(data, request, true); err != nil {
+		return err
+	}
+
+	if len(request.Ports) < 1 {
+		return fmt.Errorf(
+			"broadcast domain port %s commands must have name, ipspace"+
+				" and ports defined, got: %+v", cmdType, *request)
+	}
+
+	return nil
+}
+
+func bcDomainPortAdd(c *Cluster, data []byte) (interface{}, error) {
+	const api = "net-port-broadcast-domain-add-ports"
+
+	request := network.BcDomainRequest{}
+	if err := decodeBcDomainPortsRequest(data, &request, "add"); err != nil {
+		return nil, err
+	}
+
+	bcd, err := c.getBcDomain(api, request.IPSpace, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	ports, err := c.bcDomainPorts(api, bcd, request.Ports)
+	if err != nil {
+		return nil, err
+	}
+
+	c.addPorts(bcd, ports)
+
+	return bcDomainStatusResponse(bcd), nil
+}
+
+func bcDomainPortRemove(c *Cluster, data []byte) (interface{}, error) {
+	const api = "net-port-broadcast-domain-remove-ports"
+
+	request := network.BcDomainRequest{}
+	if err := decodeBcDomainPortsRequest(data, &request, "remove"); err != nil {
+		return nil, err
+	}
+
+	bcd, err := c.getBcDomain(api, request.IPSpace, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	remove := map[string]bool{}
+	for _, name := range request.Ports {
+		if p, ok := c.ports[name]; !ok || p.bcDomain != bcd.name {
+			return nil, apiError(api, errnoObjectNotFound,
+				"port [%s] is not member of broadcast domain [%s]",
+				name, bcd.name)
+		}
+		remove[name] = true
+	}
+
+	ports := []string{}
+	for _, name := range bcd.ports {
+		if remove[name] {
+			c.ports[name].bcDomain = ""
+		} else {
+			ports = append(ports, name)
+		}
+	}
+	bcd.ports = ports
+	bcd.pending = c.JobPolls
+
+	return bcDomainStatusResponse(bcd), nil
+}
+
+func bcDomainUpdate(c *Cluster, data []byte) (interface{}, error) {
+	const api = "net-port-broadcast-domain-modify"
+
+	request := network.BcDomainRequest{}
+	if err := decodeBcDomainRequest(data, &request, true); err != nil {
+		return nil, err
+	}
+	if request.Mtu == "" {
+		return nil, fmt.Errorf(
+			"broadcast domain update commands must have name, ipspace"+
+				" and mtu defined, got: %+v", request)
+	}
+
+	bcd, err := c.getBcDomain(api, request.IPSpace, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	mtu := bcd.mtu
+	if err := parseOptInt(api, "mtu", request.Mtu, &mtu); err != nil {
+		return nil, err
+	}
+
+	// validate all members first, the update applies to all or none
+	for _, name := range bcd.ports {
+		if err := c.checkPortMtu(api, c.ports[name], mtu); err != nil {
+			return nil, err
+		}
+	}
+
+	bcd.mtu = mtu
+	for _, name := range bcd.ports {
+		c.ports[name].mtu = mtu
+	}
+	if len(bcd.ports) > 0 {
+		bcd.pending = c.JobPolls
+	}
+
+	return bcDomainStatusResponse(bcd), nil
+}
+
+//*****************************************************************************
+// subnet commands
+
+func decodeSubnetRequest(data []byte, request *network.SubnetRequest) error {
+	if err := decodeRequest(data, request); err != nil {
+		return err
+	}
+
+	if request.Name == "" || request.IPSpace == "" {
+		return fmt.Errorf(
+			"subnet request must have name and ipspace defined, got: %+v",
+			*request)
+	}
+
+	return nil
+}
+
+// getSubnet returns the subnet or an object not found error
+func (c *Cluster) getSubnet(api, ipspaceName, name string) (*subnet, error) {
+	sn, ok := c.subnets[qualifiedName(ipspaceName, name)]
+	if !ok {
+		return nil, apiError(api, errnoObjectNotFound,
+			"subnet [%s] in ipspace [%s] does not exist", name, ipspaceName)
+	}
+
+	return sn, nil
+}
+
+// parseIPRange returns the first and last address of an IP range,
+// e.g. 192.168.1.5-192.168.1.9 or a single address
+func parseIPRange(api, ipRange string) (net.IP, net.IP, error) {
+	parts := strings.SplitN(ipRange, "-", 2)
+	first := net.ParseIP(strings.TrimSpace(parts[0]))
+	last := first
+	if len(parts) == 2 {
+		last = net.ParseIP(strings.TrimSpace(parts[1]))
+	}
+
+	if first == nil || last == nil || bytes.Compare(first, last) > 0 {
+		return nil, nil, apiError(api, errnoInvalidInput,
+			"invalid ip range [%s]", ipRange)
+	}
+
+	return first, last, nil
+}
+
+// rangeSize returns the number of addresses in the IP range
+func rangeSize(first, last net.IP) int {
+	size := new(big.Int).Sub(
+		new(big.Int).SetBytes(last.To16()), new(big.Int).SetBytes(first.To16()))
+
+	return int(size.Int64()) + 1
+}
+
+// checkSubnet validates gateway and IP ranges are within the network
+func checkSubnet(api string, ipNet *net.IPNet, gateway string, ranges []string) error {
+	if gateway != "" {
+		gw := net.ParseIP(gateway)
+		if gw == nil || !ipNet.Contains(gw) {
+			return apiError(api, errnoInvalidInput,
+				"gateway [%s] is not within subnet [%s]", gateway, ipNet)
+		}
+	}
+
+	for _, ipRange := range ranges {
+		first, last, err := parseIPRange(api, ipRange)
+		if err != nil {
+			return err
+		}
+		if !ipNet.Contains(first) || !ipNet.Contains(last) {
+			return apiError(api, errnoInvalidInput,
+				"ip range [%s] is not within subnet [%s]", ipRange, ipNet)
+		}
+	}
+
+	return nil
+}
+
+func parseSubnet(api, cidr string) (*net.IPNet, error) {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, apiError(api, errnoInvalidInput,
+			"invalid subnet [%s]", cidr)
+	}
+
+	return ipNet, nil
+}
+
+func subnetInfo(sn *subnet) *network.SubnetInfo {
+	info := &network.SubnetInfo{}
+	for _, ipRange := range sn.ranges {
+		first, last, _ := parseIPRange("", ipRange)
+		info.IPCount += rangeSize(first, last)
+	}
+	info.IPAvailable = info.IPCount
+
+	info.Name = sn.name
+	info.BroadCastDomain = sn.bcDomain
+	info.Gateway = sn.gateway
+	info.IPSpace = sn.ipspace
+	info.Subnet = sn.network.String()
+	info.IPRanges = append([]string{}, sn.ranges...)
+
+	return info
+}
+
+func subnetGet(c *Cluster, data []byte) (interface{}, error) {
+	request := network.SubnetRequest{}
+	if err := decodeRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.Name == "" || request.BroadCastDomain == "" {
+		return nil, fmt.Errorf(
+			"subnet get commands must have name and broadcast domain"+
+				" defined, got: %+v", request)
+	}
+
+	for _, sn := range c.subnets {
+		if sn.name == request.Name && sn.bcDomain == request.BroadCastDomain &&
+			(request.IPSpace == "" || request.IPSpace == sn.ipspace) {
+			return subnetInfo(sn), nil
+		}
+	}
+
+	return nonExistResponse(), nil
+}
+
+func subnetCreate(c *Cluster, data []byte) (interface{}, error) {
+	const api = "net-subnet-create"
+
+	request := network.SubnetRequest{}
+	if err := decodeSubnetRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.BroadCastDomain == "" || request.Subnet == "" {
+		return nil, fmt.Errorf(
+			"subnet create commands must have name, broadcast domain,"+
+				" ipspace and subnet defined, got: %+v", request)
+	}
+
+	if _, err := c.getBcDomain(api, request.IPSpace, request.BroadCastDomain); err != nil {
+		return nil, err
+	}
+	key := qualifiedName(request.IPSpace, request.Name)
+	if _, ok := c.subnets[key]; ok {
+		return nil, apiError(api, errnoDuplicateEntry, "duplicate entry")
+	}
+
+	ipNet, err := parseSubnet(api, request.Subnet)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkSubnet(api, ipNet, request.Gateway, request.IPRanges); err != nil {
+		return nil, err
+	}
+
+	sn := &subnet{
+		name: request.Name, ipspace: request.IPSpace,
+		bcDomain: request.BroadCastDomain, network: ipNet,
+		gateway: request.Gateway, ranges: append([]string{}, request.IPRanges...),
+	}
+	sort.Strings(sn.ranges)
+	c.subnets[key] = sn
+
+	return subnetInfo(sn), nil
+}
+
+func subnetDelete(c *Cluster, data []byte) (interface{}, error) {
+	request := network.SubnetRequest{}
+	if err := decodeSubnetRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	sn, err := c.getSubnet("net-subnet-destroy", request.IPSpace, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	delete(c.subnets, qualifiedName(sn.ipspace, sn.name))
+
+	return emptyResponse(), nil
+}
+
+func subnetRename(c *Cluster, data []byte) (interface{}, error) {
+	const api = "net-subnet-rename"
+
+	request := network.SubnetRequest{}
+	if err := decodeSubnetRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.NewName == "" {
+		return nil, fmt.Errorf(
+			"subnet rename commands must have name, new name and"+
+				" ipspace defined, got: %+v", request)
+	}
+
+	sn, err := c.getSubnet(api, request.IPSpace, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	newKey := qualifiedName(sn.ipspace, request.NewName)
+	if _, ok := c.subnets[newKey]; ok {
+		return nil, apiError(api, errnoDuplicateEntry, "duplicate entry")
+	}
+
+	delete(c.subnets, qualifiedName(sn.ipspace, sn.name))
+	sn.name = request.NewName
+	c.subnets[newKey] = sn
+
+	return emptyResponse(), nil
+}
+
+func subnetIPRangesModify(c *Cluster, data []byte, cmdType string) (interface{}, error) {
+	api := "net-subnet-" + cmdType + "-ranges"
+
+	request := network.SubnetRequest{}
+	if err := decodeSubnetRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if len(request.IPRanges) < 1 {
+		return nil, fmt.Errorf(
+			"subnet ip range %s commands must have name, ipspace"+
+				" and ip_ranges defined, got: %+v", cmdType, request)
+	}
+
+	sn, err := c.getSubnet(api, request.IPSpace, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	ranges := map[string]bool{}
+	for _, ipRange := range sn.ranges {
+		ranges[ipRange] = true
+	}
+
+	for _, ipRange := range request.IPRanges {
+		if cmdType == "add" {
+			if ranges[ipRange] {
+				return nil, apiError(api, errnoDuplicateEntry, "duplicate entry")
+			}
+			if err := checkSubnet(api, sn.network, "", []string{ipRange}); err != nil {
+				return nil, err
+			}
+			ranges[ipRange] = true
+		} else {
+			if !ranges[ipRange] {
+				return nil, apiError(api, errnoObjectNotFound,
+					"ip range [%s] is not part of subnet [%s]", ipRange, sn.name)
+			}
+			delete(ranges, ipRange)
+		}
+	}
+	sn.ranges = sortedKeys(ranges)
+
+	return emptyResponse(), nil
+}
+
+func subnetIPRangeAdd(c *Cluster, data []byte) (interface{}, error) {
+	return subnetIPRangesModify(c, data, "add")
+}
+
+func subnetIPRangeRemove(c *Cluster, data []byte) (interface{}, error) {
+	return subnetIPRangesModify(c, data, "remove")
+}
+
+func subnetModify(c *Cluster, data []byte) (interface{}, error) {
+	const api = "net-subnet-modify"
+
+	request := network.SubnetRequest{}
+	if err := decodeSubnetRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.Gateway == "" && request.Subnet == "" {
+		return nil, fmt.Errorf(
+			"subnet modify must have name, ipspace and either gateway"+
+				" or subnet defined, got: %+v", request)
+	}
+
+	sn, err := c.getSubnet(api, request.IPSpace, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	ipNet := sn.network
+	if request.Subnet != "" {
+		if ipNet, err = parseSubnet(api, request.Subnet); err != nil {
+			return nil, err
+		}
+	}
+	gateway := sn.gateway
+	if request.Gateway != "" {
+		gateway = request.Gateway
+	}
+	if err := checkSubnet(api, ipNet, gateway, sn.ranges); err != nil {
+		return nil, err
+	}
+
+	sn.network = ipNet
+	sn.gateway = gateway
+
+	return emptyResponse(), nil
+}
@@ -0,0 +1,378 @@
+package simapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	grpcpyapi "github.com/jogam/terraform-provider-netapp/netapp/internal/grpcapi"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/pythonapi"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/system"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/zapi"
+)
+
+const (
+	connectCmd     = "SYS.CONNECT"
+	connectInfoCmd = "SYS.CONNECT.INFO"
+)
+
+// versions reported by the simulated cluster
+const (
+	OntapVersion = "1.130"
+	OsVersion    = "NetApp Release 9.3 (simulated)"
+)
+
+// ZAPI errno values returned for violated cluster invariants
+const (
+	errnoInvalidInput   = 13115 // EINVALIDINPUTERROR
+	errnoDuplicateEntry = 13130 // EDUPLICATEENTRY
+	errnoObjectNotFound = 15661 // EOBJECTNOTFOUND
+	errnoInUse          = 13001 // EAPIERROR, object still in use
+)
+
+// command executes one NetApp API command on the simulated cluster, the
+// data is the JSON request as created by the helpers, the result is JSON
+// marshalled. Commands are executed with the cluster lock held
+type command func(c *Cluster, data []byte) (interface{}, error)
+
+var commands = map[string]command{}
+
+func registerCommands(cmds map[string]command) {
+	for name, cmd := range cmds {
+		commands[name] = cmd
+	}
+}
+
+// Cluster is an in-memory ONTAP cluster, it keeps the state of all
+// objects managed by the provider and enforces ONTAP like invariants,
+// e.g. a port can only belong to one broadcast domain
+type Cluster struct {
+	lock sync.Mutex
+
+	// JobPolls is the number of SYS.JOB.GET polls a job stays running
+	JobPolls int
+
+	// Delays simulate slow or hung commands, a command waits for its
+	// delay or until the call context is done, key: command name
+	Delays map[string]time.Duration
+
+	// Faults fail commands with the ZAPI errno instead of executing them,
+	// e.g. objects deleted or locked outside of terraform, key: command name
+	Faults map[string]int
+
+	nodes     map[string]*node     // key: node name
+	ports     map[string]*port     // key: node:port
+	groups    map[string]*group    // key: node:ifgrp
+	aggrs     map[string]*aggr     // key: aggregate name
+	ipspaces  map[string]*ipspace  // key: ipspace name
+	bcDomains map[string]*bcDomain // key: ipspace:broadcast domain
+	subnets   map[string]*subnet   // key: ipspace:subnet
+	svms      map[string]*vserver  // key: SVM name
+	volumes   map[string]*volume   // key: SVM:volume
+	jobs      map[int]*job
+
+	nextJobID  int
+	nextMac    int
+	cmdCount   map[string]int
+	batchCount int
+}
+
+// NewCluster returns an empty cluster with the Default and Cluster IPspace
+func NewCluster() *Cluster {
+	c := &Cluster{
+		JobPolls:  1,
+		nodes:     map[string]*node{},
+		ports:     map[string]*port{},
+		groups:    map[string]*group{},
+		aggrs:     map[string]*aggr{},
+		ipspaces:  map[string]*ipspace{},
+		bcDomains: map[string]*bcDomain{},
+		subnets:   map[string]*subnet{},
+		svms:      map[string]*vserver{},
+		volumes:   map[string]*volume{},
+		jobs:      map[int]*job{},
+		cmdCount:  map[string]int{},
+	}
+
+	for _, name := range []string{defaultIPSpace, "Cluster"} {
+		c.ipspaces[name] = &ipspace{name: name, uuid: newUUID()}
+	}
+
+	return c
+}
+
+// API returns a new NetApp API connected to the simulated cluster
+func (c *Cluster) API() *pythonapi.NetAppAPI {
+	return pythonapi.NewNetAppAPI(c.Impl())
+}
+
+// Impl returns a new API implementation on the simulated cluster, e.g.
+// to serve the cluster over gRPC
+func (c *Cluster) Impl() *NetAppSim {
+	return &NetAppSim{cluster: c, sessions: map[string]*simSession{}}
+}
+
+// BatchCount returns how many batch calls were executed
+func (c *Cluster) BatchCount() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.batchCount
+}
+
+// CommandCount returns how often the command was executed successfully
+func (c *Cluster) CommandCount(cmdName string) int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.cmdCount[cmdName]
+}
+
+// NetAppSim executes the NetApp API commands on a simulated cluster, like
+// the Python API it keeps the connection per client session
+type NetAppSim struct {
+	lock     sync.Mutex
+	cluster  *Cluster
+	sessions map[string]*simSession
+}
+
+// simSession is the cluster connection of a client session
+type simSession struct {
+	host string
+	user string
+}
+
+func newUUID() string {
+	return uuid.New().String()
+}
+
+func decodeRequest(data []byte, request interface{}) error {
+	if err := json.Unmarshal(data, request); err != nil {
+		return fmt.Errorf("request decode error: %s", err)
+	}
+
+	return nil
+}
+
+func emptyResponse() interface{} {
+	return &pythonapi.EmptyResponse{Dummy: 1}
+}
+
+func nonExistResponse() interface{} {
+	return &pythonapi.ResourceInfo{NonExist: true}
+}
+
+// apiError returns a ZAPI like error for the simulated API call
+func apiError(api string, errno int, format string, args ...interface{}) error {
+	return &zapi.Error{API: api, ErrNo: errno, Reason: fmt.Sprintf(format, args...)}
+}
+
+// qualifiedName returns the cluster wide port name 'node:port'
+func qualifiedName(nodeName, portName string) string {
+	return nodeName + ":" + portName
+}
+
+// splitQualifiedName splits the cluster wide port name 'node:port'
+func splitQualifiedName(api, name string) (string, string, error) {
+	parts := strings.SplitN(name, ":", 2)
+	if len(parts) != 2 {
+		return "", "", apiError(api, errnoInvalidInput,
+			"port name [%s] not qualified as 'node:port'", name)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// sortedKeys returns the sorted keys of a name set
+func sortedKeys(names map[string]bool) []string {
+	keys := []string{}
+	for name := range names {
+		keys = append(keys, name)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func (api *NetAppSim) connect(session string, data []byte) (interface{}, error) {
+	request := system.ConnectRequest{}
+	if err := decodeRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.Host == "" || request.User == "" {
+		return nil, fmt.Errorf(
+			"API connect requires host and user, got: %s@%s",
+			request.User, request.Host)
+	}
+
+	api.lock.Lock()
+	api.sessions[session] = &simSession{host: request.Host, user: request.User}
+	api.lock.Unlock()
+
+	return &system.ConnectResponse{
+		OntapVersion: OntapVersion,
+		OsVersion:    OsVersion,
+	}, nil
+}
+
+func (api *NetAppSim) connectInfo(session string) (interface{}, error) {
+	api.lock.Lock()
+	defer api.lock.Unlock()
+
+	info := &system.ConnectInfo{}
+	if conn, ok := api.sessions[session]; ok {
+		info.Host = conn.host
+		info.User = conn.user
+		info.Connected = true
+	}
+	info.OntapVersion = OntapVersion
+	info.OsVersion = OsVersion
+
+	return info, nil
+}
+
+func (api *NetAppSim) execute(
+	session, cmdName string, data []byte) (interface{}, error) {
+	switch cmdName {
+	case connectCmd:
+		return api.connect(session, data)
+	case connectInfoCmd:
+		return api.connectInfo(session)
+	}
+
+	cmd, ok := commands[cmdName]
+	if !ok {
+		return nil, fmt.Errorf("could not get command: %s", cmdName)
+	}
+
+	api.lock.Lock()
+	_, connected := api.sessions[session]
+	api.lock.Unlock()
+
+	if !connected {
+		return nil, fmt.Errorf("API not connected, call Connect() first")
+	}
+
+	api.cluster.lock.Lock()
+	defer api.cluster.lock.Unlock()
+
+	if errno := api.cluster.Faults[cmdName]; errno != 0 {
+		return nil, apiError(cmdName, errno, "simulated fault")
+	}
+
+	result, err := cmd(api.cluster, data)
+	if err == nil {
+		api.cluster.cmdCount[cmdName]++
+	}
+
+	return result, err
+}
+
+// Call executes the named command with JSON request data in the session
+// of the incoming gRPC call, in-process calls share the empty session
+func (api *NetAppSim) Call(
+	ctx context.Context, cmdName string, data []byte) (*grpcpyapi.CallResponse, error) {
+	if delay := api.cluster.Delays[cmdName]; delay > 0 {
+		select {
+		case <-ctx.Done():
+			// a transport error as returned by gRPC for an aborted call
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+
+	result, err := api.execute(
+		grpcpyapi.SessionFromContext(ctx), cmdName, data)
+	if err != nil {
+		log.Printf("[WARN] simulated cmd [%s] failed with: %s", cmdName, err)
+		return &grpcpyapi.CallResponse{
+			Errmsg: fmt.Sprintf("failed cmd [%s] with %s", cmdName, err),
+			Errno:  int32(zapi.ErrNo(err)),
+		}, nil
+	}
+
+	resData, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"cmd [%s] result marshal error: %s", cmdName, err)
+	}
+
+	return &grpcpyapi.CallResponse{Success: true, Data: resData}, nil
+}
+
+// CallBatch executes the calls in order like the Python API, a failed call
+// does not stop the batch
+func (api *NetAppSim) CallBatch(
+	ctx context.Context, calls []*grpcpyapi.CallRequest) ([]*grpcpyapi.CallResponse, error) {
+	api.cluster.lock.Lock()
+	api.cluster.batchCount++
+	api.cluster.lock.Unlock()
+
+	results := make([]*grpcpyapi.CallResponse, 0, len(calls))
+	for _, call := range calls {
+		resp, err := api.Call(ctx, call.Cmd, call.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, resp)
+	}
+
+	return results, nil
+}
+
+// WatchJob pushes the job info of every SYS.JOB.GET poll that changed,
+// each poll advances the simulated job until it ended
+func (api *NetAppSim) WatchJob(
+	ctx context.Context, data []byte,
+	update func(data []byte) error) (bool, string, error) {
+
+	var last []byte
+	for {
+		if err := ctx.Err(); err != nil {
+			return false, "", err
+		}
+
+		resp, err := api.Call(ctx, "SYS.JOB.GET", data)
+		if err != nil || !resp.Success {
+			if err != nil {
+				return false, "", err
+			}
+			return false, resp.Errmsg, nil
+		}
+		info := resp.Data
+
+		if !bytes.Equal(info, last) {
+			if err := update(info); err != nil {
+				return false, "", err
+			}
+			last = info
+		}
+
+		jInfo := system.JobInfo{}
+		if err := json.Unmarshal(info, &jInfo); err != nil {
+			return false, "", fmt.Errorf("job info decode error: %s", err)
+		}
+		if system.JobEnded(jInfo.Status) {
+			return true, "", nil
+		}
+	}
+}
+
+// Shutdown closes the session of the client, the simulated cluster has
+// nothing to stop
+func (api *NetAppSim) Shutdown(ctx context.Context, clientID string) (bool, error) {
+	api.lock.Lock()
+	delete(api.sessions, clientID)
+	api.lock.Unlock()
+
+	return true, nil
+}
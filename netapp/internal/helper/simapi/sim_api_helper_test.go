@@ -0,0 +1,324 @@
+package simapi
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/network"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/pythonapi"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/svm"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/system"
+)
+
+// testCluster returns a cluster with one node, two ports, an aggregate
+// and a connected API
+func testCluster(t *testing.T) (*Cluster, *pythonapi.NetAppAPI) {
+	c := NewCluster()
+	c.JobPolls = 0
+	c.AddNode("node1")
+	c.AddPort("node1", "e0c")
+	c.AddPort("node1", "e0d")
+	c.AddAggr("aggr1", "node1")
+
+	api := c.API()
+	_, err := system.Connect(api, &system.ConnectRequest{Host: "sim", User: "admin"})
+	require.NoError(t, err)
+
+	return c, api
+}
+
+func Test_Sim_NotConnected(t *testing.T) {
+	r := require.New(t)
+	api := NewCluster().API()
+
+	_, err := system.NodeGetByName(api, "node1")
+	r.Error(err)
+	r.Contains(err.Error(), "API not connected")
+
+	resp, err := system.Connect(api, &system.ConnectRequest{Host: "sim", User: "admin"})
+	r.NoError(err)
+	r.Equal(OntapVersion, resp.OntapVersion)
+
+	node, err := system.NodeGetByName(api, "node1")
+	r.NoError(err)
+	r.True(node.NonExist)
+}
+
+func Test_Sim_VlanNeedsParent(t *testing.T) {
+	r := require.New(t)
+	c, api := testCluster(t)
+
+	err := network.VlanCreate(api, &network.VlanRequest{
+		NodeName: "node1", ParentName: "e0x", VlanID: "100"})
+	r.Error(err)
+	r.Contains(err.Error(), "does not exist")
+
+	request := &network.VlanRequest{
+		NodeName: "node1", ParentName: "e0c", VlanID: "100"}
+	r.NoError(network.VlanCreate(api, request))
+	r.Equal(1, c.CommandCount("NW.VLAN.CREATE"))
+
+	err = network.VlanCreate(api, request)
+	r.Error(err)
+	r.Contains(err.Error(), "reason=\"duplicate entry\"")
+
+	info, err := network.VlanGet(api, request)
+	r.NoError(err)
+	r.Equal("e0c-100", info.Name)
+
+	port, err := system.PortGetByNames(api, "node1", "e0c-100")
+	r.NoError(err)
+	r.Equal("vlan", port.Type)
+	r.Equal("e0c", port.VlanPort)
+
+	// VLAN on VLAN is not allowed
+	err = network.VlanCreate(api, &network.VlanRequest{
+		NodeName: "node1", ParentName: "e0c-100", VlanID: "200"})
+	r.Error(err)
+
+	r.NoError(network.VlanDelete(api, request))
+	info, err = network.VlanGet(api, request)
+	r.NoError(err)
+	r.True(info.NonExist)
+}
+
+func Test_Sim_PortSingleBroadcastDomain(t *testing.T) {
+	r := require.New(t)
+	_, api := testCluster(t)
+
+	bcInfo, err := network.BcDomainCreate(api, &network.BcDomainRequest{
+		Name: "bcd1", Mtu: "1500", Ports: []string{"node1:e0c"}})
+	r.NoError(err)
+	r.Equal("complete", bcInfo.PortUpdateStatus)
+
+	_, err = network.BcDomainCreate(api, &network.BcDomainRequest{
+		Name: "bcd2", Mtu: "1500", Ports: []string{"node1:e0c"}})
+	r.Error(err)
+	r.Contains(err.Error(), "already member of broadcast domain [bcd1]")
+
+	_, err = network.BcDomainCreate(api, &network.BcDomainRequest{
+		Name: "bcd2", Mtu: "1500"})
+	r.NoError(err)
+	_, err = network.BcDomainPortsModify(
+		api, "bcd2", defaultIPSpace, []string{"node1:e0c"}, true, false)
+	r.Error(err)
+
+	_, err = network.BcDomainPortsModify(
+		api, "bcd1", defaultIPSpace, []string{"node1:e0c"}, false, true)
+	r.NoError(err)
+	_, err = network.BcDomainPortsModify(
+		api, "bcd2", defaultIPSpace, []string{"node1:e0c"}, true, false)
+	r.NoError(err)
+
+	port, err := system.PortGetByNames(api, "node1", "e0c")
+	r.NoError(err)
+	r.Equal("bcd2", port.BroadCastDomain)
+}
+
+func Test_Sim_BroadcastDomainInProgress(t *testing.T) {
+	r := require.New(t)
+	c, api := testCluster(t)
+	c.JobPolls = 2
+
+	bcInfo, err := network.BcDomainCreate(api, &network.BcDomainRequest{
+		Name: "bcd1", Mtu: "1500", Ports: []string{"node1:e0c"}})
+	r.NoError(err)
+	r.Equal("in_progress", bcInfo.PortUpdateStatus)
+
+	status, err := network.BcDomainWaitForInProgressDone(context.Background(), api, "bcd1")
+	r.NoError(err)
+	r.Equal("complete", status)
+	r.Equal(3, c.CommandCount("NW.BRCDOM.STATUS"))
+}
+
+func Test_Sim_BroadcastDomainInProgressTimeout(t *testing.T) {
+	r := require.New(t)
+	c, api := testCluster(t)
+	// the port update never finishes
+	c.JobPolls = math.MaxInt32
+
+	_, err := network.BcDomainCreate(api, &network.BcDomainRequest{
+		Name: "bcd1", Mtu: "1500", Ports: []string{"node1:e0c"}})
+	r.NoError(err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1200*time.Millisecond)
+	defer cancel()
+	_, err = network.BcDomainWaitForInProgressDone(ctx, api, "bcd1")
+	r.Error(err)
+	r.Contains(err.Error(), "broadcast domain [bcd1] port update wait ended")
+	r.NotZero(c.CommandCount("NW.BRCDOM.STATUS"))
+}
+
+func Test_Sim_VlanMtu(t *testing.T) {
+	r := require.New(t)
+	_, api := testCluster(t)
+
+	r.NoError(network.VlanCreate(api, &network.VlanRequest{
+		NodeName: "node1", ParentName: "e0c", VlanID: "100"}))
+	_, err := network.BcDomainCreate(api, &network.BcDomainRequest{
+		Name: "bcd1", Mtu: "9000", Ports: []string{"node1:e0c-100"}})
+	r.Error(err)
+	r.Contains(err.Error(), "exceeds MTU [1500] of parent port [e0c]")
+
+	r.NoError(system.PortModify(api, &system.PortModifyRequest{
+		PortGetRequest: system.PortGetRequest{NodeName: "node1", PortName: "e0c"},
+		Mtu:            "9000"}))
+	_, err = network.BcDomainCreate(api, &network.BcDomainRequest{
+		Name: "bcd1", Mtu: "9000", Ports: []string{"node1:e0c-100"}})
+	r.NoError(err)
+
+	// the parent port MTU can not go below its VLAN MTU
+	err = system.PortModify(api, &system.PortModifyRequest{
+		PortGetRequest: system.PortGetRequest{NodeName: "node1", PortName: "e0c"},
+		Mtu:            "1500"})
+	r.Error(err)
+
+	// the VLAN MTU is managed by the broadcast domain
+	err = system.PortModify(api, &system.PortModifyRequest{
+		PortGetRequest: system.PortGetRequest{NodeName: "node1", PortName: "e0c-100"},
+		Mtu:            "1500"})
+	r.Error(err)
+	r.Contains(err.Error(), "managed by broadcast domain")
+}
+
+func Test_Sim_ObjectsInUse(t *testing.T) {
+	r := require.New(t)
+	_, api := testCluster(t)
+
+	_, err := network.IPSpaceCreate(api, "ips1")
+	r.NoError(err)
+	_, err = network.BcDomainCreate(api, &network.BcDomainRequest{
+		Name: "bcd1", Mtu: "1500", IPSpace: "ips1",
+		Ports: []string{"node1:e0c"}})
+	r.NoError(err)
+	_, err = network.SubnetCreate(api, &network.SubnetRequest{
+		Name: "sn1", BroadCastDomain: "bcd1", IPSpace: "ips1",
+		Subnet: "10.0.0.0/24", IPRanges: []string{"10.0.1.1"}})
+	r.Error(err)
+	r.Contains(err.Error(), "not within subnet")
+
+	snInfo, err := network.SubnetCreate(api, &network.SubnetRequest{
+		Name: "sn1", BroadCastDomain: "bcd1", IPSpace: "ips1",
+		Subnet: "10.0.0.0/24", IPRanges: []string{"10.0.0.10-10.0.0.19"}})
+	r.NoError(err)
+	r.Equal(10, snInfo.IPCount)
+
+	r.Error(network.IPSpaceDelete(api, "ips1"))
+	_, err = network.BcDomainDelete(api, "bcd1", "ips1")
+	r.Error(err)
+	r.Contains(err.Error(), "used by subnet [sn1]")
+
+	r.NoError(network.SubnetDelete(api, &network.SubnetRequest{
+		Name: "sn1", IPSpace: "ips1"}))
+	_, err = network.BcDomainDelete(api, "bcd1", "ips1")
+	r.NoError(err)
+	r.NoError(network.IPSpaceDelete(api, "ips1"))
+	r.Error(network.IPSpaceDelete(api, defaultIPSpace))
+}
+
+func Test_Sim_PortGroup(t *testing.T) {
+	r := require.New(t)
+	_, api := testCluster(t)
+
+	request := &system.PortGroupModifyRequest{
+		Mode: "multimode_lacp", LoadDistribution: "ip"}
+	request.NodeName = "node1"
+	request.GroupName = "a0a"
+	r.NoError(system.PortGroupCreate(api, request))
+	r.NoError(system.PortGroupPortsModify(
+		api, "node1", "a0a", []string{"e0c", "e0d"}, true, false))
+
+	info, err := system.PortGroupGetByNames(api, "node1", "a0a")
+	r.NoError(err)
+	r.Equal([]string{"e0c", "e0d"}, info.Ports)
+	r.Equal("full", info.GroupLinkStatus)
+
+	// group members can neither host VLANs nor join a broadcast domain
+	r.Error(network.VlanCreate(api, &network.VlanRequest{
+		NodeName: "node1", ParentName: "e0c", VlanID: "100"}))
+	_, err = network.BcDomainCreate(api, &network.BcDomainRequest{
+		Name: "bcd1", Mtu: "1500", Ports: []string{"node1:e0d"}})
+	r.Error(err)
+
+	found, err := system.PortFindByNamePattern(api, "node1", "a*")
+	r.NoError(err)
+	r.Equal([]string{"a0a"}, found.Names)
+
+	r.NoError(system.PortGroupDelete(api, "node1", "a0a"))
+	info, err = system.PortGroupGetByNames(api, "node1", "a0a")
+	r.NoError(err)
+	r.True(info.NonExist)
+	r.NoError(network.VlanCreate(api, &network.VlanRequest{
+		NodeName: "node1", ParentName: "e0c", VlanID: "100"}))
+}
+
+func Test_Sim_SvmLifecycle(t *testing.T) {
+	r := require.New(t)
+	c, api := testCluster(t)
+	c.JobPolls = 1
+
+	_, err := svm.Create(api, &svm.Request{
+		Name: "svm1", IPSpace: "missing", RootAggr: "aggr1"})
+	r.Error(err)
+	_, err = svm.Create(api, &svm.Request{
+		Name: "svm1", IPSpace: defaultIPSpace, RootAggr: "missing"})
+	r.Error(err)
+
+	jobRes, err := svm.Create(api, &svm.Request{
+		Name: "svm1", IPSpace: defaultIPSpace, RootAggr: "aggr1"})
+	r.NoError(err)
+	r.Equal("in_progress", jobRes.Status)
+
+	info, err := svm.GetByName(api, "svm1")
+	r.NoError(err)
+	r.Equal("starting", info.SvmState)
+	r.Equal("svm1_root", info.RootName)
+
+	job, err := system.JobGetByID(api, jobRes.JobID)
+	r.NoError(err)
+	r.Equal("running", job.Status)
+	job, err = system.JobGetByID(api, jobRes.JobID)
+	r.NoError(err)
+	r.Equal("success", job.Status)
+
+	info, err = svm.GetByName(api, "svm1")
+	r.NoError(err)
+	r.Equal("running", info.SvmState)
+
+	// ipspace is in use by the SVM
+	r.Error(network.IPSpaceDelete(api, defaultIPSpace))
+
+	volReq := &svm.VolumeRequest{VolumeName: "svm1_root", Size: "50m"}
+	volReq.SvmInstanceName = "svm1"
+	volInfo, err := svm.VolumeSizeCommand(api, volReq)
+	r.NoError(err)
+	r.Equal("50m", volInfo.Size)
+
+	_, err = svm.DeleteByName(api, "svm1")
+	r.Error(err)
+	r.NoError(svm.ExecuteSimpleCommand(api, "svm1", svm.StopCmd, false))
+	_, err = svm.DeleteByName(api, "svm1")
+	r.Error(err)
+	r.Contains(err.Error(), "still has volume")
+
+	r.Error(svm.VolumeSimpleCommand(
+		api, "svm1", "svm1_root", svm.VolumeDeleteCommand))
+	r.NoError(svm.VolumeSimpleCommand(
+		api, "svm1", "svm1_root", svm.VolumeOfflineCommand))
+	r.NoError(svm.VolumeSimpleCommand(
+		api, "svm1", "svm1_root", svm.VolumeDeleteCommand))
+
+	jobRes, err = svm.DeleteByName(api, "svm1")
+	r.NoError(err)
+	job, err = system.JobWaitDone(api, jobRes.JobID)
+	r.NoError(err)
+	r.Equal("success", job.Status)
+
+	info, err = svm.GetByName(api, "svm1")
+	r.NoError(err)
+	r.True(info.NonExist)
+}
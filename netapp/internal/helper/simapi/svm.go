@@ -0,0 +1,418 @@
+package simapi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/svm"
+)
+
+func init() {
+	registerCommands(map[string]command{
+		"SVM.GET":    svmGet,
+		"SVM.CREATE": svmCreate,
+		"SVM.DELETE": svmDelete,
+		"SVM.START":  svmStateCommand("vserver-start", "running"),
+		"SVM.STOP":   svmStateCommand("vserver-stop", "stopped"),
+		"SVM.UNLOCK": svmUnlock,
+		"SVM.RENAME": svmRename,
+
+		"SVM.VOL.ONLINE":   volumeStateCommand("online"),
+		"SVM.VOL.OFFLINE":  volumeStateCommand("offline"),
+		"SVM.VOL.RESTRICT": volumeStateCommand("restricted"),
+		"SVM.VOL.DELETE":   volumeDelete,
+		"SVM.VOL.SIZE":     volumeSize,
+	})
+}
+
+const (
+	defaultRootSecStyle = "unix"
+	defaultRootSize     = 1 << 30
+	defaultRetention    = "12"
+)
+
+// svmProtocols are the protocols reported as inactive for new SVMs
+var svmProtocols = []string{"nfs", "cifs", "fcp", "iscsi", "ndmp"}
+
+type vserver struct {
+	name      string
+	uuid      string
+	ipspace   string
+	rootAggr  string
+	rootName  string
+	secStyle  string
+	retention string
+	locked    bool
+	state     string
+}
+
+type volume struct {
+	svm   string
+	name  string
+	aggr  string
+	size  int64
+	state string
+}
+
+//*****************************************************************************
+// SVM commands
+
+func decodeSvmNameRequest(data []byte, request *svm.Request, cmdType string) error {
+	if err := decodeRequest(data, request); err != nil {
+		return err
+	}
+	if request.Name == "" {
+		return fmt.Errorf(
+			"%s SVM request must have name defined, got: %+v",
+			cmdType, *request)
+	}
+
+	return nil
+}
+
+// getSvm returns the SVM or an object not found error
+func (c *Cluster) getSvm(api, name string) (*vserver, error) {
+	vs, ok := c.svms[name]
+	if !ok {
+		return nil, apiError(api, errnoObjectNotFound,
+			"vserver [%s] does not exist", name)
+	}
+
+	return vs, nil
+}
+
+func svmGet(c *Cluster, data []byte) (interface{}, error) {
+	request := svm.Request{}
+	if err := decodeRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.Name == "" && request.UUID == "" {
+		return nil, fmt.Errorf(
+			"get SVM request must have name or uuid defined, got: %+v",
+			request)
+	}
+
+	for _, vs := range c.svms {
+		if (request.Name != "" && request.Name != vs.name) ||
+			(request.UUID != "" && request.UUID != vs.uuid) {
+			continue
+		}
+
+		info := &svm.Info{
+			ConfigLocked:  vs.locked,
+			OperState:     vs.state,
+			SvmState:      vs.state,
+			ProtoEnabled:  []string{},
+			ProtoInactive: append([]string{}, svmProtocols...),
+		}
+		info.Name = vs.name
+		info.UUID = vs.uuid
+		info.IPSpace = vs.ipspace
+		info.RootAggr = vs.rootAggr
+		info.RootName = vs.rootName
+		info.RootSecStyle = vs.secStyle
+		info.RootRetention = vs.retention
+
+		return info, nil
+	}
+
+	return nonExistResponse(), nil
+}
+
+func svmCreate(c *Cluster, data []byte) (interface{}, error) {
+	const api = "vserver-create-async"
+
+	request := svm.Request{}
+	if err := decodeRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.Name == "" || request.IPSpace == "" || request.RootAggr == "" {
+		return nil, fmt.Errorf(
+			"create SVM request must have name, ipspace and root aggregate"+
+				" defined, got: %+v", request)
+	}
+
+	if _, ok := c.svms[request.Name]; ok {
+		return nil, apiError(api, errnoDuplicateEntry, "duplicate entry")
+	}
+	if _, err := c.getIPSpace(api, request.IPSpace); err != nil {
+		return nil, err
+	}
+	if _, ok := c.aggrs[request.RootAggr]; !ok {
+		return nil, apiError(api, errnoObjectNotFound,
+			"aggregate [%s] does not exist", request.RootAggr)
+	}
+
+	vs := &vserver{
+		name: request.Name, uuid: newUUID(), ipspace: request.IPSpace,
+		rootAggr: request.RootAggr, rootName: request.RootName,
+		secStyle: request.RootSecStyle, retention: request.RootRetention,
+		state: "starting",
+	}
+	if vs.rootName == "" {
+		vs.rootName = vs.name + "_root"
+	}
+	if vs.secStyle == "" {
+		vs.secStyle = defaultRootSecStyle
+	}
+	if vs.retention == "" {
+		vs.retention = defaultRetention
+	}
+
+	c.svms[vs.name] = vs
+	c.volumes[qualifiedName(vs.name, vs.rootName)] = &volume{
+		svm: vs.name, name: vs.rootName, aggr: vs.rootAggr,
+		size: defaultRootSize, state: "online",
+	}
+
+	j := c.newJob(vs.name, func() { vs.state = "running" })
+
+	result := &svm.JobResult{Status: "in_progress", JobID: j.id}
+	result.Name = vs.name
+	result.IPSpace = vs.ipspace
+	result.RootAggr = vs.rootAggr
+
+	return result, nil
+}
+
+func svmDelete(c *Cluster, data []byte) (interface{}, error) {
+	const api = "vserver-destroy-async"
+
+	request := svm.Request{}
+	if err := decodeSvmNameRequest(data, &request, "delete"); err != nil {
+		return nil, err
+	}
+
+	vs, err := c.getSvm(api, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if vs.state != "stopped" {
+		return nil, apiError(api, errnoInUse,
+			"vserver [%s] must be stopped, state is [%s]", vs.name, vs.state)
+	}
+	for _, vol := range c.volumes {
+		if vol.svm == vs.name {
+			return nil, apiError(api, errnoInUse,
+				"vserver [%s] still has volume [%s]", vs.name, vol.name)
+		}
+	}
+
+	vs.state = "deleting"
+	j := c.newJob(vs.name, func() { delete(c.svms, vs.name) })
+
+	return &svm.JobResult{Status: "in_progress", JobID: j.id}, nil
+}
+
+func svmStateCommand(api, state string) command {
+	return func(c *Cluster, data []byte) (interface{}, error) {
+		request := svm.Request{}
+		if err := decodeSvmNameRequest(data, &request, state); err != nil {
+			return nil, err
+		}
+
+		vs, err := c.getSvm(api, request.Name)
+		if err != nil {
+			return nil, err
+		}
+		if vs.state != "running" && vs.state != "stopped" {
+			return nil, apiError(api, errnoInUse,
+				"vserver [%s] is in state [%s]", vs.name, vs.state)
+		}
+		vs.state = state
+
+		return emptyResponse(), nil
+	}
+}
+
+func svmUnlock(c *Cluster, data []byte) (interface{}, error) {
+	request := svm.Request{}
+	if err := decodeSvmNameRequest(data, &request, "unlock"); err != nil {
+		return nil, err
+	}
+
+	vs, err := c.getSvm("vserver-unlock", request.Name)
+	if err != nil {
+		return nil, err
+	}
+	vs.locked = false
+
+	return emptyResponse(), nil
+}
+
+func svmRename(c *Cluster, data []byte) (interface{}, error) {
+	const api = "vserver-rename"
+
+	request := svm.Request{}
+	if err := decodeRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.Name == "" || request.NewName == "" {
+		return nil, fmt.Errorf(
+			"SVM rename request must have name and new_name defined, got: %+v",
+			request)
+	}
+
+	vs, err := c.getSvm(api, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := c.svms[request.NewName]; ok {
+		return nil, apiError(api, errnoDuplicateEntry, "duplicate entry")
+	}
+
+	delete(c.svms, vs.name)
+	vs.name = request.NewName
+	c.svms[vs.name] = vs
+
+	for key, vol := range c.volumes {
+		if vol.svm == request.Name {
+			delete(c.volumes, key)
+			vol.svm = vs.name
+			c.volumes[qualifiedName(vol.svm, vol.name)] = vol
+		}
+	}
+
+	return emptyResponse(), nil
+}
+
+//*****************************************************************************
+// SVM volume commands
+
+func decodeVolumeRequest(data []byte, request *svm.VolumeRequest) error {
+	if err := decodeRequest(data, request); err != nil {
+		return err
+	}
+
+	if request.SvmInstanceName == "" {
+		return fmt.Errorf(
+			"NetAPP SVM command must have SVM name [svm_name] defined, got: %+v",
+			*request)
+	}
+
+	if request.VolumeName == "" {
+		return fmt.Errorf(
+			"SVM volume request must have name defined, got: %+v", *request)
+	}
+
+	return nil
+}
+
+// getVolume returns the SVM volume or an object not found error
+func (c *Cluster) getVolume(api string, request *svm.VolumeRequest) (*volume, error) {
+	vol, ok := c.volumes[qualifiedName(request.SvmInstanceName, request.VolumeName)]
+	if !ok {
+		return nil, apiError(api, errnoObjectNotFound,
+			"volume [%s] on vserver [%s] does not exist",
+			request.VolumeName, request.SvmInstanceName)
+	}
+
+	return vol, nil
+}
+
+func volumeStateCommand(state string) command {
+	return func(c *Cluster, data []byte) (interface{}, error) {
+		request := svm.VolumeRequest{}
+		if err := decodeVolumeRequest(data, &request); err != nil {
+			return nil, err
+		}
+
+		vol, err := c.getVolume("volume-"+state, &request)
+		if err != nil {
+			return nil, err
+		}
+		vol.state = state
+
+		return emptyResponse(), nil
+	}
+}
+
+func volumeDelete(c *Cluster, data []byte) (interface{}, error) {
+	const api = "volume-destroy"
+
+	request := svm.VolumeRequest{}
+	if err := decodeVolumeRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	vol, err := c.getVolume(api, &request)
+	if err != nil {
+		return nil, err
+	}
+	if vol.state != "offline" {
+		return nil, apiError(api, errnoInUse,
+			"volume [%s] must be offline, state is [%s]", vol.name, vol.state)
+	}
+
+	delete(c.volumes, qualifiedName(vol.svm, vol.name))
+
+	return emptyResponse(), nil
+}
+
+// sizeUnits are the ZAPI volume size suffixes, largest first
+var sizeUnits = []struct {
+	suffix string
+	bytes  int64
+}{
+	{"t", 1 << 40}, {"g", 1 << 30}, {"m", 1 << 20}, {"k", 1 << 10},
+}
+
+// parseSize converts a ZAPI volume size, e.g. 20m or 1g, to bytes
+func parseSize(size string) (int64, error) {
+	size = strings.ToLower(strings.TrimSpace(size))
+	multiplier := int64(1)
+	for _, unit := range sizeUnits {
+		if strings.HasSuffix(size, unit.suffix) {
+			size = strings.TrimSuffix(size, unit.suffix)
+			multiplier = unit.bytes
+			break
+		}
+	}
+
+	value, err := strconv.ParseInt(size, 10, 64)
+	if err != nil || value < 1 {
+		return 0, apiError("volume-size", errnoInvalidInput,
+			"invalid volume size [%s]", size)
+	}
+
+	return value * multiplier, nil
+}
+
+// formatSize converts bytes to the largest even ZAPI volume size unit
+func formatSize(bytes int64) string {
+	for _, unit := range sizeUnits {
+		if bytes > 0 && bytes%unit.bytes == 0 {
+			return strconv.FormatInt(bytes/unit.bytes, 10) + unit.suffix
+		}
+	}
+
+	return strconv.FormatInt(bytes, 10)
+}
+
+func volumeSize(c *Cluster, data []byte) (interface{}, error) {
+	request := svm.VolumeRequest{}
+	if err := decodeVolumeRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	vol, err := c.getVolume("volume-size", &request)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Size != "" {
+		size, err := parseSize(request.Size)
+		if err != nil {
+			return nil, err
+		}
+		vol.size = size
+	}
+
+	info := &svm.VolumeInfo{}
+	info.SvmInstanceName = vol.svm
+	info.VolumeName = vol.name
+	info.Size = formatSize(vol.size)
+
+	return info, nil
+}
@@ -0,0 +1,704 @@
+package simapi
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/system"
+)
+
+func init() {
+	registerCommands(map[string]command{
+		"SYS.NODE.GET":              nodeGet,
+		"SYS.PORT.GET":              portGet,
+		"SYS.PORT.FIND.PATTERN":     portFindByPattern,
+		"SYS.PORT.MODIFY":           portModify,
+		"SYS.PORTGROUP.GET":         portGroupGet,
+		"SYS.PORTGROUP.CREATE":      portGroupCreate,
+		"SYS.PORTGROUP.PORT.ADD":    portGroupPortAdd,
+		"SYS.PORTGROUP.PORT.REMOVE": portGroupPortRemove,
+		"SYS.PORTGROUP.DELETE":      portGroupDelete,
+		"SYS.AGGR.GET":              aggrGet,
+		"SYS.JOB.GET":               jobGet,
+	})
+}
+
+const (
+	portTypePhysical = "physical"
+	portTypeVlan     = "vlan"
+	portTypeGroup    = "if_group"
+
+	defaultMtu = 1500
+)
+
+type node struct {
+	name   string
+	uuid   string
+	serial string
+	id     string
+}
+
+type port struct {
+	node     string
+	name     string
+	portType string
+	mac      string
+
+	up           bool
+	mtu          int
+	auto         bool
+	speed        string
+	duplex       string
+	flow         string
+	autoRevert   int
+	ignoreHealth bool
+	role         string
+
+	ipspace  string
+	bcDomain string
+
+	vlanID   int    // VLAN ports only
+	vlanPort string // VLAN ports only, parent port name on same node
+	group    string // if_group the port is member of
+}
+
+type group struct {
+	node  string
+	name  string
+	mode  string
+	dist  string
+	ports []string
+}
+
+type aggr struct {
+	name      string
+	uuid      string
+	nodes     []string
+	sizeTotal int
+	sizeUsed  int
+}
+
+type job struct {
+	id    int
+	svm   string
+	polls int
+	state string
+	done  func()
+}
+
+//*****************************************************************************
+// cluster setup
+
+// AddNode adds a healthy node to the cluster and returns its UUID
+func (c *Cluster) AddNode(name string) string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	n := &node{name: name, uuid: newUUID()}
+	n.serial = fmt.Sprintf("4%08d", len(c.nodes)+1)
+	n.id = fmt.Sprintf("5%08d", len(c.nodes)+1)
+	c.nodes[name] = n
+
+	return n.uuid
+}
+
+// AddPort adds an up physical port to the node and returns the MAC address
+func (c *Cluster) AddPort(nodeName, portName string) string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.newPort(nodeName, portName, portTypePhysical).mac
+}
+
+// AddAggr adds an aggregate hosted by the nodes and returns its UUID
+func (c *Cluster) AddAggr(name string, nodes ...string) string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	a := &aggr{
+		name: name, uuid: newUUID(), nodes: nodes,
+		sizeTotal: 1 << 40, sizeUsed: 1 << 30,
+	}
+	c.aggrs[name] = a
+
+	return a.uuid
+}
+
+func (c *Cluster) newPort(nodeName, portName, portType string) *port {
+	c.nextMac++
+	p := &port{
+		node: nodeName, name: portName, portType: portType,
+		mac: fmt.Sprintf("00:a0:98:%02x:%02x:%02x",
+			(c.nextMac>>16)&0xff, (c.nextMac>>8)&0xff, c.nextMac&0xff),
+		up: true, mtu: defaultMtu, auto: true,
+		speed: "auto", duplex: "auto", flow: "full",
+		role: "data", ipspace: defaultIPSpace,
+	}
+	c.ports[qualifiedName(nodeName, portName)] = p
+
+	return p
+}
+
+// newJob registers a running job, done is executed when it succeeds
+func (c *Cluster) newJob(svmName string, done func()) *job {
+	c.nextJobID++
+	j := &job{id: c.nextJobID, svm: svmName, state: "running", done: done}
+	c.jobs[j.id] = j
+
+	return j
+}
+
+//*****************************************************************************
+// node commands
+
+func nodeGet(c *Cluster, data []byte) (interface{}, error) {
+	request := system.NodeGetRequest{}
+	if err := decodeRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.Name == "" && request.UUID == "" {
+		return nil, fmt.Errorf(
+			"need at least one query parameter, got: %+v", request)
+	}
+
+	for _, n := range c.nodes {
+		if (request.Name == "" || request.Name == n.name) &&
+			(request.UUID == "" || request.UUID == n.uuid) {
+			return &system.NodeInfo{
+				Name: n.name, Serial: n.serial, ID: n.id, UUID: n.uuid,
+				Version: OsVersion, Healty: true, Uptime: 4711,
+			}, nil
+		}
+	}
+
+	return nonExistResponse(), nil
+}
+
+//*****************************************************************************
+// port commands
+
+func decodePortRequest(data []byte, request *system.PortGetRequest) error {
+	if err := decodeRequest(data, request); err != nil {
+		return err
+	}
+
+	if request.NodeName == "" || request.PortName == "" {
+		return fmt.Errorf(
+			"port request must have node and port defined, got: %+v",
+			*request)
+	}
+
+	return nil
+}
+
+// getPort returns the port or an object not found error
+func (c *Cluster) getPort(api, nodeName, portName string) (*port, error) {
+	p, ok := c.ports[qualifiedName(nodeName, portName)]
+	if !ok {
+		return nil, apiError(api, errnoObjectNotFound,
+			"port [%s] does not exist", qualifiedName(nodeName, portName))
+	}
+
+	return p, nil
+}
+
+// vlansOf returns the VLAN ports using the port as parent
+func (c *Cluster) vlansOf(p *port) []*port {
+	vlans := []*port{}
+	for _, vlan := range c.ports {
+		if vlan.portType == portTypeVlan &&
+			vlan.node == p.node && vlan.vlanPort == p.name {
+			vlans = append(vlans, vlan)
+		}
+	}
+
+	return vlans
+}
+
+func portInfo(p *port) *system.PortInfo {
+	status := "down"
+	if p.up {
+		status = "up"
+	}
+	speed := p.speed
+	if p.auto || speed == "auto" {
+		speed = "1000"
+	}
+
+	info := &system.PortInfo{
+		AutoRevertDelay: strconv.Itoa(p.autoRevert),
+		IgnoreHealth:    strconv.FormatBool(p.ignoreHealth),
+		IPSpace:         p.ipspace,
+		Role:            p.role,
+
+		AdminUp:     strconv.FormatBool(p.up),
+		AdminMtu:    strconv.Itoa(p.mtu),
+		AdminAuto:   strconv.FormatBool(p.auto),
+		AdminSpeed:  p.speed,
+		AdminDuplex: p.duplex,
+		AdminFlow:   p.flow,
+
+		Status:          status,
+		Health:          "healthy",
+		Mac:             p.mac,
+		BroadCastDomain: p.bcDomain,
+		Mtu:             strconv.Itoa(p.mtu),
+		Auto:            strconv.FormatBool(p.auto),
+		Speed:           speed,
+		Duplex:          "full",
+		Flow:            "none",
+
+		Type: p.portType,
+	}
+	info.NodeName = p.node
+	info.PortName = p.name
+
+	if p.portType == portTypeVlan {
+		info.VlanID = strconv.Itoa(p.vlanID)
+		info.VlanNode = p.node
+		info.VlanPort = p.vlanPort
+	}
+
+	return info
+}
+
+func portGet(c *Cluster, data []byte) (interface{}, error) {
+	request := system.PortGetRequest{}
+	if err := decodePortRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	p, ok := c.ports[qualifiedName(request.NodeName, request.PortName)]
+	if !ok {
+		return nonExistResponse(), nil
+	}
+
+	return portInfo(p), nil
+}
+
+func portFindByPattern(c *Cluster, data []byte) (interface{}, error) {
+	request := system.PortGetRequest{}
+	if err := decodePortRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	names := map[string]bool{}
+	for _, p := range c.ports {
+		if p.node != request.NodeName {
+			continue
+		}
+		if match, _ := path.Match(request.PortName, p.name); match {
+			names[p.name] = true
+		}
+	}
+	if len(names) == 0 {
+		return nonExistResponse(), nil
+	}
+
+	return &system.PortFindResult{Names: sortedKeys(names)}, nil
+}
+
+// parseOptInt parses an optional integer request parameter
+func parseOptInt(api, key, value string, dest *int) error {
+	if value == "" {
+		return nil
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return apiError(api, errnoInvalidInput,
+			"invalid value [%s] for %s", value, key)
+	}
+	*dest = parsed
+
+	return nil
+}
+
+// parseOptBool parses an optional boolean request parameter
+func parseOptBool(api, key, value string, dest *bool) error {
+	if value == "" {
+		return nil
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return apiError(api, errnoInvalidInput,
+			"invalid value [%s] for %s", value, key)
+	}
+	*dest = parsed
+
+	return nil
+}
+
+func portModify(c *Cluster, data []byte) (interface{}, error) {
+	const api = "net-port-modify"
+
+	request := system.PortModifyRequest{}
+	if err := decodePortRequest(data, &request.PortGetRequest); err != nil {
+		return nil, err
+	}
+	if err := decodeRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	p, err := c.getPort(api, request.NodeName, request.PortName)
+	if err != nil {
+		return nil, err
+	}
+
+	// validate on a copy, the port is only changed if all values are valid
+	modified := *p
+	for _, param := range []struct {
+		key, value string
+		dest       *int
+	}{
+		{"mtu", request.Mtu, &modified.mtu},
+		{"autorevert-delay", request.AutoRevertDelay, &modified.autoRevert},
+	} {
+		if err := parseOptInt(api, param.key, param.value, param.dest); err != nil {
+			return nil, err
+		}
+	}
+	for _, param := range []struct {
+		key, value string
+		dest       *bool
+	}{
+		{"is-administrative-up", request.Up, &modified.up},
+		{"is-administrative-auto-negotiate", request.Auto, &modified.auto},
+		{"ignore-health-status", request.IgnoreHealth, &modified.ignoreHealth},
+	} {
+		if err := parseOptBool(api, param.key, param.value, param.dest); err != nil {
+			return nil, err
+		}
+	}
+	for value, dest := range map[string]*string{
+		request.Speed: &modified.speed, request.Duplex: &modified.duplex,
+		request.Flow: &modified.flow, request.Role: &modified.role,
+	} {
+		if value != "" {
+			*dest = value
+		}
+	}
+
+	if modified.mtu != p.mtu {
+		if p.bcDomain != "" {
+			return nil, apiError(api, errnoInUse,
+				"port [%s] MTU is managed by broadcast domain [%s]",
+				qualifiedName(p.node, p.name), p.bcDomain)
+		}
+		for _, vlan := range c.vlansOf(p) {
+			if vlan.mtu > modified.mtu {
+				return nil, apiError(api, errnoInvalidInput,
+					"MTU [%d] of port [%s] is below MTU [%d] of VLAN [%s]",
+					modified.mtu, p.name, vlan.mtu, vlan.name)
+			}
+		}
+	}
+
+	if request.IPSpace != "" && request.IPSpace != p.ipspace {
+		if _, ok := c.ipspaces[request.IPSpace]; !ok {
+			return nil, apiError(api, errnoObjectNotFound,
+				"ipspace [%s] does not exist", request.IPSpace)
+		}
+		if p.bcDomain != "" {
+			return nil, apiError(api, errnoInUse,
+				"port [%s] is in broadcast domain [%s]",
+				qualifiedName(p.node, p.name), p.bcDomain)
+		}
+		modified.ipspace = request.IPSpace
+	}
+
+	*p = modified
+	return emptyResponse(), nil
+}
+
+//*****************************************************************************
+// port group commands
+
+func decodePortGroupRequest(data []byte, request *system.PortGroupModifyRequest) error {
+	if err := decodeRequest(data, request); err != nil {
+		return err
+	}
+
+	if request.NodeName == "" || request.GroupName == "" {
+		return fmt.Errorf(
+			"port group request must have node and name defined, got: %+v",
+			*request)
+	}
+
+	return nil
+}
+
+// getGroup returns the port group or an object not found error
+func (c *Cluster) getGroup(api, nodeName, groupName string) (*group, error) {
+	g, ok := c.groups[qualifiedName(nodeName, groupName)]
+	if !ok {
+		return nil, apiError(api, errnoObjectNotFound,
+			"port group [%s] does not exist",
+			qualifiedName(nodeName, groupName))
+	}
+
+	return g, nil
+}
+
+func portGroupGet(c *Cluster, data []byte) (interface{}, error) {
+	request := system.PortGroupModifyRequest{}
+	if err := decodePortGroupRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	g, ok := c.groups[qualifiedName(request.NodeName, request.GroupName)]
+	if !ok {
+		return nonExistResponse(), nil
+	}
+
+	info := &system.PortGroupInfo{
+		PortsUp:   []string{},
+		PortsDown: []string{},
+	}
+	info.NodeName = g.node
+	info.GroupName = g.name
+	info.Mode = g.mode
+	info.LoadDistribution = g.dist
+	info.Ports = append([]string{}, g.ports...)
+
+	for _, name := range g.ports {
+		if c.ports[qualifiedName(g.node, name)].up {
+			info.PortsUp = append(info.PortsUp, name)
+		} else {
+			info.PortsDown = append(info.PortsDown, name)
+		}
+	}
+
+	switch {
+	case len(g.ports) > 0 && len(info.PortsDown) == 0:
+		info.GroupLinkStatus = "full"
+	case len(info.PortsUp) > 0:
+		info.GroupLinkStatus = "partial"
+	default:
+		info.GroupLinkStatus = "none"
+	}
+
+	return info, nil
+}
+
+func portGroupCreate(c *Cluster, data []byte) (interface{}, error) {
+	const api = "net-port-ifgrp-create"
+
+	request := system.PortGroupModifyRequest{}
+	if err := decodePortGroupRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.Mode == "" || request.LoadDistribution == "" {
+		return nil, fmt.Errorf(
+			"port group create commands must have node, name, mode"+
+				" and distribution defined, got: %+v", request)
+	}
+
+	if _, ok := c.nodes[request.NodeName]; !ok {
+		return nil, apiError(api, errnoObjectNotFound,
+			"node [%s] does not exist", request.NodeName)
+	}
+	name := qualifiedName(request.NodeName, request.GroupName)
+	if _, ok := c.ports[name]; ok {
+		return nil, apiError(api, errnoDuplicateEntry, "duplicate entry")
+	}
+
+	c.newPort(request.NodeName, request.GroupName, portTypeGroup)
+	c.groups[name] = &group{
+		node: request.NodeName, name: request.GroupName,
+		mode: request.Mode, dist: request.LoadDistribution,
+		ports: []string{},
+	}
+
+	return emptyResponse(), nil
+}
+
+func portGroupPortAdd(c *Cluster, data []byte) (interface{}, error) {
+	const api = "net-port-ifgrp-add-port"
+
+	request := system.PortGroupModifyRequest{}
+	if err := decodePortGroupRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	g, err := c.getGroup(api, request.NodeName, request.GroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range request.Ports {
+		p, err := c.getPort(api, request.NodeName, name)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case p.portType != portTypePhysical:
+			return nil, apiError(api, errnoInvalidInput,
+				"port [%s] is not a physical port", name)
+		case p.group != "":
+			return nil, apiError(api, errnoInUse,
+				"port [%s] is already member of port group [%s]", name, p.group)
+		case p.bcDomain != "":
+			return nil, apiError(api, errnoInUse,
+				"port [%s] is in broadcast domain [%s]", name, p.bcDomain)
+		case len(c.vlansOf(p)) > 0:
+			return nil, apiError(api, errnoInUse,
+				"port [%s] is hosting VLANs", name)
+		}
+
+		p.group = g.name
+		g.ports = append(g.ports, name)
+	}
+
+	return emptyResponse(), nil
+}
+
+func portGroupPortRemove(c *Cluster, data []byte) (interface{}, error) {
+	const api = "net-port-ifgrp-remove-port"
+
+	request := system.PortGroupModifyRequest{}
+	if err := decodePortGroupRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	g, err := c.getGroup(api, request.NodeName, request.GroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range request.Ports {
+		members := []string{}
+		for _, member := range g.ports {
+			if member != name {
+				members = append(members, member)
+			}
+		}
+		if len(members) == len(g.ports) {
+			return nil, apiError(api, errnoObjectNotFound,
+				"port [%s] is not member of port group [%s]", name, g.name)
+		}
+
+		c.ports[qualifiedName(g.node, name)].group = ""
+		g.ports = members
+	}
+
+	return emptyResponse(), nil
+}
+
+func portGroupDelete(c *Cluster, data []byte) (interface{}, error) {
+	const api = "net-port-ifgrp-destroy"
+
+	request := system.PortGroupModifyRequest{}
+	if err := decodePortGroupRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	g, err := c.getGroup(api, request.NodeName, request.GroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	p := c.ports[qualifiedName(g.node, g.name)]
+	if p.bcDomain != "" {
+		return nil, apiError(api, errnoInUse,
+			"port group [%s] is in broadcast domain [%s]", g.name, p.bcDomain)
+	}
+	if len(c.vlansOf(p)) > 0 {
+		return nil, apiError(api, errnoInUse,
+			"port group [%s] is hosting VLANs", g.name)
+	}
+
+	for _, name := range g.ports {
+		c.ports[qualifiedName(g.node, name)].group = ""
+	}
+	delete(c.ports, qualifiedName(g.node, g.name))
+	delete(c.groups, qualifiedName(g.node, g.name))
+
+	return emptyResponse(), nil
+}
+
+//*****************************************************************************
+// aggregate commands
+
+func aggrGet(c *Cluster, data []byte) (interface{}, error) {
+	request := system.AggrGetRequest{}
+	if err := decodeRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.Name == "" && request.UUID == "" {
+		return nil, fmt.Errorf(
+			"need at least one query parameter, got: %+v", request)
+	}
+
+	for _, a := range c.aggrs {
+		if (request.Name != "" && request.Name != a.name) ||
+			(request.UUID != "" && request.UUID != a.uuid) {
+			continue
+		}
+
+		flexVols := 0
+		for _, v := range c.volumes {
+			if v.aggr == a.name {
+				flexVols++
+			}
+		}
+
+		info := &system.AggrInfo{
+			FlexVolCount:    flexVols,
+			PctUsedCapacity: 100 * a.sizeUsed / a.sizeTotal,
+			PctUsedPhysical: 100 * a.sizeUsed / a.sizeTotal,
+			SizeTotal:       a.sizeTotal,
+			SizeUsed:        a.sizeUsed,
+			SizeAvailable:   a.sizeTotal - a.sizeUsed,
+			SizeReserved:    0,
+		}
+		info.Name = a.name
+		info.UUID = a.uuid
+		info.Nodes = append([]string{}, a.nodes...)
+
+		return info, nil
+	}
+
+	return nonExistResponse(), nil
+}
+
+//*****************************************************************************
+// job commands
+
+func jobGet(c *Cluster, data []byte) (interface{}, error) {
+	request := system.JobGetRequest{}
+	if err := decodeRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	j, ok := c.jobs[request.ID]
+	if !ok {
+		return nil, apiError("job-get", errnoObjectNotFound,
+			"job [%d] does not exist", request.ID)
+	}
+
+	if j.state == "running" {
+		if j.polls < c.JobPolls {
+			j.polls++
+		} else {
+			if j.done != nil {
+				j.done()
+			}
+			j.state = "success"
+		}
+	}
+
+	info := &system.JobInfo{Status: j.state}
+	info.ID = j.id
+	info.SVM = j.svm
+	if j.state == "success" {
+		info.Message = "Complete: Succeeded"
+	} else {
+		info.Progress = fmt.Sprintf("step %d of %d", j.polls, c.JobPolls)
+	}
+
+	return info, nil
+}
@@ -50,17 +50,17 @@ type JobResult struct {
 
 const svmGetCmd = "SVM.GET"
 
-func GetByUUID(client *pythonapi.NetAppAPI, uuid string) (*Info, error) {
+func GetByUUID(client pythonapi.Backend, uuid string) (*Info, error) {
 	request := &Request{UUID: uuid}
 	return svmGet(client, request)
 }
 
-func GetByName(client *pythonapi.NetAppAPI, name string) (*Info, error) {
+func GetByName(client pythonapi.Backend, name string) (*Info, error) {
 	request := &Request{Name: name}
 	return svmGet(client, request)
 }
 
-func svmGet(client *pythonapi.NetAppAPI, request *Request) (*Info, error) {
+func svmGet(client pythonapi.Backend, request *Request) (*Info, error) {
 	resp := Info{}
 	err := pythonapi.MakeAPICall(client, svmGetCmd, request, &resp)
 	if err != nil {
@@ -72,7 +72,7 @@ func svmGet(client *pythonapi.NetAppAPI, request *Request) (*Info, error) {
 
 const svmCreateCmd = "SVM.CREATE"
 
-func Create(client *pythonapi.NetAppAPI, request *Request) (*JobResult, error) {
+func Create(client pythonapi.Backend, request *Request) (*JobResult, error) {
 	response := &JobResult{}
 	err := pythonapi.MakeAPICall(client, svmCreateCmd, request, response)
 	if err != nil {
@@ -84,7 +84,7 @@ func Create(client *pythonapi.NetAppAPI, request *Request) (*JobResult, error) {
 
 const svmDeleteCmd = "SVM.DELETE"
 
-func DeleteByName(client *pythonapi.NetAppAPI, name string) (*JobResult, error) {
+func DeleteByName(client pythonapi.Backend, name string) (*JobResult, error) {
 	request := Request{Name: name}
 	response := &JobResult{}
 	err := pythonapi.MakeAPICall(client, svmDeleteCmd, &request, response)
@@ -108,7 +108,7 @@ const (
 
 // ExecuteSimpleCommand execute start/stop/unlock of SVM
 func ExecuteSimpleCommand(
-	client *pythonapi.NetAppAPI, name string,
+	client pythonapi.Backend, name string,
 	simpleCmd simpleSvmCmd, force bool) error {
 
 	request := Request{Name: name}
@@ -123,7 +123,7 @@ func ExecuteSimpleCommand(
 const svmRenameCmd = "SVM.RENAME"
 
 // Rename rename SVM
-func Rename(client *pythonapi.NetAppAPI, name, newName string) error {
+func Rename(client pythonapi.Backend, name, newName string) error {
 	request := Request{Name: name, NewName: newName}
 	response := pythonapi.EmptyResponse{}
 	return pythonapi.MakeAPICall(
@@ -158,7 +158,7 @@ type VolumeInfo struct {
 }
 
 func VolumeSimpleCommand(
-	client *pythonapi.NetAppAPI,
+	client pythonapi.Backend,
 	svmName, volName string,
 	simpleCmd simpleVolCmd) error {
 	request := VolumeRequest{VolumeName: volName}
@@ -171,7 +171,7 @@ func VolumeSimpleCommand(
 const svmVolumeSizeCmd = "SVM.VOL.SIZE"
 
 func VolumeSizeCommand(
-	client *pythonapi.NetAppAPI, request *VolumeRequest) (*VolumeInfo, error) {
+	client pythonapi.Backend, request *VolumeRequest) (*VolumeInfo, error) {
 	response := &VolumeInfo{}
 	err := pythonapi.MakeAPICall(client, svmVolumeSizeCmd, request, response)
 	if err != nil {
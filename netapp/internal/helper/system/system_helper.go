@@ -1,7 +1,11 @@
 package system
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 	"time"
 
 	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/pythonapi"
@@ -9,12 +13,74 @@ import (
 
 const connectCmd = "SYS.CONNECT"
 
+// connection transports and server types of the ConnectRequest
+const (
+	TransportHTTPS    = "HTTPS"
+	TransportHTTP     = "HTTP"
+	ServerTypeFiler   = "FILER"
+	ServerTypeVserver = "VSERVER"
+)
+
 // ConnectRequest is the required input for the NetApp API connection
 type ConnectRequest struct {
-	// TODO: here we would also have HTTP/HTTPS, vserver vs filer etc...
 	Host     string `json:"host"`
 	User     string `json:"user"`
-	Password string `json:"pwd"`
+	Password string `json:"pwd" netapp:"secret"`
+
+	// Transport is HTTPS (default) or HTTP, Port replaces the default
+	// port of the transport if set
+	Transport string `json:"transport,omitempty"`
+	Port      int    `json:"port,omitempty"`
+	// ServerType is FILER (default) for cluster management or VSERVER
+	// for the management LIF of a SVM
+	ServerType string `json:"server_type,omitempty"`
+
+	// Insecure skips the server certificate verification, CAFile verifies
+	// against the PEM CA bundle instead of the system CAs
+	Insecure bool   `json:"insecure,omitempty"`
+	CAFile   string `json:"ca_file,omitempty"`
+
+	// ClientCertFile / ClientKeyFile are the PEM client certificate and
+	// key authenticating the user instead of the password
+	ClientCertFile string `json:"client_cert_file,omitempty"`
+	ClientKeyFile  string `json:"client_key_file,omitempty"`
+}
+
+// CertAuth reports whether the connection authenticates with the client
+// certificate instead of the password
+func (r *ConnectRequest) CertAuth() bool {
+	return r.ClientCertFile != ""
+}
+
+// TLSConfig returns the TLS configuration of HTTPS connections, the
+// server certificate and host name are verified unless Insecure is set
+func (r *ConnectRequest) TLSConfig() (*tls.Config, error) {
+	config := &tls.Config{InsecureSkipVerify: r.Insecure}
+	if r.CertAuth() {
+		cert, err := tls.LoadX509KeyPair(r.ClientCertFile, r.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"could not load client_cert_file [%s] with client_key_file [%s], got: %s",
+				r.ClientCertFile, r.ClientKeyFile, err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	if r.CAFile == "" {
+		return config, nil
+	}
+
+	pem, err := ioutil.ReadFile(r.CAFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not read ca_file [%s], got: %s", r.CAFile, err)
+	}
+
+	config.RootCAs = x509.NewCertPool()
+	if !config.RootCAs.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no PEM certificates found in ca_file [%s]", r.CAFile)
+	}
+
+	return config, nil
 }
 
 // ConnectResponse is the returned result for a Connect call
@@ -24,13 +90,33 @@ type ConnectResponse struct {
 }
 
 // Connect returns ONTAP and OS version from NetApp host
-func Connect(client *pythonapi.NetAppAPI, request *ConnectRequest) (*ConnectResponse, error) {
+func Connect(client pythonapi.Backend, request *ConnectRequest) (*ConnectResponse, error) {
 	resp := ConnectResponse{}
 	err := pythonapi.MakeAPICall(client, connectCmd, request, &resp)
 
 	return &resp, err
 }
 
+const connectInfoCmd = "SYS.CONNECT.INFO"
+
+// ConnectInfo is the current connection of the NetApp API, e.g. to check
+// that a reattached API still serves the configured host and user
+type ConnectInfo struct {
+	ConnectResponse
+
+	Host      string `json:"host"`
+	User      string `json:"user"`
+	Connected bool   `json:"connected"`
+}
+
+// GetConnectInfo returns the connection of the NetApp API
+func GetConnectInfo(client pythonapi.Backend) (*ConnectInfo, error) {
+	resp := ConnectInfo{}
+	err := pythonapi.MakeAPICall(client, connectInfoCmd, &pythonapi.EmptyResponse{}, &resp)
+
+	return &resp, err
+}
+
 const nodeGetCmd = "SYS.NODE.GET"
 
 // NodeGetRequest to get node information from NetApp
@@ -52,7 +138,7 @@ type NodeInfo struct {
 }
 
 // NodeGetByName to find node for a given name
-func NodeGetByName(client *pythonapi.NetAppAPI, name string) (*NodeInfo, error) {
+func NodeGetByName(client pythonapi.Backend, name string) (*NodeInfo, error) {
 	request := &NodeGetRequest{
 		Name: name, UUID: "",
 	}
@@ -63,7 +149,7 @@ func NodeGetByName(client *pythonapi.NetAppAPI, name string) (*NodeInfo, error)
 }
 
 // NodeGetByUUID to retrieve NetApp node data for UUID / Terraform resource ID
-func NodeGetByUUID(client *pythonapi.NetAppAPI, uuid string) (*NodeInfo, error) {
+func NodeGetByUUID(client pythonapi.Backend, uuid string) (*NodeInfo, error) {
 	request := &NodeGetRequest{
 		Name: "", UUID: uuid,
 	}
@@ -117,7 +203,7 @@ type PortInfo struct {
 }
 
 func PortGetByNames(
-	client *pythonapi.NetAppAPI,
+	client pythonapi.Backend,
 	nodeName string, portName string) (*PortInfo, error) {
 
 	request := &PortGetRequest{
@@ -128,6 +214,33 @@ func PortGetByNames(
 	return &resp, err
 }
 
+// PortGetBatch returns the infos of the node/port pairs in the order of the
+// requests, with one API round trip if the client supports batches. Each
+// port has its own error, e.g. a single port which does not exist
+func PortGetBatch(
+	client pythonapi.Backend,
+	requests []*PortGetRequest) ([]*PortInfo, []error) {
+
+	infos := make([]*PortInfo, len(requests))
+	items := make([]*pythonapi.BatchItem, len(requests))
+	for idx, request := range requests {
+		infos[idx] = &PortInfo{}
+		items[idx] = &pythonapi.BatchItem{
+			Cmd: portGetInfoCmd, Request: request, Response: infos[idx]}
+	}
+
+	errs := make([]error, len(requests))
+	err := pythonapi.CallBatch(context.Background(), client, items)
+	for idx, item := range items {
+		errs[idx] = item.Err
+		if err != nil {
+			errs[idx] = err
+		}
+	}
+
+	return infos, errs
+}
+
 const portModifyCmd = "SYS.PORT.MODIFY"
 
 type PortModifyRequest struct {
@@ -147,7 +260,7 @@ type PortModifyRequest struct {
 }
 
 func PortModify(
-	client *pythonapi.NetAppAPI,
+	client pythonapi.Backend,
 	request *PortModifyRequest) error {
 
 	resp := pythonapi.EmptyResponse{}
@@ -161,7 +274,7 @@ type PortFindResult struct {
 const portFindByPatternCmd = "SYS.PORT.FIND.PATTERN"
 
 func PortFindByNamePattern(
-	client *pythonapi.NetAppAPI,
+	client pythonapi.Backend,
 	nodeName, pattern string) (*PortFindResult, error) {
 	request := &PortGetRequest{NodeName: nodeName, PortName: pattern}
 	response := &PortFindResult{}
@@ -198,7 +311,7 @@ type PortGroupInfo struct {
 const portGroupGetCmd = "SYS.PORTGROUP.GET"
 
 func PortGroupGetByNames(
-	client *pythonapi.NetAppAPI,
+	client pythonapi.Backend,
 	nodeName, groupName string) (*PortGroupInfo, error) {
 
 	request := PortGroupGetRequest{NodeName: nodeName, GroupName: groupName}
@@ -214,7 +327,7 @@ func PortGroupGetByNames(
 const portGroupCreateCmd = "SYS.PORTGROUP.CREATE"
 
 func PortGroupCreate(
-	client *pythonapi.NetAppAPI,
+	client pythonapi.Backend,
 	request *PortGroupModifyRequest) error {
 	resp := pythonapi.EmptyResponse{}
 	return pythonapi.MakeAPICall(client, portGroupCreateCmd, request, &resp)
@@ -224,7 +337,7 @@ const portGroupPortAddCmd = "SYS.PORTGROUP.PORT.ADD"
 const portGroupPortRemoveCmd = "SYS.PORTGROUP.PORT.REMOVE"
 
 func PortGroupPortsModify(
-	client *pythonapi.NetAppAPI,
+	client pythonapi.Backend,
 	node, name string,
 	portNames []string,
 	add bool, remove bool) error {
@@ -254,7 +367,7 @@ func PortGroupPortsModify(
 const portGroupDeleteCmd = "SYS.PORTGROUP.DELETE"
 
 func PortGroupDelete(
-	client *pythonapi.NetAppAPI,
+	client pythonapi.Backend,
 	nodeName, groupName string) error {
 	req := &PortGroupGetRequest{NodeName: nodeName, GroupName: groupName}
 	resp := pythonapi.EmptyResponse{}
@@ -285,7 +398,7 @@ type AggrInfo struct {
 }
 
 // AggrGetByName to find aggregate for given name
-func AggrGetByName(client *pythonapi.NetAppAPI, name string) (*AggrInfo, error) {
+func AggrGetByName(client pythonapi.Backend, name string) (*AggrInfo, error) {
 	request := &AggrGetRequest{Name: name}
 	resp := AggrInfo{}
 	err := pythonapi.MakeAPICall(client, aggrGetCmd, request, &resp)
@@ -294,7 +407,7 @@ func AggrGetByName(client *pythonapi.NetAppAPI, name string) (*AggrInfo, error)
 }
 
 // AggrGetByUUID to find aggregate for given UUID
-func AggrGetByUUID(client *pythonapi.NetAppAPI, uuid string) (*AggrInfo, error) {
+func AggrGetByUUID(client pythonapi.Backend, uuid string) (*AggrInfo, error) {
 	request := &AggrGetRequest{UUID: uuid}
 	resp := AggrInfo{}
 	err := pythonapi.MakeAPICall(client, aggrGetCmd, request, &resp)
@@ -314,11 +427,13 @@ type JobInfo struct {
 	Message string `json:"msg"`    // <job-completion>	contains error message if status-code != 0, e.g. result-error-message
 	Status  string `json:"status"` // <job-state> eq. result-status to some degree...
 	ErrNo   int    `json:"errno"`  // <job-status-code> eq. result-error-code and is 0 if all good
+
+	Progress string `json:"progress"` // <job-progress>
 }
 
 const jobGetCmd = "SYS.JOB.GET"
 
-func JobGetByID(client *pythonapi.NetAppAPI, id int) (*JobInfo, error) {
+func JobGetByID(client pythonapi.Backend, id int) (*JobInfo, error) {
 	request := &JobGetRequest{ID: id}
 	response := &JobInfo{}
 	err := pythonapi.MakeAPICall(client, jobGetCmd, request, response)
@@ -329,27 +444,81 @@ func JobGetByID(client *pythonapi.NetAppAPI, id int) (*JobInfo, error) {
 	return response, nil
 }
 
-func JobWaitDone(client *pythonapi.NetAppAPI, id int) (*JobInfo, error) {
-	var jInfo *JobInfo
-	var err error
-	waiting := true
-	for waiting {
-		// wait first <-- API timeout currently set to 800ms!
-		time.Sleep(500 * time.Millisecond)
+// jobPollInterval between SYS.JOB.GET polls of backends without job watch
+const jobPollInterval = 500 * time.Millisecond
+
+// JobEnded reports whether the job state is final
+func JobEnded(status string) bool {
+	switch status {
+	case "success", "failure", "error", "quit", "dead":
+		return true
+	}
+
+	return false
+}
 
-		// get the job status
-		jInfo, err = JobGetByID(client, id)
+// JobWatch waits until the job ended and returns its final info, progress
+// is called with every job state or progress change, e.g. to log it. The
+// changes are pushed by backends implementing pythonapi.JobWatcher, all
+// other backends are polled. The wait ends with ctx, e.g. on timeout
+func JobWatch(
+	ctx context.Context, client pythonapi.Backend,
+	id int, progress func(*JobInfo)) (*JobInfo, error) {
+
+	if progress == nil {
+		progress = func(*JobInfo) {}
+	}
+
+	request := &JobGetRequest{ID: id}
+	if watcher, ok := client.(pythonapi.JobWatcher); ok {
+		jInfo := &JobInfo{}
+		err := watcher.WatchJob(ctx, request, jInfo, func() error {
+			progress(jInfo)
+			return nil
+		})
+		if err != pythonapi.ErrWatchUnsupported {
+			if err != nil {
+				return nil, fmt.Errorf("job [%d] watch error: %s", id, err)
+			}
+			if !JobEnded(jInfo.Status) {
+				return nil, fmt.Errorf(
+					"job [%d] watch ended before the job, state: %s", id, jInfo.Status)
+			}
+
+			return jInfo, nil
+		}
+	}
+
+	ticker := time.NewTicker(jobPollInterval)
+	defer ticker.Stop()
+
+	var last JobInfo
+	for {
+		// wait first, the job was just started
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("job [%d] wait ended, got: %s", id, ctx.Err())
+		case <-ticker.C:
+		}
+
+		jInfo := &JobInfo{}
+		err := client.Call(ctx, jobGetCmd, request, jInfo)
 		if err != nil {
 			return nil, err
 		}
 
-		// check for status that indicates termination
-		switch jInfo.Status {
-		case "success", "failure", "error", "quit", "dead":
-			waiting = false
-			break
+		if *jInfo != last {
+			progress(jInfo)
+			last = *jInfo
+		}
+
+		if JobEnded(jInfo.Status) {
+			return jInfo, nil
 		}
 	}
+}
 
-	return jInfo, nil
+// JobWaitDone waits without time limit until the job ended
+func JobWaitDone(client pythonapi.Backend, id int) (*JobInfo, error) {
+	return JobWatch(context.Background(), client, id, nil)
 }
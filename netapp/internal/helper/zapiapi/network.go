@@ -0,0 +1,697 @@
+package zapiapi
+
+import (
+	"fmt"
+
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/network"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/zapi"
+)
+
+func init() {
+	registerCommands(map[string]command{
+		"NW.VLAN.GET":    vlanGet,
+		"NW.VLAN.CREATE": vlanCreate,
+		"NW.VLAN.DELETE": vlanDelete,
+
+		"NW.IPSPACE.GET":    ipspaceGet,
+		"NW.IPSPACE.CREATE": ipspaceCreate,
+		"NW.IPSPACE.DELETE": ipspaceDelete,
+		"NW.IPSPACE.UPDATE": ipspaceUpdate,
+
+		"NW.BRCDOM.GET":         bcDomainGet,
+		"NW.BRCDOM.STATUS":      bcDomainStatus,
+		"NW.BRCDOM.CREATE":      bcDomainCreate,
+		"NW.BRCDOM.DELETE":      bcDomainDelete,
+		"NW.BRCDOM.RENAME":      bcDomainRename,
+		"NW.BRCDOM.PORT.ADD":    bcDomainPortAdd,
+		"NW.BRCDOM.PORT.REMOVE": bcDomainPortRemove,
+		"NW.BRCDOM.UPDATE":      bcDomainUpdate,
+
+		"NW.SUBNET.GET":        subnetGet,
+		"NW.SUBNET.CREATE":     subnetCreate,
+		"NW.SUBNET.DELETE":     subnetDelete,
+		"NW.SUBNET.RENAME":     subnetRename,
+		"NW.SUBNET.IPR.ADD":    subnetIPRangeAdd,
+		"NW.SUBNET.IPR.REMOVE": subnetIPRangeRemove,
+		"NW.SUBNET.MODIFY":     subnetModify,
+	})
+}
+
+//*****************************************************************************
+// VLAN commands
+
+func decodeVlanRequest(data interface{}, request *network.VlanRequest) error {
+	if err := decodeRequest(data, request); err != nil {
+		return err
+	}
+
+	if request.ParentName == "" || request.VlanID == "" {
+		return fmt.Errorf(
+			"vlan request must have parent name and vlan id defined, got: %+v",
+			*request)
+	}
+
+	return nil
+}
+
+func vlanInfoElement(request *network.VlanRequest) *zapi.Element {
+	info := zapi.NewElement("vlan-info").
+		AddChildString("parent-interface", request.ParentName).
+		AddChildString("vlanid", request.VlanID)
+	if request.NodeName != "" {
+		info.AddChildString("node", request.NodeName)
+	}
+
+	return info
+}
+
+func vlanGet(client *zapi.Client, data interface{}) (interface{}, error) {
+	request := network.VlanRequest{}
+	if err := decodeVlanRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	call := zapi.NewElement("net-vlan-get-iter").
+		AddChild(zapi.NewElement("query").AddChild(vlanInfoElement(&request))).
+		AddChild(zapi.NewElement("desired-attributes").AddChild(
+			zapi.NewElement("vlan-info").AddDesired(
+				"interface-name", "node", "parent-interface", "vlanid")))
+
+	results, err := client.Invoke(call)
+	if err != nil {
+		return nil, err
+	}
+	if nonExist(results) {
+		return nonExistResponse(), nil
+	}
+
+	if results.ChildInt("num-records") < 1 {
+		return nil, fmt.Errorf(
+			"no vlans or too many found for query: [%+v] result is: %s",
+			request, results)
+	}
+
+	vlan := results.FirstOf("attributes-list")
+	if vlan == nil {
+		return nil, fmt.Errorf("no vlan data found in: %s", results)
+	}
+
+	info := &network.VlanInfo{Name: vlan.ChildString("interface-name")}
+	info.NodeName = vlan.ChildString("node")
+	info.ParentName = vlan.ChildString("parent-interface")
+	info.VlanID = vlan.ChildString("vlanid")
+
+	return info, nil
+}
+
+func vlanModify(client *zapi.Client, data interface{}, cmd string) (interface{}, error) {
+	request := network.VlanRequest{}
+	if err := decodeVlanRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	call := zapi.NewElement(cmd).AddChild(vlanInfoElement(&request))
+	if _, err := client.Invoke(call); err != nil {
+		return nil, err
+	}
+
+	return emptyResponse(), nil
+}
+
+func vlanCreate(client *zapi.Client, data interface{}) (interface{}, error) {
+	return vlanModify(client, data, "net-vlan-create")
+}
+
+func vlanDelete(client *zapi.Client, data interface{}) (interface{}, error) {
+	return vlanModify(client, data, "net-vlan-delete")
+}
+
+//*****************************************************************************
+// IPSpace commands
+
+func ipspaceGet(client *zapi.Client, data interface{}) (interface{}, error) {
+	request := network.IPSpaceRequest{}
+	if err := decodeRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.UUID == "" && request.Name == "" {
+		return nil, fmt.Errorf(
+			"get IPSpace request must have uuid or name defined, got: %+v",
+			request)
+	}
+
+	query := zapi.NewElement("net-ipspaces-info")
+	if request.UUID != "" {
+		query.AddChildString("uuid", request.UUID)
+	}
+	if request.Name != "" {
+		query.AddChildString("ipspace", request.Name)
+	}
+
+	call := zapi.NewElement("net-ipspaces-get-iter").
+		AddChild(zapi.NewElement("query").AddChild(query)).
+		AddChild(zapi.NewElement("desired-attributes").AddChild(
+			zapi.NewElement("net-ipspaces-info").
+				AddDesired("ipspace", "uuid").
+				AddChild(zapi.NewElement("broadcast-domains").
+					AddDesired("broadcast-domain-name")).
+				AddChild(zapi.NewElement("ports").
+					AddDesired("net-qualified-port-name")).
+				AddChild(zapi.NewElement("vservers").
+					AddDesired("vserver-name"))))
+
+	results, err := client.Invoke(call)
+	if err != nil {
+		return nil, err
+	}
+	if nonExist(results) {
+		return nonExistResponse(), nil
+	}
+
+	ipspace, err := singleRecord(results, "ipspace", request)
+	if err != nil {
+		return nil, err
+	}
+
+	return &network.IPSpaceInfo{
+		Name:             ipspace.ChildString("ipspace"),
+		UUID:             ipspace.ChildString("uuid"),
+		BroadCastDomains: ipspace.ChildContentList("broadcast-domains"),
+		Ports:            ipspace.ChildContentList("ports"),
+		VServers:         ipspace.ChildContentList("vservers"),
+	}, nil
+}
+
+func decodeIPSpaceRequest(data interface{}, request *network.IPSpaceRequest) error {
+	if err := decodeRequest(data, request); err != nil {
+		return err
+	}
+
+	if request.Name == "" {
+		return fmt.Errorf(
+			"ipspace request must have name defined, got: %+v", *request)
+	}
+
+	return nil
+}
+
+func ipspaceCreate(client *zapi.Client, data interface{}) (interface{}, error) {
+	request := network.IPSpaceRequest{}
+	if err := decodeIPSpaceRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	call := zapi.NewElement("net-ipspaces-create").
+		AddChildString("ipspace", request.Name).
+		AddChildBool("return-record", true)
+
+	results, err := client.Invoke(call)
+	if err != nil {
+		return nil, err
+	}
+
+	ipspace := results.Child("result").Child("net-ipspaces-info")
+	if ipspace == nil {
+		return nil, fmt.Errorf(
+			"no ipspace info received from create, got: %s", results)
+	}
+
+	return &network.IPSpaceInfo{
+		Name: request.Name,
+		UUID: ipspace.ChildString("uuid"),
+	}, nil
+}
+
+func ipspaceDelete(client *zapi.Client, data interface{}) (interface{}, error) {
+	request := network.IPSpaceRequest{}
+	if err := decodeIPSpaceRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	call := zapi.NewElement("net-ipspaces-destroy").
+		AddChildString("ipspace", request.Name)
+	if _, err := client.Invoke(call); err != nil {
+		return nil, err
+	}
+
+	return emptyResponse(), nil
+}
+
+func ipspaceUpdate(client *zapi.Client, data interface{}) (interface{}, error) {
+	request := network.IPSpaceRequest{}
+	if err := decodeIPSpaceRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.NewName == "" {
+		return nil, fmt.Errorf(
+			"update/rename ipspace must have name and new_name defined, got: %+v",
+			request)
+	}
+
+	call := zapi.NewElement("net-ipspaces-rename").
+		AddChildString("ipspace", request.Name).
+		AddChildString("new-name", request.NewName)
+	if _, err := client.Invoke(call); err != nil {
+		return nil, err
+	}
+
+	return emptyResponse(), nil
+}
+
+//*****************************************************************************
+// broadcast domain commands
+
+func decodeBcDomainRequest(data interface{}, request *network.BcDomainRequest, ipspace bool) error {
+	if err := decodeRequest(data, request); err != nil {
+		return err
+	}
+
+	if request.Name == "" || (ipspace && request.IPSpace == "") {
+		return fmt.Errorf(
+			"broadcast domain request must have name and ipspace defined, got: %+v",
+			*request)
+	}
+
+	return nil
+}
+
+func bcDomainStatusResponse(results *zapi.Element) interface{} {
+	return &network.BcDomainInfo{
+		PortUpdateStatus: results.ChildString("port-update-status-combined"),
+	}
+}
+
+func bcDomainQuery(name string, desired *zapi.Element) *zapi.Element {
+	return zapi.NewElement("net-port-broadcast-domain-get-iter").
+		AddChild(zapi.NewElement("query").AddChild(
+			zapi.NewElement("net-port-broadcast-domain-info").
+				AddChildString("broadcast-domain", name))).
+		AddChild(zapi.NewElement("desired-attributes").AddChild(desired))
+}
+
+func bcDomainGet(client *zapi.Client, data interface{}) (interface{}, error) {
+	request := network.BcDomainRequest{}
+	if err := decodeBcDomainRequest(data, &request, false); err != nil {
+		return nil, err
+	}
+
+	results, err := client.Invoke(bcDomainQuery(request.Name,
+		zapi.NewElement("net-port-broadcast-domain-info").
+			AddDesired("broadcast-domain", "ipspace", "mtu",
+				"port-update-status-combined").
+			AddChild(zapi.NewElement("ports").AddChild(
+				zapi.NewElement("port-info").AddDesired("port",
+					"port-update-status", "port-update-status-details"))).
+			AddChild(zapi.NewElement("failover-groups").
+				AddDesired("failover-group")).
+			AddChild(zapi.NewElement("subnet-names").
+				AddDesired("subnet-name"))))
+	if err != nil {
+		return nil, err
+	}
+	if nonExist(results) {
+		return nonExistResponse(), nil
+	}
+
+	bcDomain, err := singleRecord(results, "broadcast domain", request)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &network.BcDomainInfo{
+		Name:             bcDomain.ChildString("broadcast-domain"),
+		Mtu:              bcDomain.ChildString("mtu"),
+		IPSpace:          bcDomain.ChildString("ipspace"),
+		PortUpdateStatus: bcDomain.ChildString("port-update-status-combined"),
+		Ports:            []network.BcDomainPortInfo{},
+		FailoverGroups:   bcDomain.ChildContentList("failover-groups"),
+		SubnetNames:      bcDomain.ChildContentList("subnet-names"),
+	}
+
+	for _, port := range bcDomain.Child("ports").Children() {
+		info.Ports = append(info.Ports, network.BcDomainPortInfo{
+			Name:         port.ChildString("port"),
+			UpdateStatus: port.ChildString("port-update-status"),
+			StatusDetail: port.ChildString("port-update-status-details"),
+		})
+	}
+
+	return info, nil
+}
+
+func bcDomainStatus(client *zapi.Client, data interface{}) (interface{}, error) {
+	request := network.BcDomainRequest{}
+	if err := decodeBcDomainRequest(data, &request, false); err != nil {
+		return nil, err
+	}
+
+	results, err := client.Invoke(bcDomainQuery(request.Name,
+		zapi.NewElement("net-port-broadcast-domain-info").
+			AddDesired("port-update-status-combined")))
+	if err != nil {
+		return nil, err
+	}
+	if nonExist(results) {
+		return nonExistResponse(), nil
+	}
+
+	bcDomain, err := singleRecord(results, "broadcast domain", request)
+	if err != nil {
+		return nil, err
+	}
+
+	return bcDomainStatusResponse(bcDomain), nil
+}
+
+func bcDomainCreate(client *zapi.Client, data interface{}) (interface{}, error) {
+	request := network.BcDomainRequest{}
+	if err := decodeBcDomainRequest(data, &request, false); err != nil {
+		return nil, err
+	}
+	if request.Mtu == "" {
+		return nil, fmt.Errorf(
+			"broadcast domain create commands must have name and mtu defined, got: %+v",
+			request)
+	}
+
+	call := zapi.NewElement("net-port-broadcast-domain-create").
+		AddChildString("broadcast-domain", request.Name).
+		AddChildString("mtu", request.Mtu)
+	if request.IPSpace != "" {
+		call.AddChildString("ipspace", request.IPSpace)
+	}
+	if len(request.Ports) > 0 {
+		call.AddChildStrings("ports", "net-qualified-port-name", request.Ports)
+	}
+
+	results, err := client.Invoke(call)
+	if err != nil {
+		return nil, err
+	}
+
+	return bcDomainStatusResponse(results), nil
+}
+
+func bcDomainDelete(client *zapi.Client, data interface{}) (interface{}, error) {
+	request := network.BcDomainRequest{}
+	if err := decodeBcDomainRequest(data, &request, true); err != nil {
+		return nil, err
+	}
+
+	call := zapi.NewElement("net-port-broadcast-domain-destroy").
+		AddChildString("broadcast-domain", request.Name).
+		AddChildString("ipspace", request.IPSpace)
+
+	results, err := client.Invoke(call)
+	if err != nil {
+		return nil, err
+	}
+
+	return bcDomainStatusResponse(results), nil
+}
+
+func bcDomainRename(client *zapi.Client, data interface{}) (interface{}, error) {
+	request := network.BcDomainRequest{}
+	if err := decodeBcDomainRequest(data, &request, true); err != nil {
+		return nil, err
+	}
+	if request.NewName == "" {
+		return nil, fmt.Errorf(
+			"broadcast domain rename commands must have name, new name"+
+				" and ipspace defined, got: %+v", request)
+	}
+
+	call := zapi.NewElement("net-port-broadcast-domain-rename").
+		AddChildString("broadcast-domain", request.Name).
+		AddChildString("ipspace", request.IPSpace).
+		AddChildString("new-name", request.NewName)
+
+	if _, err := client.Invoke(call); err != nil {
+		return nil, err
+	}
+
+	return emptyResponse(), nil
+}
+
+func bcDomainPortsModify(client *zapi.Client, data interface{}, cmdType string) (interface{}, error) {
+	request := network.BcDomainRequest{}
+	if err := decodeBcDomainRequest(data, &request, true); err != nil {
+		return nil, err
+	}
+	if len(request.Ports) < 1 {
+		return nil, fmt.Errorf(
+			"broadcast domain port %s commands must have name, ipspace"+
+				" and ports defined, got: %+v", cmdType, request)
+	}
+
+	call := zapi.NewElement("net-port-broadcast-domain-"+cmdType+"-ports").
+		AddChildString("broadcast-domain", request.Name).
+		AddChildString("ipspace", request.IPSpace).
+		AddChildStrings("ports", "net-qualified-port-name", request.Ports)
+
+	results, err := client.Invoke(call)
+	if err != nil {
+		return nil, err
+	}
+
+	return bcDomainStatusResponse(results), nil
+}
+
+func bcDomainPortAdd(client *zapi.Client, data interface{}) (interface{}, error) {
+	return bcDomainPortsModify(client, data, "add")
+}
+
+func bcDomainPortRemove(client *zapi.Client, data interface{}) (interface{}, error) {
+	return bcDomainPortsModify(client, data, "remove")
+}
+
+func bcDomainUpdate(client *zapi.Client, data interface{}) (interface{}, error) {
+	request := network.BcDomainRequest{}
+	if err := decodeBcDomainRequest(data, &request, true); err != nil {
+		return nil, err
+	}
+	if request.Mtu == "" {
+		return nil, fmt.Errorf(
+			"broadcast domain update commands must have name, ipspace"+
+				" and mtu defined, got: %+v", request)
+	}
+
+	call := zapi.NewElement("net-port-broadcast-domain-modify").
+		AddChildString("broadcast-domain", request.Name).
+		AddChildString("ipspace", request.IPSpace).
+		AddChildString("mtu", request.Mtu)
+
+	results, err := client.Invoke(call)
+	if err != nil {
+		return nil, err
+	}
+
+	return bcDomainStatusResponse(results), nil
+}
+
+//*****************************************************************************
+// subnet commands
+
+func decodeSubnetRequest(data interface{}, request *network.SubnetRequest) error {
+	if err := decodeRequest(data, request); err != nil {
+		return err
+	}
+
+	if request.Name == "" || request.IPSpace == "" {
+		return fmt.Errorf(
+			"subnet request must have name and ipspace defined, got: %+v",
+			*request)
+	}
+
+	return nil
+}
+
+func subnetInfo(subnet *zapi.Element) *network.SubnetInfo {
+	info := &network.SubnetInfo{
+		IPCount:     subnet.ChildInt("total-count"),
+		IPUsed:      subnet.ChildInt("used-count"),
+		IPAvailable: subnet.ChildInt("available-count"),
+	}
+	info.Name = subnet.ChildString("subnet-name")
+	info.BroadCastDomain = subnet.ChildString("broadcast-domain")
+	info.Gateway = subnet.ChildString("gateway")
+	info.IPSpace = subnet.ChildString("ipspace")
+	info.Subnet = subnet.ChildString("subnet")
+	info.IPRanges = subnet.ChildContentList("ip-ranges")
+
+	return info
+}
+
+func subnetGet(client *zapi.Client, data interface{}) (interface{}, error) {
+	request := network.SubnetRequest{}
+	if err := decodeRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.Name == "" || request.BroadCastDomain == "" {
+		return nil, fmt.Errorf(
+			"subnet get commands must have name and broadcast domain"+
+				" defined, got: %+v", request)
+	}
+
+	call := zapi.NewElement("net-subnet-get-iter").
+		AddChild(zapi.NewElement("query").AddChild(
+			zapi.NewElement("net-subnet-info").
+				AddChildString("broadcast-domain", request.BroadCastDomain).
+				AddChildString("subnet-name", request.Name))).
+		AddChild(zapi.NewElement("desired-attributes").AddChild(
+			zapi.NewElement("net-subnet-info").
+				AddDesired("subnet-name", "broadcast-domain", "ipspace",
+					"subnet", "gateway").
+				AddChild(zapi.NewElement("ip-ranges").AddDesired("ip-range")).
+				AddDesired("total-count", "used-count", "available-count")))
+
+	results, err := client.Invoke(call)
+	if err != nil {
+		return nil, err
+	}
+	if nonExist(results) {
+		return nonExistResponse(), nil
+	}
+
+	subnet, err := singleRecord(results, "subnet", request)
+	if err != nil {
+		return nil, err
+	}
+
+	return subnetInfo(subnet), nil
+}
+
+func subnetCreate(client *zapi.Client, data interface{}) (interface{}, error) {
+	request := network.SubnetRequest{}
+	if err := decodeSubnetRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.BroadCastDomain == "" || request.Subnet == "" {
+		return nil, fmt.Errorf(
+			"subnet create commands must have name, broadcast domain,"+
+				" ipspace and subnet defined, got: %+v", request)
+	}
+
+	call := zapi.NewElement("net-subnet-create").
+		AddChildString("subnet-name", request.Name).
+		AddChildString("broadcast-domain", request.BroadCastDomain).
+		AddChildString("ipspace", request.IPSpace).
+		AddChildString("subnet", request.Subnet).
+		// make sure that create call returns record!
+		AddChildBool("return-record", true)
+	if request.Gateway != "" {
+		call.AddChildString("gateway", request.Gateway)
+	}
+	if len(request.IPRanges) > 0 {
+		call.AddChildStrings("ip-ranges", "ip-range", request.IPRanges)
+	}
+
+	results, err := client.Invoke(call)
+	if err != nil {
+		return nil, err
+	}
+
+	subnet := results.Child("result").Child("net-subnet-info")
+	if subnet == nil {
+		return nil, fmt.Errorf(
+			"no result data for create subnet with input: [%+v] result is: %s",
+			request, results)
+	}
+
+	return subnetInfo(subnet), nil
+}
+
+func subnetDelete(client *zapi.Client, data interface{}) (interface{}, error) {
+	request := network.SubnetRequest{}
+	if err := decodeSubnetRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	call := zapi.NewElement("net-subnet-destroy").
+		AddChildString("subnet-name", request.Name).
+		AddChildString("ipspace", request.IPSpace)
+	if _, err := client.Invoke(call); err != nil {
+		return nil, err
+	}
+
+	return emptyResponse(), nil
+}
+
+func subnetRename(client *zapi.Client, data interface{}) (interface{}, error) {
+	request := network.SubnetRequest{}
+	if err := decodeSubnetRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.NewName == "" {
+		return nil, fmt.Errorf(
+			"subnet rename commands must have name, new name and"+
+				" ipspace defined, got: %+v", request)
+	}
+
+	call := zapi.NewElement("net-subnet-rename").
+		AddChildString("subnet-name", request.Name).
+		AddChildString("ipspace", request.IPSpace).
+		AddChildString("new-name", request.NewName)
+	if _, err := client.Invoke(call); err != nil {
+		return nil, err
+	}
+
+	return emptyResponse(), nil
+}
+
+func subnetIPRangesModify(client *zapi.Client, data interface{}, cmdType string) (interface{}, error) {
+	request := network.SubnetRequest{}
+	if err := decodeSubnetRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if len(request.IPRanges) < 1 {
+		return nil, fmt.Errorf(
+			"subnet ip range %s commands must have name, ipspace"+
+				" and ip_ranges defined, got: %+v", cmdType, request)
+	}
+
+	call := zapi.NewElement("net-subnet-"+cmdType+"-ranges").
+		AddChildString("subnet-name", request.Name).
+		AddChildString("ipspace", request.IPSpace).
+		AddChildStrings("ip-ranges", "ip-range", request.IPRanges)
+	if _, err := client.Invoke(call); err != nil {
+		return nil, err
+	}
+
+	return emptyResponse(), nil
+}
+
+func subnetIPRangeAdd(client *zapi.Client, data interface{}) (interface{}, error) {
+	return subnetIPRangesModify(client, data, "add")
+}
+
+func subnetIPRangeRemove(client *zapi.Client, data interface{}) (interface{}, error) {
+	return subnetIPRangesModify(client, data, "remove")
+}
+
+func subnetModify(client *zapi.Client, data interface{}) (interface{}, error) {
+	request := network.SubnetRequest{}
+	if err := decodeSubnetRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.Gateway == "" && request.Subnet == "" {
+		return nil, fmt.Errorf(
+			"subnet modify must have name, ipspace and either gateway"+
+				" or subnet defined, got: %+v", request)
+	}
+
+	call := zapi.NewElement("net-subnet-modify").
+		AddChildString("subnet-name", request.Name).
+		AddChildString("ipspace", request.IPSpace)
+	if request.Gateway != "" {
+		call.AddChildString("gateway", request.Gateway)
+	}
+	if request.Subnet != "" {
+		call.AddChildString("subnet", request.Subnet)
+	}
+	if _, err := client.Invoke(call); err != nil {
+		return nil, err
+	}
+
+	return emptyResponse(), nil
+}
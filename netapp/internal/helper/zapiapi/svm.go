@@ -0,0 +1,280 @@
+package zapiapi
+
+import (
+	"fmt"
+
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/svm"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/zapi"
+)
+
+func init() {
+	registerCommands(map[string]command{
+		"SVM.GET":    svmGet,
+		"SVM.CREATE": svmCreate,
+		"SVM.DELETE": svmDelete,
+		"SVM.START":  svmSimpleCommand("start"),
+		"SVM.STOP":   svmSimpleCommand("stop"),
+		"SVM.UNLOCK": svmSimpleCommand("unlock"),
+		"SVM.RENAME": svmRename,
+
+		"SVM.VOL.ONLINE":   volumeSimpleCommand("online"),
+		"SVM.VOL.OFFLINE":  volumeSimpleCommand("offline"),
+		"SVM.VOL.RESTRICT": volumeSimpleCommand("restrict"),
+		"SVM.VOL.DELETE":   volumeSimpleCommand("destroy"),
+		"SVM.VOL.SIZE":     volumeSize,
+	})
+}
+
+func svmInfo(vserver *zapi.Element) *svm.Info {
+	info := &svm.Info{
+		ConfigLocked:  vserver.ChildBool("is-config-locked-for-changes"),
+		OperState:     vserver.ChildString("operational-state"),
+		SvmState:      vserver.ChildString("state"),
+		ProtoEnabled:  vserver.ChildContentList("allowed-protocols"),
+		ProtoInactive: vserver.ChildContentList("disallowed-protocols"),
+	}
+	if reason := vserver.ChildString("operational-state-stopped-reason"); reason != "" {
+		info.OperState += " caused by: " + reason
+	}
+
+	info.Name = vserver.ChildString("vserver-name")
+	info.UUID = vserver.ChildString("uuid")
+	info.IPSpace = vserver.ChildString("ipspace")
+	info.RootAggr = vserver.ChildString("root-volume-aggregate")
+	info.RootSecStyle = vserver.ChildString("root-volume-security-style")
+	info.RootName = vserver.ChildString("root-volume")
+	info.RootRetention = vserver.ChildString("volume-delete-retention-hours")
+
+	return info
+}
+
+// jobResult fills the async job result data of a vserver async call
+func jobResult(results *zapi.Element, result *svm.JobResult) *svm.JobResult {
+	result.Status = results.ChildString("result-status")
+	if results.Child("result-jobid") != nil {
+		result.JobID = results.ChildInt("result-jobid")
+	}
+	if results.Child("result-error-code") != nil {
+		result.ErrNo = results.ChildInt("result-error-code")
+	}
+	result.ErrMsg = results.ChildString("result-error-message")
+
+	return result
+}
+
+func svmGet(client *zapi.Client, data interface{}) (interface{}, error) {
+	request := svm.Request{}
+	if err := decodeRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	query := zapi.NewElement("vserver-info")
+	if request.Name != "" {
+		query.AddChildString("vserver-name", request.Name)
+	} else if request.UUID != "" {
+		query.AddChildString("uuid", request.UUID)
+	} else {
+		return nil, fmt.Errorf(
+			"get SVM request must have name or uuid defined, got: %+v",
+			request)
+	}
+
+	call := zapi.NewElement("vserver-get-iter").
+		AddChild(zapi.NewElement("query").AddChild(query)).
+		AddChild(zapi.NewElement("desired-attributes").AddChild(
+			zapi.NewElement("vserver-info").
+				AddChild(zapi.NewElement("allowed-protocols").AddDesired("protocol")).
+				AddChild(zapi.NewElement("disallowed-protocols").AddDesired("protocol")).
+				AddDesired("ipspace", "is-config-locked-for-changes",
+					"operational-state", "operational-state-stopped-reason",
+					"root-volume", "root-volume-aggregate",
+					"root-volume-security-style", "state", "uuid",
+					"volume-delete-retention-hours", "vserver-name")))
+
+	results, err := client.Invoke(call)
+	if err != nil {
+		return nil, err
+	}
+	if nonExist(results) {
+		return nonExistResponse(), nil
+	}
+
+	vserver, err := singleRecord(results, "SVM", request)
+	if err != nil {
+		return nil, err
+	}
+
+	return svmInfo(vserver), nil
+}
+
+func svmCreate(client *zapi.Client, data interface{}) (interface{}, error) {
+	request := svm.Request{}
+	if err := decodeRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.Name == "" || request.IPSpace == "" || request.RootAggr == "" {
+		return nil, fmt.Errorf(
+			"create SVM request must have name, ipspace and root aggregate"+
+				" defined, got: %+v", request)
+	}
+
+	call := zapi.NewElement("vserver-create-async").
+		AddChildString("vserver-name", request.Name).
+		AddChildString("ipspace", request.IPSpace).
+		AddChildString("root-volume-aggregate", request.RootAggr)
+	if request.RootName != "" {
+		call.AddChildString("root-volume", request.RootName)
+	}
+	if request.RootSecStyle != "" {
+		call.AddChildString("root-volume-security-style", request.RootSecStyle)
+	}
+	call.AddChildString("comment", "created by Terraform")
+
+	results, err := client.Invoke(call)
+	if err != nil {
+		return nil, err
+	}
+
+	vserver := results.Child("result").Child("vserver-info")
+	if vserver == nil {
+		return nil, fmt.Errorf("no svm data found in: %s", results)
+	}
+
+	return jobResult(results, &svm.JobResult{Info: *svmInfo(vserver)}), nil
+}
+
+func svmDelete(client *zapi.Client, data interface{}) (interface{}, error) {
+	request := svm.Request{}
+	if err := decodeRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.Name == "" {
+		return nil, fmt.Errorf(
+			"delete SVM request must have name defined, got: %+v", request)
+	}
+
+	call := zapi.NewElement("vserver-destroy-async").
+		AddChildString("vserver-name", request.Name)
+
+	results, err := client.Invoke(call)
+	if err != nil {
+		return nil, err
+	}
+
+	return jobResult(results, &svm.JobResult{}), nil
+}
+
+func svmSimpleCommand(cmdType string) command {
+	return func(client *zapi.Client, data interface{}) (interface{}, error) {
+		request := svm.Request{}
+		if err := decodeRequest(data, &request); err != nil {
+			return nil, err
+		}
+		if request.Name == "" {
+			return nil, fmt.Errorf(
+				"%s SVM request must have name defined, got: %+v",
+				cmdType, request)
+		}
+
+		call := zapi.NewElement("vserver-"+cmdType).
+			AddChildString("vserver-name", request.Name)
+		// force will only be present if set otherwise ommitted
+		if request.Force != "" {
+			call.AddChildBool("force", true)
+		}
+
+		if _, err := client.Invoke(call); err != nil {
+			return nil, err
+		}
+
+		return emptyResponse(), nil
+	}
+}
+
+func svmRename(client *zapi.Client, data interface{}) (interface{}, error) {
+	request := svm.Request{}
+	if err := decodeRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.Name == "" || request.NewName == "" {
+		return nil, fmt.Errorf(
+			"SVM rename request must have name and new_name defined, got: %+v",
+			request)
+	}
+
+	call := zapi.NewElement("vserver-rename").
+		AddChildString("vserver-name", request.Name).
+		AddChildString("new-name", request.NewName)
+
+	if _, err := client.Invoke(call); err != nil {
+		return nil, err
+	}
+
+	return emptyResponse(), nil
+}
+
+// decodeVolumeRequest returns the client tunneled to the request SVM
+func decodeVolumeRequest(
+	client *zapi.Client, data interface{},
+	request *svm.VolumeRequest) (*zapi.Client, error) {
+
+	if err := decodeRequest(data, request); err != nil {
+		return nil, err
+	}
+
+	if request.SvmInstanceName == "" {
+		return nil, fmt.Errorf(
+			"NetAPP SVM command must have SVM name [svm_name] defined, got: %+v",
+			*request)
+	}
+
+	if request.VolumeName == "" {
+		return nil, fmt.Errorf(
+			"SVM volume request must have name defined, got: %+v", *request)
+	}
+
+	return client.WithVserver(request.SvmInstanceName), nil
+}
+
+func volumeSimpleCommand(cmdType string) command {
+	return func(client *zapi.Client, data interface{}) (interface{}, error) {
+		request := svm.VolumeRequest{}
+		svmClient, err := decodeVolumeRequest(client, data, &request)
+		if err != nil {
+			return nil, err
+		}
+
+		call := zapi.NewElement("volume-"+cmdType).
+			AddChildString("name", request.VolumeName)
+
+		if _, err := svmClient.Invoke(call); err != nil {
+			return nil, err
+		}
+
+		return emptyResponse(), nil
+	}
+}
+
+func volumeSize(client *zapi.Client, data interface{}) (interface{}, error) {
+	request := svm.VolumeRequest{}
+	svmClient, err := decodeVolumeRequest(client, data, &request)
+	if err != nil {
+		return nil, err
+	}
+
+	call := zapi.NewElement("volume-size").
+		AddChildString("volume", request.VolumeName)
+	if request.Size != "" {
+		call.AddChildString("new-size", request.Size)
+	}
+
+	results, err := svmClient.Invoke(call)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &svm.VolumeInfo{}
+	info.Size = results.ChildString("volume-size")
+
+	return info, nil
+}
@@ -0,0 +1,473 @@
+package zapiapi
+
+import (
+	"fmt"
+
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/system"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/zapi"
+)
+
+func init() {
+	registerCommands(map[string]command{
+		"SYS.NODE.GET":              nodeGet,
+		"SYS.PORT.GET":              portGet,
+		"SYS.PORT.FIND.PATTERN":     portFindByPattern,
+		"SYS.PORT.MODIFY":           portModify,
+		"SYS.PORTGROUP.GET":         portGroupGet,
+		"SYS.PORTGROUP.CREATE":      portGroupCreate,
+		"SYS.PORTGROUP.PORT.ADD":    portGroupPortAdd,
+		"SYS.PORTGROUP.PORT.REMOVE": portGroupPortRemove,
+		"SYS.PORTGROUP.DELETE":      portGroupDelete,
+		"SYS.AGGR.GET":              aggrGet,
+		"SYS.JOB.GET":               jobGet,
+	})
+}
+
+// systemInfo returns the ONTAPI major/minor and OS version
+func systemInfo(client *zapi.Client) (int, int, string, error) {
+	results, err := client.Invoke(zapi.NewElement("system-get-ontapi-version"))
+	if err != nil {
+		return 0, 0, "", err
+	}
+	major := results.ChildInt("major-version")
+	minor := results.ChildInt("minor-version")
+
+	results, err = client.Invoke(zapi.NewElement("system-get-version"))
+	if err != nil {
+		return 0, 0, "", err
+	}
+
+	return major, minor, results.ChildString("version"), nil
+}
+
+func nodeGet(client *zapi.Client, data interface{}) (interface{}, error) {
+	request := system.NodeGetRequest{}
+	if err := decodeRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	query := zapi.NewElement("node-details-info")
+	if request.Name != "" {
+		query.AddChildString("node", request.Name)
+	}
+	if request.UUID != "" {
+		query.AddChildString("node-uuid", request.UUID)
+	}
+	if len(query.Children()) < 1 {
+		return nil, fmt.Errorf(
+			"need at least one query parameter, got: %+v", request)
+	}
+
+	call := zapi.NewElement("system-node-get-iter").
+		AddChild(zapi.NewElement("desired-attributes").AddChild(
+			zapi.NewElement("node-details-info").AddDesired(
+				"node-uuid", "node", "node-serial-number", "node-system-id",
+				"product-version", "is-node-healthy", "node-uptime"))).
+		AddChild(zapi.NewElement("query").AddChild(query))
+
+	results, err := client.Invoke(call)
+	if err != nil {
+		return nil, err
+	}
+	if nonExist(results) {
+		return nonExistResponse(), nil
+	}
+
+	node, err := singleRecord(results, "node", request)
+	if err != nil {
+		return nil, err
+	}
+
+	return &system.NodeInfo{
+		Name:    node.ChildString("node"),
+		Serial:  node.ChildString("node-serial-number"),
+		ID:      node.ChildString("node-system-id"),
+		UUID:    node.ChildString("node-uuid"),
+		Version: node.ChildString("product-version"),
+		Healty:  node.ChildBool("is-node-healthy"),
+		Uptime:  node.ChildInt("node-uptime"),
+	}, nil
+}
+
+func portQuery(request *system.PortGetRequest, desired ...string) *zapi.Element {
+	return zapi.NewElement("net-port-get-iter").
+		AddChild(zapi.NewElement("query").AddChild(
+			zapi.NewElement("net-port-info").
+				AddChildString("node", request.NodeName).
+				AddChildString("port", request.PortName))).
+		AddChild(zapi.NewElement("desired-attributes").AddChild(
+			zapi.NewElement("net-port-info").AddDesired(desired...)))
+}
+
+func decodePortRequest(data interface{}, request *system.PortGetRequest) error {
+	if err := decodeRequest(data, request); err != nil {
+		return err
+	}
+
+	if request.NodeName == "" || request.PortName == "" {
+		return fmt.Errorf(
+			"port request must have node and port defined, got: %+v",
+			*request)
+	}
+
+	return nil
+}
+
+func portGet(client *zapi.Client, data interface{}) (interface{}, error) {
+	request := system.PortGetRequest{}
+	if err := decodePortRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	results, err := client.Invoke(portQuery(&request,
+		"node", "port", "autorevert-delay", "ignore-health-status",
+		"ipspace", "role", "is-administrative-up", "mtu-admin",
+		"is-administrative-auto-negotiate", "administrative-speed",
+		"administrative-duplex", "administrative-flowcontrol",
+		"link-status", "health-status", "mac-address", "broadcast-domain",
+		"mtu", "is-operational-auto-negotiate", "operational-speed",
+		"operational-duplex", "operational-flowcontrol", "port-type",
+		"vlan-id", "vlan-node", "vlan-port"))
+	if err != nil {
+		return nil, err
+	}
+	if nonExist(results) {
+		return nonExistResponse(), nil
+	}
+
+	port, err := singleRecord(results, "port", request)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &system.PortInfo{
+		AutoRevertDelay: port.ChildString("autorevert-delay"),
+		IgnoreHealth:    port.ChildString("ignore-health-status"),
+		IPSpace:         port.ChildString("ipspace"),
+		Role:            port.ChildString("role"),
+
+		AdminUp:     port.ChildString("is-administrative-up"),
+		AdminMtu:    port.ChildString("mtu-admin"),
+		AdminAuto:   port.ChildString("is-administrative-auto-negotiate"),
+		AdminSpeed:  port.ChildString("administrative-speed"),
+		AdminDuplex: port.ChildString("administrative-duplex"),
+		AdminFlow:   port.ChildString("administrative-flowcontrol"),
+
+		Status:          port.ChildString("link-status"),
+		Health:          port.ChildString("health-status"),
+		Mac:             port.ChildString("mac-address"),
+		BroadCastDomain: port.ChildString("broadcast-domain"),
+		Mtu:             port.ChildString("mtu"),
+		Auto:            port.ChildString("is-operational-auto-negotiate"),
+		Speed:           port.ChildString("operational-speed"),
+		Duplex:          port.ChildString("operational-duplex"),
+		Flow:            port.ChildString("operational-flowcontrol"),
+
+		Type: port.ChildString("port-type"),
+
+		VlanID:   port.ChildString("vlan-id"),
+		VlanNode: port.ChildString("vlan-node"),
+		VlanPort: port.ChildString("vlan-port"),
+	}
+	info.NodeName = port.ChildString("node")
+	info.PortName = port.ChildString("port")
+
+	return info, nil
+}
+
+func portFindByPattern(client *zapi.Client, data interface{}) (interface{}, error) {
+	request := system.PortGetRequest{}
+	if err := decodePortRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	results, err := client.Invoke(portQuery(&request, "port"))
+	if err != nil {
+		return nil, err
+	}
+	if nonExist(results) {
+		return nonExistResponse(), nil
+	}
+
+	ports := []string{}
+	for _, port := range results.Child("attributes-list").Children() {
+		ports = append(ports, port.ChildString("port"))
+	}
+
+	return &system.PortFindResult{Names: ports}, nil
+}
+
+func portModify(client *zapi.Client, data interface{}) (interface{}, error) {
+	request := system.PortModifyRequest{}
+	if err := decodePortRequest(data, &request.PortGetRequest); err != nil {
+		return nil, err
+	}
+	if err := decodeRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	call := zapi.NewElement("net-port-modify").
+		AddChildString("node", request.NodeName).
+		AddChildString("port", request.PortName)
+
+	for _, value := range []struct{ name, value string }{
+		{"administrative-duplex", request.Duplex},
+		{"administrative-flowcontrol", request.Flow},
+		{"administrative-speed", request.Speed},
+		{"autorevert-delay", request.AutoRevertDelay},
+		{"ignore-health-status", request.IgnoreHealth},
+		{"ipspace", request.IPSpace},
+		{"is-administrative-auto-negotiate", request.Auto},
+		{"is-administrative-up", request.Up},
+		{"mtu", request.Mtu},
+		{"role", request.Role},
+	} {
+		if value.value != "" {
+			call.AddChildString(value.name, value.value)
+		}
+	}
+
+	if _, err := client.Invoke(call); err != nil {
+		return nil, err
+	}
+
+	return emptyResponse(), nil
+}
+
+func decodePortGroupRequest(data interface{}, request *system.PortGroupModifyRequest) error {
+	if err := decodeRequest(data, request); err != nil {
+		return err
+	}
+
+	if request.NodeName == "" || request.GroupName == "" {
+		return fmt.Errorf(
+			"port group request must have node and name defined, got: %+v",
+			*request)
+	}
+
+	return nil
+}
+
+func portGroupGet(client *zapi.Client, data interface{}) (interface{}, error) {
+	request := system.PortGroupModifyRequest{}
+	if err := decodePortGroupRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	call := zapi.NewElement("net-port-ifgrp-get").
+		AddChildString("ifgrp-name", request.GroupName).
+		AddChildString("node", request.NodeName).
+		AddChild(zapi.NewElement("desired-attributes").AddChild(
+			zapi.NewElement("net-ifgrp-info").
+				AddDesired("node", "ifgrp-name", "mode",
+					"distribution-function", "port-participation").
+				AddChild(zapi.NewElement("ports").AddDesired("lif-bindable")).
+				AddChild(zapi.NewElement("down-ports").AddDesired("lif-bindable")).
+				AddChild(zapi.NewElement("up-ports").AddDesired("lif-bindable"))))
+
+	results, err := client.Invoke(call)
+	if err != nil {
+		return nil, err
+	}
+
+	group := results.FirstOf("attributes")
+	if group == nil {
+		return nil, fmt.Errorf("no port group data found in: %s", results)
+	}
+
+	info := &system.PortGroupInfo{
+		GroupLinkStatus: group.ChildString("port-participation"),
+		PortsDown:       group.ChildContentList("down-ports"),
+		PortsUp:         group.ChildContentList("up-ports"),
+	}
+	info.NodeName = group.ChildString("node")
+	info.GroupName = group.ChildString("ifgrp-name")
+	info.Mode = group.ChildString("mode")
+	info.LoadDistribution = group.ChildString("distribution-function")
+	info.Ports = group.ChildContentList("ports")
+
+	return info, nil
+}
+
+func portGroupCreate(client *zapi.Client, data interface{}) (interface{}, error) {
+	request := system.PortGroupModifyRequest{}
+	if err := decodePortGroupRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.Mode == "" || request.LoadDistribution == "" {
+		return nil, fmt.Errorf(
+			"port group create commands must have node, name, mode"+
+				" and distribution defined, got: %+v", request)
+	}
+
+	call := zapi.NewElement("net-port-ifgrp-create").
+		AddChildString("distribution-function", request.LoadDistribution).
+		AddChildString("ifgrp-name", request.GroupName).
+		AddChildString("mode", request.Mode).
+		AddChildString("node", request.NodeName).
+		AddChildBool("return-record", false)
+
+	if _, err := client.Invoke(call); err != nil {
+		return nil, err
+	}
+
+	return emptyResponse(), nil
+}
+
+func portGroupPortsModify(client *zapi.Client, data interface{}, cmdType string) (interface{}, error) {
+	request := system.PortGroupModifyRequest{}
+	if err := decodePortGroupRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	// ifgrp only allows add/remove of single port...
+	for _, port := range request.Ports {
+		call := zapi.NewElement("net-port-ifgrp-"+cmdType+"-port").
+			AddChildString("ifgrp-name", request.GroupName).
+			AddChildString("node", request.NodeName).
+			AddChildString("port", port)
+
+		if _, err := client.Invoke(call); err != nil {
+			return nil, err
+		}
+	}
+
+	return emptyResponse(), nil
+}
+
+func portGroupPortAdd(client *zapi.Client, data interface{}) (interface{}, error) {
+	return portGroupPortsModify(client, data, "add")
+}
+
+func portGroupPortRemove(client *zapi.Client, data interface{}) (interface{}, error) {
+	return portGroupPortsModify(client, data, "remove")
+}
+
+func portGroupDelete(client *zapi.Client, data interface{}) (interface{}, error) {
+	request := system.PortGroupModifyRequest{}
+	if err := decodePortGroupRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	call := zapi.NewElement("net-port-ifgrp-destroy").
+		AddChildString("ifgrp-name", request.GroupName).
+		AddChildString("node", request.NodeName)
+
+	if _, err := client.Invoke(call); err != nil {
+		return nil, err
+	}
+
+	return emptyResponse(), nil
+}
+
+func aggrGet(client *zapi.Client, data interface{}) (interface{}, error) {
+	request := system.AggrGetRequest{}
+	if err := decodeRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.Name == "" && request.UUID == "" {
+		return nil, fmt.Errorf(
+			"get aggr request must have either name or uuid defined, got: %+v",
+			request)
+	}
+
+	query := zapi.NewElement("aggr-attributes")
+	if request.Name != "" {
+		query.AddChildString("aggregate-name", request.Name)
+	}
+	if request.UUID != "" {
+		query.AddChildString("aggregate-uuid", request.UUID)
+	}
+	if len(request.Nodes) > 0 {
+		query.AddChildStrings("nodes", "node-name", request.Nodes)
+	}
+
+	call := zapi.NewElement("aggr-get-iter").
+		AddChild(zapi.NewElement("query").AddChild(query)).
+		AddChild(zapi.NewElement("desired-attributes").AddChild(
+			zapi.NewElement("aggr-attributes").
+				AddDesired("aggregate-name", "aggregate-uuid").
+				AddChild(zapi.NewElement("aggr-space-attributes").AddDesired(
+					"percent-used-capacity", "physical-used-percent",
+					"size-available", "size-total", "size-used",
+					"total-reserved-space")).
+				AddChild(zapi.NewElement("aggr-volume-count-attributes").
+					AddDesired("flexvol-count"))))
+
+	results, err := client.Invoke(call)
+	if err != nil {
+		return nil, err
+	}
+	if nonExist(results) {
+		return nonExistResponse(), nil
+	}
+
+	aggr, err := singleRecord(results, "aggregate", request)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &system.AggrInfo{}
+	info.Name = aggr.ChildString("aggregate-name")
+	info.UUID = aggr.ChildString("aggregate-uuid")
+
+	if space := aggr.Child("aggr-space-attributes"); space != nil {
+		info.PctUsedCapacity = space.ChildInt("percent-used-capacity")
+		info.PctUsedPhysical = space.ChildInt("physical-used-percent")
+		info.SizeAvailable = space.ChildInt("size-available")
+		info.SizeTotal = space.ChildInt("size-total")
+		info.SizeUsed = space.ChildInt("size-used")
+		info.SizeReserved = space.ChildInt("total-reserved-space")
+	}
+
+	if volCount := aggr.Child("aggr-volume-count-attributes"); volCount != nil {
+		info.FlexVolCount = volCount.ChildInt("flexvol-count")
+	}
+
+	return info, nil
+}
+
+func jobGet(client *zapi.Client, data interface{}) (interface{}, error) {
+	request := system.JobGetRequest{}
+	if err := decodeRequest(data, &request); err != nil {
+		return nil, err
+	}
+	if request.ID == 0 {
+		return nil, fmt.Errorf(
+			"get job request must have id defined, got: %+v", request)
+	}
+
+	call := zapi.NewElement("job-get-iter").
+		AddChild(zapi.NewElement("query").AddChild(
+			zapi.NewElement("job-info").
+				AddChildString("job-id", fmt.Sprintf("%d", request.ID)))).
+		AddChild(zapi.NewElement("desired-attributes").AddChild(
+			zapi.NewElement("job-info").AddDesired(
+				"job-id", "job-vserver", "job-completion",
+				"job-state", "job-status-code", "job-progress")))
+
+	results, err := client.Invoke(call)
+	if err != nil {
+		return nil, err
+	}
+	if nonExist(results) {
+		return nonExistResponse(), nil
+	}
+
+	job, err := singleRecord(results, "job", request)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &system.JobInfo{
+		Message: job.ChildString("job-completion"),
+		Status:  job.ChildString("job-state"),
+		ErrNo:   job.ChildInt("job-status-code"),
+
+		Progress: job.ChildString("job-progress"),
+	}
+	info.ID = job.ChildInt("job-id")
+	info.SVM = job.ChildString("job-vserver")
+
+	return info, nil
+}
@@ -0,0 +1,240 @@
+package zapiapi
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/pythonapi"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/system"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/zapi"
+)
+
+const (
+	connectCmd = "SYS.CONNECT"
+	jobGetCmd  = "SYS.JOB.GET"
+)
+
+// jobPollInterval between the job-get-iter calls of a job watch
+const jobPollInterval = 500 * time.Millisecond
+
+// ONTAPI version used until the cluster version is known
+const (
+	initialMajorVersion = 1
+	initialMinorVersion = 2
+)
+
+// command executes one NetApp API command via ZAPI, the data is the
+// request as passed by the helpers, see decodeRequest
+type command func(client *zapi.Client, data interface{}) (interface{}, error)
+
+var commands = map[string]command{}
+
+func registerCommands(cmds map[string]command) {
+	for name, cmd := range cmds {
+		commands[name] = cmd
+	}
+}
+
+// NetAppZAPI implements the NetApp API commands natively in Go via ZAPI,
+// it replaces the Python API / NetApp Manageability SDK bridge as backend
+type NetAppZAPI struct {
+	lock       sync.Mutex
+	client     *zapi.Client
+	httpClient *http.Client
+	connected  bool
+
+	request   system.ConnectRequest
+	osVersion string
+}
+
+// CreateAPI returns the NetApp API backend using the native ZAPI
+// implementation
+func CreateAPI() (*NetAppZAPI, error) {
+	return &NetAppZAPI{}, nil
+}
+
+// decodeRequest stores the request data of the helpers in request
+func decodeRequest(data interface{}, request interface{}) error {
+	if err := pythonapi.Convert(data, request); err != nil {
+		return fmt.Errorf("request decode error: %s", err)
+	}
+
+	return nil
+}
+
+func emptyResponse() interface{} {
+	return &pythonapi.EmptyResponse{Dummy: 1}
+}
+
+func nonExistResponse() interface{} {
+	return &pythonapi.ResourceInfo{NonExist: true}
+}
+
+// nonExist returns true for get-iter results without any record
+func nonExist(results *zapi.Element) bool {
+	return results.ChildInt("num-records") == 0
+}
+
+// singleRecord returns the only record of get-iter results
+func singleRecord(results *zapi.Element, kind string, request interface{}) (*zapi.Element, error) {
+	if cnt := results.ChildInt("num-records"); cnt != 1 {
+		return nil, fmt.Errorf(
+			"not exactly one %s found for query: [%+v] result is: %s",
+			kind, request, results)
+	}
+
+	record := results.FirstOf("attributes-list")
+	if record == nil {
+		return nil, fmt.Errorf("no %s data found in: %s", kind, results)
+	}
+
+	return record, nil
+}
+
+func (api *NetAppZAPI) versionString(major, minor int) string {
+	return strconv.Itoa(major) + "." + strconv.Itoa(minor)
+}
+
+func (api *NetAppZAPI) connect(ctx context.Context, data interface{}) (interface{}, error) {
+	request := system.ConnectRequest{}
+	if err := decodeRequest(data, &request); err != nil {
+		return nil, err
+	}
+
+	api.lock.Lock()
+	defer api.lock.Unlock()
+
+	if api.connected && api.request == request {
+		// already connected and all setup, just return data
+		major, minor := api.client.Version()
+		return &system.ConnectResponse{
+			OntapVersion: api.versionString(major, minor),
+			OsVersion:    api.osVersion,
+		}, nil
+	}
+
+	api.connected = false
+	tlsConfig, err := request.TLSConfig()
+	if err != nil {
+		return nil, fmt.Errorf("API connect failed with: %s", err)
+	}
+	client := zapi.NewClient(&zapi.Config{
+		Host: request.Host, User: request.User, Password: request.Password,
+		MajorVersion: initialMajorVersion, MinorVersion: initialMinorVersion,
+		Transport: strings.ToLower(request.Transport), Port: request.Port,
+		TLSConfig: tlsConfig, HTTPClient: api.httpClient,
+	})
+
+	major, minor, osVersion, err := systemInfo(client.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("API connect failed with: %s", err)
+	}
+	log.Printf("[INFO] ZAPI connected to [%s], ONTAPI v%d.%d, OS: %s",
+		request.Host, major, minor, osVersion)
+
+	client.SetVersion(major, minor)
+	api.client = client
+	api.request = request
+	api.osVersion = osVersion
+	api.connected = true
+
+	return &system.ConnectResponse{
+		OntapVersion: api.versionString(major, minor),
+		OsVersion:    osVersion,
+	}, nil
+}
+
+func (api *NetAppZAPI) execute(
+	ctx context.Context, cmdName string, data interface{}) (interface{}, error) {
+	if cmdName == connectCmd {
+		return api.connect(ctx, data)
+	}
+
+	cmd, ok := commands[cmdName]
+	if !ok {
+		return nil, fmt.Errorf("could not get command: %s", cmdName)
+	}
+
+	api.lock.Lock()
+	client := api.client
+	connected := api.connected
+	api.lock.Unlock()
+
+	if !connected {
+		return nil, fmt.Errorf("API not connected, call Connect() first")
+	}
+
+	return cmd(client.WithContext(ctx), data)
+}
+
+// Call executes the named command, the result is stored in response
+func (api *NetAppZAPI) Call(
+	ctx context.Context, cmdName string,
+	request, response interface{}) error {
+
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("api call [%s] not executed, got: %s", cmdName, err)
+	}
+
+	result, err := api.execute(ctx, cmdName, request)
+	if err != nil {
+		log.Printf("[WARN] ZAPI cmd [%s] failed with: %s", cmdName, err)
+		return pythonapi.NewAPIError(
+			cmdName, zapi.ErrNo(err),
+			fmt.Sprintf("failed cmd [%s] with %s", cmdName, err))
+	}
+
+	if err := pythonapi.Convert(result, response); err != nil {
+		return fmt.Errorf(
+			"api call [%s] result conversion error: %s", cmdName, err)
+	}
+
+	return nil
+}
+
+// WatchJob polls the job until it ended, each changed job state is stored
+// in response before update is called
+func (api *NetAppZAPI) WatchJob(
+	ctx context.Context, request, response interface{},
+	update func() error) error {
+
+	var last system.JobInfo
+	for {
+		result, err := api.execute(ctx, jobGetCmd, request)
+		if err != nil {
+			return pythonapi.NewAPIError(
+				jobGetCmd, zapi.ErrNo(err),
+				fmt.Sprintf("failed cmd [%s] with %s", jobGetCmd, err))
+		}
+
+		info := system.JobInfo{}
+		if err := pythonapi.Convert(result, &info); err != nil {
+			return fmt.Errorf("job watch result conversion error: %s", err)
+		}
+		if info != last {
+			if err := pythonapi.Convert(&info, response); err != nil {
+				return fmt.Errorf("job watch result conversion error: %s", err)
+			}
+			if err := update(); err != nil {
+				return err
+			}
+			last = info
+		}
+
+		if system.JobEnded(info.Status) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(jobPollInterval):
+		}
+	}
+}
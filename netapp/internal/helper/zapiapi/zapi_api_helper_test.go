@@ -0,0 +1,333 @@
+package zapiapi
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/network"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/pythonapi"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/svm"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/system"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/zapi"
+)
+
+// fakeFiler answers ZAPI requests with canned results per API name
+type fakeFiler struct {
+	lock     sync.Mutex
+	results  map[string]string
+	requests []*zapi.Element
+}
+
+func (f *fakeFiler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	netapp, err := zapi.ParseElement(req.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.requests = append(f.requests, netapp)
+
+	call := netapp.Children()[0]
+	results, ok := f.results[call.Name]
+	if !ok {
+		results = `<results status="failed" errno="13005" reason="Unable to find API: ` +
+			call.Name + `"></results>`
+	}
+
+	w.Write([]byte(`<?xml version='1.0' encoding='UTF-8' ?>` +
+		`<netapp version='1.130' xmlns='http://www.netapp.com/filer/admin'>` +
+		results + `</netapp>`))
+}
+
+func (f *fakeFiler) lastRequest() *zapi.Element {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.requests[len(f.requests)-1]
+}
+
+func testAPI(t *testing.T, results map[string]string) (*NetAppZAPI, *fakeFiler, func()) {
+	results["system-get-ontapi-version"] = `<results status="passed">` +
+		`<major-version>1</major-version><minor-version>130</minor-version></results>`
+	results["system-get-version"] = `<results status="passed">` +
+		`<version>NetApp Release 9.3P5</version></results>`
+
+	filer := &fakeFiler{results: results}
+	srv := httptest.NewTLSServer(filer)
+
+	api := &NetAppZAPI{httpClient: srv.Client()}
+
+	resp, err := system.Connect(api, &system.ConnectRequest{
+		Host:     strings.TrimPrefix(srv.URL, "https://"),
+		User:     "admin",
+		Password: "secret",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "1.130", resp.OntapVersion)
+	require.Equal(t, "NetApp Release 9.3P5", resp.OsVersion)
+
+	return api, filer, srv.Close
+}
+
+func Test_ZAPI_ConnectTLS(t *testing.T) {
+	r := require.New(t)
+	filer := &fakeFiler{results: map[string]string{
+		"system-get-ontapi-version": `<results status="passed">` +
+			`<major-version>1</major-version><minor-version>130</minor-version></results>`,
+		"system-get-version": `<results status="passed">` +
+			`<version>NetApp Release 9.3P5</version></results>`,
+	}}
+	srv := httptest.NewTLSServer(filer)
+	defer srv.Close()
+
+	host, port, err := net.SplitHostPort(strings.TrimPrefix(srv.URL, "https://"))
+	r.NoError(err)
+	request := system.ConnectRequest{Host: host, User: "admin", Password: "secret"}
+	request.Port, err = strconv.Atoi(port)
+	r.NoError(err)
+
+	connect := func(request system.ConnectRequest) error {
+		api, err := CreateAPI()
+		r.NoError(err)
+		_, err = system.Connect(api, &request)
+		return err
+	}
+
+	// the self-signed test certificate is verified by default
+	err = connect(request)
+	r.Error(err)
+	r.Contains(err.Error(), "certificate")
+
+	insecure := request
+	insecure.Insecure = true
+	r.NoError(connect(insecure))
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	r.NoError(ioutil.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{
+		Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}), 0600))
+	withCA := request
+	withCA.CAFile = caFile
+	r.NoError(connect(withCA))
+
+	withCA.CAFile = filepath.Join(t.TempDir(), "missing.pem")
+	err = connect(withCA)
+	r.Error(err)
+	r.Contains(err.Error(), "could not read ca_file")
+
+	plain := httptest.NewServer(filer)
+	defer plain.Close()
+	r.NoError(connect(system.ConnectRequest{
+		Host: strings.TrimPrefix(plain.URL, "http://"), User: "admin", Password: "secret",
+		Transport: system.TransportHTTP}))
+}
+
+// writeClientCert writes a self-signed client certificate and its key
+func writeClientCert(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "admin"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "client.pem"), filepath.Join(dir, "client.key")
+	require.NoError(t, ioutil.WriteFile(certFile, pem.EncodeToMemory(
+		&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, ioutil.WriteFile(keyFile, pem.EncodeToMemory(
+		&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+
+	return certFile, keyFile
+}
+
+func Test_ZAPI_ConnectClientCert(t *testing.T) {
+	r := require.New(t)
+	filer := &fakeFiler{results: map[string]string{
+		"system-get-ontapi-version": `<results status="passed">` +
+			`<major-version>1</major-version><minor-version>130</minor-version></results>`,
+		"system-get-version": `<results status="passed">` +
+			`<version>NetApp Release 9.3P5</version></results>`,
+	}}
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(
+		func(w http.ResponseWriter, req *http.Request) {
+			if _, _, ok := req.BasicAuth(); ok {
+				t.Errorf("unexpected basic auth with client certificate")
+			}
+			if len(req.TLS.PeerCertificates) != 1 ||
+				req.TLS.PeerCertificates[0].Subject.CommonName != "admin" {
+				t.Errorf("missing client certificate")
+			}
+			filer.ServeHTTP(w, req)
+		}))
+	srv.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	srv.StartTLS()
+	defer srv.Close()
+
+	certFile, keyFile := writeClientCert(t)
+	api, err := CreateAPI()
+	r.NoError(err)
+	_, err = system.Connect(api, &system.ConnectRequest{
+		Host: strings.TrimPrefix(srv.URL, "https://"), User: "admin",
+		Insecure: true, ClientCertFile: certFile, ClientKeyFile: keyFile})
+	r.NoError(err)
+
+	_, err = system.Connect(api, &system.ConnectRequest{
+		Host: strings.TrimPrefix(srv.URL, "https://"), User: "admin",
+		Insecure: true, ClientCertFile: certFile, ClientKeyFile: certFile})
+	r.Error(err)
+	r.Contains(err.Error(), "could not load client_cert_file")
+}
+
+func Test_ZAPI_NotConnected(t *testing.T) {
+	r := require.New(t)
+	api, err := CreateAPI()
+	r.NoError(err)
+
+	_, err = network.VlanGet(api, &network.VlanRequest{ParentName: "e0c", VlanID: "12"})
+	r.Error(err)
+	r.Contains(err.Error(), "API not connected")
+
+	_, err = svm.GetByName(api, "svm1")
+	r.Error(err)
+}
+
+func Test_ZAPI_VlanGet(t *testing.T) {
+	r := require.New(t)
+	api, filer, done := testAPI(t, map[string]string{
+		"net-vlan-get-iter": `<results status="passed"><attributes-list><vlan-info>` +
+			`<interface-name>e0c-12</interface-name><node>node-01</node>` +
+			`<parent-interface>e0c</parent-interface><vlanid>12</vlanid>` +
+			`</vlan-info></attributes-list><num-records>1</num-records></results>`,
+	})
+	defer done()
+
+	info, err := network.VlanGet(api, &network.VlanRequest{
+		NodeName: "node-01", ParentName: "e0c", VlanID: "12"})
+	r.NoError(err)
+	r.False(info.NonExist)
+	r.Equal("e0c-12", info.Name)
+	r.Equal("node-01", info.NodeName)
+	r.Equal("12", info.VlanID)
+
+	query := filer.lastRequest().Child("net-vlan-get-iter").FirstOf("query")
+	r.Equal("vlan-info", query.Name)
+	r.Equal("node-01", query.ChildString("node"))
+	r.Equal("1.130", filer.lastRequest().Attr("version"))
+}
+
+func Test_ZAPI_NonExist(t *testing.T) {
+	r := require.New(t)
+	api, _, done := testAPI(t, map[string]string{
+		"net-port-broadcast-domain-get-iter": `<results status="passed">` +
+			`<num-records>0</num-records></results>`,
+	})
+	defer done()
+
+	info, err := network.BcDomainGet(api, "bcd1")
+	r.NoError(err)
+	r.True(info.NonExist)
+}
+
+func Test_ZAPI_DuplicateEntry(t *testing.T) {
+	r := require.New(t)
+	api, _, done := testAPI(t, map[string]string{
+		"net-vlan-create": `<results status="failed" errno="13130" ` +
+			`reason="duplicate entry"></results>`,
+	})
+	defer done()
+
+	err := network.VlanCreate(api, &network.VlanRequest{ParentName: "e0c", VlanID: "12"})
+	r.Error(err)
+	// vlan resource create relies on the ZAPI reason in the error message
+	r.Contains(err.Error(), "reason=\"duplicate entry\"")
+}
+
+func Test_ZAPI_SvmCreate(t *testing.T) {
+	r := require.New(t)
+	api, filer, done := testAPI(t, map[string]string{
+		"vserver-create-async": `<results status="passed"><result><vserver-info>` +
+			`<vserver-name>svm1</vserver-name><uuid>1234-abcd</uuid>` +
+			`<ipspace>Default</ipspace><root-volume-aggregate>aggr1</root-volume-aggregate>` +
+			`</vserver-info></result><result-jobid>4711</result-jobid>` +
+			`<result-status>in_progress</result-status></results>`,
+	})
+	defer done()
+
+	result, err := svm.Create(api, &svm.Request{
+		Name: "svm1", IPSpace: "Default", RootAggr: "aggr1"})
+	r.NoError(err)
+	r.Equal("in_progress", result.Status)
+	r.Equal(4711, result.JobID)
+	r.Equal(0, result.ErrNo)
+	r.Equal("1234-abcd", result.UUID)
+
+	call := filer.lastRequest().Child("vserver-create-async")
+	r.Equal("created by Terraform", call.ChildString("comment"))
+	r.Nil(call.Child("root-volume"))
+}
+
+func Test_ZAPI_VolumeTunnel(t *testing.T) {
+	r := require.New(t)
+	api, filer, done := testAPI(t, map[string]string{
+		"volume-size": `<results status="passed"><volume-size>2g</volume-size></results>`,
+	})
+	defer done()
+
+	request := &svm.VolumeRequest{VolumeName: "svm1_root", Size: "2g"}
+	request.SvmInstanceName = "svm1"
+	info, err := svm.VolumeSizeCommand(api, request)
+	r.NoError(err)
+	r.Equal("2g", info.Size)
+	r.Equal("svm1", filer.lastRequest().Attr("vfiler"))
+}
+
+func Test_ZAPI_JobWatch(t *testing.T) {
+	r := require.New(t)
+	api, filer, done := testAPI(t, map[string]string{
+		"job-get-iter": `<results status="passed"><attributes-list><job-info>` +
+			`<job-id>4711</job-id><job-vserver>svm1</job-vserver>` +
+			`<job-state>success</job-state><job-status-code>0</job-status-code>` +
+			`</job-info></attributes-list><num-records>1</num-records></results>`,
+	})
+	defer done()
+
+	var _ pythonapi.JobWatcher = api
+	states := []string{}
+	info, err := system.JobWatch(context.Background(), api, 4711, func(info *system.JobInfo) {
+		states = append(states, info.Status)
+	})
+	r.NoError(err)
+	r.Equal("success", info.Status)
+	r.Equal("svm1", info.SVM)
+	r.Equal([]string{"success"}, states)
+
+	query := filer.lastRequest().Child("job-get-iter").FirstOf("query")
+	r.Equal("4711", query.ChildString("job-id"))
+}
@@ -0,0 +1,239 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const defaultTimeout = 60 * time.Second
+
+// Config contains the REST client connection parameters
+type Config struct {
+	Host     string
+	User     string
+	Password string
+
+	// Transport is the URL scheme http or https (default), Port replaces
+	// the default port of the transport if set
+	Transport string
+	Port      int
+
+	Timeout time.Duration
+
+	// TLSConfig replaces the default TLS configuration of https requests,
+	// the server certificate is verified with the system CAs by default
+	TLSConfig *tls.Config
+
+	// HTTPClient replaces the default HTTP client if set
+	HTTPClient *http.Client
+}
+
+// Error is returned for REST requests with a HTTP status >= 400
+type Error struct {
+	Method  string
+	Path    string
+	Status  int
+	Code    string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf(
+		"[%s %s] failed with status [%d] code [%s] reason=\"%s\"",
+		e.Method, e.Path, e.Status, e.Code, e.Message)
+}
+
+// errorResponse is the ONTAP REST error body
+type errorResponse struct {
+	Error struct {
+		Message string `json:"message"`
+		Code    string `json:"code"`
+	} `json:"error"`
+}
+
+// JobRef references the job of an asynchronous (HTTP 202) request
+type JobRef struct {
+	UUID string `json:"uuid"`
+}
+
+// Response contains the common fields of ONTAP REST responses
+type Response struct {
+	NumRecords int     `json:"num_records"`
+	Job        *JobRef `json:"job,omitempty"`
+}
+
+// NameRef is the name/uuid reference used for related objects
+type NameRef struct {
+	Name string `json:"name,omitempty"`
+	UUID string `json:"uuid,omitempty"`
+}
+
+// Client sends ONTAP REST API requests via HTTPS to a NetApp cluster
+type Client struct {
+	cfg        Config
+	httpClient *http.Client
+	ctx        context.Context
+}
+
+// NewClient returns a new client for the provided configuration
+func NewClient(cfg *Config) *Client {
+	c := &Client{cfg: *cfg}
+	if c.cfg.Transport == "" {
+		c.cfg.Transport = "https"
+	}
+
+	timeout := c.cfg.Timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+
+	c.httpClient = c.cfg.HTTPClient
+	if c.httpClient == nil {
+		c.httpClient = &http.Client{Timeout: timeout}
+		if c.cfg.TLSConfig != nil {
+			c.httpClient.Transport = &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: c.cfg.TLSConfig,
+			}
+		}
+	}
+
+	return c
+}
+
+// WithContext returns a copy of the client sending requests with ctx,
+// requests and job waits are aborted once the context is done
+func (c *Client) WithContext(ctx context.Context) *Client {
+	ctxClient := *c
+	ctxClient.ctx = ctx
+	return &ctxClient
+}
+
+// Context returns the request context of the client
+func (c *Client) Context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+
+	return c.ctx
+}
+
+// baseURL returns the transport and host with the optional port
+func (c *Client) baseURL() string {
+	host := c.cfg.Host
+	if c.cfg.Port != 0 {
+		host = net.JoinHostPort(host, strconv.Itoa(c.cfg.Port))
+	}
+
+	return c.cfg.Transport + "://" + host
+}
+
+func (c *Client) url(path string, query url.Values) string {
+	u := c.baseURL() + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	return u
+}
+
+// Do sends the request with the optional JSON body and decodes
+// the JSON response into result if not nil
+func (c *Client) Do(
+	method, path string, query url.Values,
+	body, result interface{}) error {
+
+	var reqBody *bytes.Buffer
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("REST [%s %s] body marshal error: %s", method, path, err)
+		}
+		reqBody = bytes.NewBuffer(data)
+	} else {
+		reqBody = &bytes.Buffer{}
+	}
+
+	req, err := http.NewRequest(method, c.url(path, query), reqBody)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(c.Context())
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.cfg.Password != "" {
+		// otherwise authenticated by the client certificate of TLSConfig
+		req.SetBasicAuth(c.cfg.User, c.cfg.Password)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		log.Printf("[ERROR] REST [%s %s] request failed: %s", method, path, err)
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("REST [%s %s] response read error: %s", method, path, err)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		errResp := errorResponse{}
+		if json.Unmarshal(data, &errResp) != nil || errResp.Error.Message == "" {
+			errResp.Error.Message = string(data)
+		}
+
+		return &Error{
+			Method: method, Path: path, Status: resp.StatusCode,
+			Code: errResp.Error.Code, Message: errResp.Error.Message,
+		}
+	}
+
+	if result == nil || len(data) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(data, result); err != nil {
+		return fmt.Errorf("REST [%s %s] response decode error: %s", method, path, err)
+	}
+
+	return nil
+}
+
+// Get sends a GET request, e.g. a collection query with fields
+func (c *Client) Get(path string, query url.Values, result interface{}) error {
+	return c.Do(http.MethodGet, path, query, nil, result)
+}
+
+// Post sends a POST request with body, the response contains the job
+// reference for asynchronous requests
+func (c *Client) Post(path string, query url.Values, body, result interface{}) error {
+	return c.Do(http.MethodPost, path, query, body, result)
+}
+
+// Patch sends a PATCH request to modify the object at path
+func (c *Client) Patch(path string, body interface{}) (*Response, error) {
+	resp := &Response{}
+	err := c.Do(http.MethodPatch, path, nil, body, resp)
+	return resp, err
+}
+
+// Delete sends a DELETE request for the object at path
+func (c *Client) Delete(path string) (*Response, error) {
+	resp := &Response{}
+	err := c.Do(http.MethodDelete, path, nil, nil, resp)
+	return resp, err
+}
@@ -0,0 +1,79 @@
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testClient(handler http.HandlerFunc) (*Client, func()) {
+	srv := httptest.NewTLSServer(handler)
+	client := NewClient(&Config{
+		Host:       strings.TrimPrefix(srv.URL, "https://"),
+		User:       "admin",
+		Password:   "secret",
+		HTTPClient: srv.Client(),
+	})
+
+	return client, srv.Close
+}
+
+func Test_Client_Get(t *testing.T) {
+	r := require.New(t)
+	client, done := testClient(func(w http.ResponseWriter, req *http.Request) {
+		user, password, ok := req.BasicAuth()
+		r.True(ok)
+		r.Equal("admin", user)
+		r.Equal("secret", password)
+		r.Equal("name,uuid", req.URL.Query().Get("fields"))
+		w.Write([]byte(`{"records":[{"name":"ips1","uuid":"1234"}],"num_records":1}`))
+	})
+	defer done()
+
+	resp := struct {
+		Response
+		Records []NameRef `json:"records"`
+	}{}
+	r.NoError(client.Get("/api/network/ipspaces",
+		map[string][]string{"fields": {"name,uuid"}}, &resp))
+	r.Equal(1, resp.NumRecords)
+	r.Equal("ips1", resp.Records[0].Name)
+}
+
+func Test_Client_Error(t *testing.T) {
+	r := require.New(t)
+	client, done := testClient(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":{"message":"duplicate entry","code":"1967082"}}`))
+	})
+	defer done()
+
+	err := client.Post("/api/network/ethernet/ports", nil, map[string]string{}, nil)
+	r.Error(err)
+	restErr, ok := err.(*Error)
+	r.True(ok)
+	r.Equal(http.StatusBadRequest, restErr.Status)
+	r.Equal("1967082", restErr.Code)
+	r.Contains(err.Error(), "reason=\"duplicate entry\"")
+}
+
+func Test_Client_WaitJob(t *testing.T) {
+	r := require.New(t)
+	client, done := testClient(func(w http.ResponseWriter, req *http.Request) {
+		r.Equal("/api/cluster/jobs/job-1", req.URL.Path)
+		w.Write([]byte(`{"uuid":"job-1","state":"failure","code":2,"message":"no space"}`))
+	})
+	defer done()
+
+	job, err := client.WaitJob(nil)
+	r.NoError(err)
+	r.Nil(job)
+
+	job, err = client.WaitJob(&JobRef{UUID: "job-1"})
+	r.Error(err)
+	r.Contains(err.Error(), "no space")
+	r.Equal("failure", job.State)
+}
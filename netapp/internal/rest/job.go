@@ -0,0 +1,73 @@
+package rest
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	jobPollInterval = 500 * time.Millisecond
+	jobWaitTimeout  = 10 * time.Minute
+)
+
+// Job is the state of an asynchronous ONTAP job, /api/cluster/jobs
+type Job struct {
+	UUID        string  `json:"uuid"`
+	State       string  `json:"state"` // queued, running, paused, success, failure
+	Code        int     `json:"code"`
+	Message     string  `json:"message"`
+	Description string  `json:"description"`
+	SVM         NameRef `json:"svm"`
+}
+
+// Done returns true if the job reached a final state
+func (j *Job) Done() bool {
+	return j.State == "success" || j.State == "failure"
+}
+
+// GetJob returns the current job state for uuid
+func (c *Client) GetJob(uuid string) (*Job, error) {
+	job := &Job{}
+	err := c.Get("/api/cluster/jobs/"+uuid, nil, job)
+	return job, err
+}
+
+// WaitJob polls the job until done, a failed job is returned as error
+func (c *Client) WaitJob(ref *JobRef) (*Job, error) {
+	if ref == nil || ref.UUID == "" {
+		// synchronous request, nothing to wait for
+		return nil, nil
+	}
+
+	deadline := time.Now().Add(jobWaitTimeout)
+	for {
+		job, err := c.GetJob(ref.UUID)
+		if err != nil {
+			return nil, err
+		}
+
+		if job.Done() {
+			if job.State != "success" {
+				return job, fmt.Errorf(
+					"job [%s] failed with code [%d]: %s",
+					job.UUID, job.Code, job.Message)
+			}
+
+			return job, nil
+		}
+
+		if time.Now().After(deadline) {
+			return job, fmt.Errorf(
+				"job [%s] not done after %s, state: %s",
+				job.UUID, jobWaitTimeout, job.State)
+		}
+
+		select {
+		case <-c.Context().Done():
+			return job, fmt.Errorf(
+				"job [%s] wait aborted in state [%s]: %s",
+				job.UUID, job.State, c.Context().Err())
+		case <-time.After(jobPollInterval):
+		}
+	}
+}
@@ -0,0 +1,232 @@
+package zapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// filerPath is the ZAPI servlet for cluster / FILER requests
+	filerPath = "/servlets/netapp.servlets.admin.XMLrequest_filer"
+
+	zapiNamespace = "http://www.netapp.com/filer/admin"
+
+	defaultTimeout = 60 * time.Second
+)
+
+// Config contains the ZAPI client connection parameters
+type Config struct {
+	Host     string
+	User     string
+	Password string
+
+	// MajorVersion / MinorVersion are the ONTAPI version used for requests
+	MajorVersion int
+	MinorVersion int
+
+	// Transport is the URL scheme http or https (default), Port replaces
+	// the default port of the transport if set
+	Transport string
+	Port      int
+
+	Timeout time.Duration
+
+	// TLSConfig replaces the default TLS configuration of https requests,
+	// the server certificate is verified with the system CAs by default
+	TLSConfig *tls.Config
+
+	// HTTPClient replaces the default HTTP client if set
+	HTTPClient *http.Client
+}
+
+// ErrNoAuthFailed is the errno of requests rejected with HTTP status 401
+const ErrNoAuthFailed = 13002
+
+// Error is returned for ZAPI requests with results status 'failed'
+type Error struct {
+	API    string
+	ErrNo  int
+	Reason string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf(
+		"[%s] failed with errno [%d] reason=\"%s\"",
+		e.API, e.ErrNo, e.Reason)
+}
+
+// ErrNo returns the errno of the ZAPI error in the chain of err, zero if
+// err is no ZAPI error, e.g. a transport error
+func ErrNo(err error) int {
+	var zapiErr *Error
+	if errors.As(err, &zapiErr) {
+		return zapiErr.ErrNo
+	}
+
+	return 0
+}
+
+// Client sends ZAPI requests via XML over HTTPS to a NetApp cluster
+type Client struct {
+	cfg        Config
+	vserver    string
+	httpClient *http.Client
+	ctx        context.Context
+}
+
+// NewClient returns a new client for the provided configuration
+func NewClient(cfg *Config) *Client {
+	c := &Client{cfg: *cfg}
+	if c.cfg.Transport == "" {
+		c.cfg.Transport = "https"
+	}
+	if c.cfg.MajorVersion == 0 {
+		c.cfg.MajorVersion = 1
+	}
+
+	timeout := c.cfg.Timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+
+	c.httpClient = c.cfg.HTTPClient
+	if c.httpClient == nil {
+		c.httpClient = &http.Client{Timeout: timeout}
+		if c.cfg.TLSConfig != nil {
+			c.httpClient.Transport = &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: c.cfg.TLSConfig,
+			}
+		}
+	}
+
+	return c
+}
+
+// SetVersion sets the ONTAPI version used for following requests
+func (c *Client) SetVersion(major, minor int) {
+	c.cfg.MajorVersion = major
+	c.cfg.MinorVersion = minor
+}
+
+// Version returns the ONTAPI major/minor version used for requests
+func (c *Client) Version() (int, int) {
+	return c.cfg.MajorVersion, c.cfg.MinorVersion
+}
+
+// WithContext returns a copy of the client sending requests with ctx,
+// the request is aborted once the context is done
+func (c *Client) WithContext(ctx context.Context) *Client {
+	ctxClient := *c
+	ctxClient.ctx = ctx
+	return &ctxClient
+}
+
+// Context returns the request context of the client
+func (c *Client) Context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+
+	return c.ctx
+}
+
+// WithVserver returns a copy of the client tunneling requests to vserver
+func (c *Client) WithVserver(vserver string) *Client {
+	svmClient := *c
+	svmClient.vserver = vserver
+	return &svmClient
+}
+
+// baseURL returns the transport and host with the optional port
+func (c *Client) baseURL() string {
+	host := c.cfg.Host
+	if c.cfg.Port != 0 {
+		host = net.JoinHostPort(host, strconv.Itoa(c.cfg.Port))
+	}
+
+	return c.cfg.Transport + "://" + host
+}
+
+func (c *Client) url() string {
+	return c.baseURL() + filerPath
+}
+
+func (c *Client) envelope(call *Element) *Element {
+	env := NewElement("netapp")
+	env.SetAttr("xmlns", zapiNamespace)
+	env.SetAttr("version", strconv.Itoa(c.cfg.MajorVersion)+
+		"."+strconv.Itoa(c.cfg.MinorVersion))
+	if c.vserver != "" {
+		env.SetAttr("vfiler", c.vserver)
+	}
+
+	return env.AddChild(call)
+}
+
+// Invoke sends the call to the cluster and returns the results element,
+// a results status other than 'passed' is returned as *Error
+func (c *Client) Invoke(call *Element) (*Element, error) {
+	body := "<?xml version='1.0' encoding='utf-8'?>" + c.envelope(call).String()
+
+	req, err := http.NewRequest("POST", c.url(), bytes.NewBufferString(body))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(c.Context())
+	req.Header.Set("Content-Type", "text/xml; charset=\"UTF-8\"")
+	if c.cfg.Password != "" {
+		// otherwise authenticated by the client certificate of TLSConfig
+		req.SetBasicAuth(c.cfg.User, c.cfg.Password)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		log.Printf("[ERROR] ZAPI [%s] request failed: %s", call.Name, err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		// reported like the NMSDK does for rejected credentials
+		return nil, &Error{
+			API: call.Name, ErrNo: ErrNoAuthFailed,
+			Reason: "Authorization failed, HTTP status [" + resp.Status + "]"}
+	}
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := ioutil.ReadAll(resp.Body)
+		return nil, fmt.Errorf(
+			"ZAPI [%s] HTTP status [%s]: %s",
+			call.Name, resp.Status, string(msg))
+	}
+
+	netapp, err := ParseElement(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"ZAPI [%s] response parse error: %s", call.Name, err)
+	}
+
+	results := netapp.Child("results")
+	if results == nil {
+		return nil, fmt.Errorf(
+			"ZAPI [%s] response without results: %s",
+			call.Name, netapp.String())
+	}
+
+	if results.Attr("status") != "passed" {
+		errNo, _ := strconv.Atoi(results.Attr("errno"))
+		return results, &Error{
+			API: call.Name, ErrNo: errNo, Reason: results.Attr("reason")}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,131 @@
+package zapi
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Element_RoundTrip(t *testing.T) {
+	r := require.New(t)
+
+	call := NewElement("net-vlan-get-iter").
+		AddChild(NewElement("query").AddChild(
+			NewElement("vlan-info").
+				AddChildString("parent-interface", "e0c").
+				AddChildString("vlanid", "12"))).
+		AddChildStrings("ports", "net-qualified-port-name", []string{"n1:e0c", "n1:e0d"}).
+		AddChildBool("return-record", true).
+		AddChildString("comment", "a <b> & c")
+
+	parsed, err := ParseElement(strings.NewReader(call.String()))
+	r.NoError(err)
+
+	r.Equal("net-vlan-get-iter", parsed.Name)
+	r.Equal("e0c", parsed.FirstOf("query").ChildString("parent-interface"))
+	r.Equal(12, parsed.FirstOf("query").ChildInt("vlanid"))
+	r.Equal([]string{"n1:e0c", "n1:e0d"}, parsed.ChildContentList("ports"))
+	r.True(parsed.ChildBool("return-record"))
+	r.Equal("a <b> & c", parsed.ChildString("comment"))
+	r.Equal(-1, parsed.ChildInt("comment"))
+	r.Equal(-1, parsed.ChildInt("missing"))
+	r.Nil(parsed.FirstOf("missing"))
+}
+
+func testServer(t *testing.T, status int, response string) (*httptest.Server, *Element) {
+	request := &Element{}
+	srv := httptest.NewTLSServer(http.HandlerFunc(
+		func(w http.ResponseWriter, req *http.Request) {
+			if req.URL.Path != filerPath {
+				t.Errorf("unexpected ZAPI path: %s", req.URL.Path)
+			}
+			if user, pwd, ok := req.BasicAuth(); !ok || user != "admin" || pwd != "secret" {
+				t.Errorf("unexpected basic auth: %s:%s", user, pwd)
+			}
+
+			body, _ := ioutil.ReadAll(req.Body)
+			parsed, err := ParseElement(strings.NewReader(string(body)))
+			if err != nil {
+				t.Errorf("invalid ZAPI request: %s", err)
+			}
+			*request = *parsed
+
+			w.WriteHeader(status)
+			w.Write([]byte(response))
+		}))
+
+	return srv, request
+}
+
+func testClient(srv *httptest.Server) *Client {
+	return NewClient(&Config{
+		Host:       strings.TrimPrefix(srv.URL, "https://"),
+		User:       "admin",
+		Password:   "secret",
+		HTTPClient: srv.Client(),
+	})
+}
+
+func Test_Client_Invoke_Passed(t *testing.T) {
+	r := require.New(t)
+	srv, request := testServer(t, http.StatusOK, `<?xml version='1.0' encoding='UTF-8' ?>
+<netapp version='1.130' xmlns='http://www.netapp.com/filer/admin'>
+  <results status="passed">
+    <major-version>1</major-version>
+    <minor-version>130</minor-version>
+  </results>
+</netapp>`)
+	defer srv.Close()
+
+	client := testClient(srv)
+	client.SetVersion(1, 31)
+	results, err := client.WithVserver("svm1").Invoke(
+		NewElement("system-get-ontapi-version"))
+	r.NoError(err)
+	r.Equal(1, results.ChildInt("major-version"))
+	r.Equal(130, results.ChildInt("minor-version"))
+
+	r.Equal("netapp", request.Name)
+	r.Equal("1.31", request.Attr("version"))
+	r.Equal("svm1", request.Attr("vfiler"))
+	r.NotNil(request.Child("system-get-ontapi-version"))
+}
+
+func Test_Client_Invoke_Failed(t *testing.T) {
+	r := require.New(t)
+	srv, _ := testServer(t, http.StatusOK, `<?xml version='1.0' encoding='UTF-8' ?>
+<netapp version='1.130' xmlns='http://www.netapp.com/filer/admin'>
+  <results status="failed" errno="13130" reason="duplicate entry"></results>
+</netapp>`)
+	defer srv.Close()
+
+	_, err := testClient(srv).Invoke(NewElement("net-vlan-create"))
+	r.Error(err)
+	zerr, ok := err.(*Error)
+	r.True(ok)
+	r.Equal(13130, zerr.ErrNo)
+	r.Contains(err.Error(), "reason=\"duplicate entry\"")
+}
+
+func Test_Client_Invoke_HTTPError(t *testing.T) {
+	r := require.New(t)
+	srv, _ := testServer(t, http.StatusUnauthorized, "Unauthorized")
+	defer srv.Close()
+
+	_, err := testClient(srv).Invoke(NewElement("system-get-version"))
+	r.Error(err)
+	r.Contains(err.Error(), "401")
+	r.Equal(ErrNoAuthFailed, ErrNo(err))
+
+	srv, _ = testServer(t, http.StatusInternalServerError, "boom")
+	defer srv.Close()
+
+	_, err = testClient(srv).Invoke(NewElement("system-get-version"))
+	r.Error(err)
+	r.Contains(err.Error(), "500")
+	r.Zero(ErrNo(err))
+}
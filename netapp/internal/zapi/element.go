@@ -0,0 +1,220 @@
+package zapi
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// Element is the Go equivalent of the NetApp Manageability SDK NaElement,
+// e.g. a named XML node with optional content, attributes and children
+type Element struct {
+	Name     string
+	Content  string
+	Attrs    map[string]string
+	children []*Element
+}
+
+// NewElement creates a new element for the given ZAPI name
+func NewElement(name string) *Element {
+	return &Element{Name: name}
+}
+
+// AddChild appends child to the element children, returns the element
+func (e *Element) AddChild(child *Element) *Element {
+	e.children = append(e.children, child)
+	return e
+}
+
+// AddChildString appends a new child with name and content value
+func (e *Element) AddChildString(name string, value string) *Element {
+	return e.AddChild(&Element{Name: name, Content: value})
+}
+
+// AddChildBool appends a new child with name and ZAPI boolean content
+func (e *Element) AddChildBool(name string, value bool) *Element {
+	return e.AddChildString(name, strconv.FormatBool(value))
+}
+
+// AddChildStrings appends a new child list with name where
+// each value is added as a child named itemName
+func (e *Element) AddChildStrings(name, itemName string, values []string) *Element {
+	list := NewElement(name)
+	for _, value := range values {
+		list.AddChildString(itemName, value)
+	}
+
+	return e.AddChild(list)
+}
+
+// AddDesired appends empty children for the names, e.g. the
+// requested attributes of a desired-attributes element
+func (e *Element) AddDesired(names ...string) *Element {
+	for _, name := range names {
+		e.AddChild(NewElement(name))
+	}
+
+	return e
+}
+
+// Attr returns the attribute value for name, empty string if not set
+func (e *Element) Attr(name string) string {
+	if e == nil || e.Attrs == nil {
+		return ""
+	}
+
+	return e.Attrs[name]
+}
+
+// SetAttr sets the attribute name to value
+func (e *Element) SetAttr(name, value string) *Element {
+	if e.Attrs == nil {
+		e.Attrs = make(map[string]string)
+	}
+	e.Attrs[name] = value
+	return e
+}
+
+// Children returns all children of the element
+func (e *Element) Children() []*Element {
+	if e == nil {
+		return nil
+	}
+
+	return e.children
+}
+
+// Child returns the first child with name, nil if not present
+func (e *Element) Child(name string) *Element {
+	if e == nil {
+		return nil
+	}
+
+	for _, child := range e.children {
+		if child.Name == name {
+			return child
+		}
+	}
+
+	return nil
+}
+
+// ChildString returns the content of child with name, empty if not present
+func (e *Element) ChildString(name string) string {
+	child := e.Child(name)
+	if child == nil {
+		return ""
+	}
+
+	return child.Content
+}
+
+// ChildInt returns the child content as integer, as NaElement
+// based API commands do a missing or non-numeric value returns -1
+func (e *Element) ChildInt(name string) int {
+	value, err := strconv.Atoi(e.ChildString(name))
+	if err != nil || value < 0 {
+		return -1
+	}
+
+	return value
+}
+
+// ChildBool returns true if the child content is 'true'
+func (e *Element) ChildBool(name string) bool {
+	return e.ChildString(name) == "true"
+}
+
+// ChildContentList returns the content of all children of child name
+func (e *Element) ChildContentList(name string) []string {
+	values := []string{}
+	for _, item := range e.Child(name).Children() {
+		values = append(values, item.Content)
+	}
+
+	return values
+}
+
+// FirstOf returns the first child element of the child with name,
+// e.g. the first record of an 'attributes-list'
+func (e *Element) FirstOf(name string) *Element {
+	children := e.Child(name).Children()
+	if len(children) == 0 {
+		return nil
+	}
+
+	return children[0]
+}
+
+// String returns the XML representation of the element
+func (e *Element) String() string {
+	var buf bytes.Buffer
+	e.write(&buf)
+	return buf.String()
+}
+
+func (e *Element) write(buf *bytes.Buffer) {
+	buf.WriteString("<" + e.Name)
+	for name, value := range e.Attrs {
+		buf.WriteString(" " + name + "=\"")
+		xml.EscapeText(buf, []byte(value))
+		buf.WriteString("\"")
+	}
+	buf.WriteString(">")
+
+	xml.EscapeText(buf, []byte(e.Content))
+	for _, child := range e.children {
+		child.write(buf)
+	}
+
+	buf.WriteString("</" + e.Name + ">")
+}
+
+// ParseElement reads the first XML element from r including all children
+func ParseElement(r io.Reader) (*Element, error) {
+	decoder := xml.NewDecoder(r)
+
+	var stack []*Element
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil, fmt.Errorf("no complete XML element found")
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			elem := NewElement(t.Name.Local)
+			for _, attr := range t.Attr {
+				if attr.Name.Space == "xmlns" || attr.Name.Local == "xmlns" {
+					continue
+				}
+				elem.SetAttr(attr.Name.Local, attr.Value)
+			}
+
+			if len(stack) > 0 {
+				stack[len(stack)-1].AddChild(elem)
+			}
+			stack = append(stack, elem)
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].Content += string(t)
+			}
+		case xml.EndElement:
+			elem := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if len(elem.children) > 0 {
+				// mixed content is whitespace/formatting only
+				elem.Content = ""
+			}
+
+			if len(stack) == 0 {
+				return elem, nil
+			}
+		}
+	}
+}
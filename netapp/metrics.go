@@ -0,0 +1,62 @@
+package netapp
+
+import (
+	"log"
+	"path/filepath"
+	"sync"
+
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/pythonapi"
+)
+
+// metricsFiles are the names of the API call metrics files per format
+var metricsFiles = map[string]string{
+	pythonapi.MetricsFormatJSON:       "api_metrics.json",
+	pythonapi.MetricsFormatPrometheus: "api_metrics.prom",
+}
+
+// runMetrics are the API call metrics of all providers of this process,
+// reported on Shutdown
+var runMetrics = struct {
+	sync.Mutex
+	metrics *pythonapi.Metrics
+	// files are the metrics file paths of the providers and their format
+	files map[string]string
+}{
+	metrics: pythonapi.NewMetrics(),
+	files:   map[string]string{},
+}
+
+// metrics returns the middleware measuring the API calls of the provider
+// and registers its metrics file
+func (c *Config) metrics() pythonapi.Middleware {
+	runMetrics.Lock()
+	defer runMetrics.Unlock()
+
+	if c.MetricsFormat != "" {
+		path := filepath.Join(c.ApiPath, metricsFiles[c.MetricsFormat])
+		runMetrics.files[path] = c.MetricsFormat
+	}
+
+	return runMetrics.metrics.Middleware()
+}
+
+// reportMetrics logs the API call metrics of the run and writes the
+// metrics files
+func reportMetrics() {
+	runMetrics.Lock()
+	defer runMetrics.Unlock()
+
+	commands := runMetrics.metrics.Commands()
+	if len(commands) == 0 {
+		return
+	}
+
+	log.Printf("[INFO] NetApp API call metrics of the run:\n%s",
+		runMetrics.metrics.Summary())
+
+	for path, format := range runMetrics.files {
+		if err := runMetrics.metrics.WriteFile(path, format); err != nil {
+			log.Printf("[WARN] could not write API call metrics [%s], got: %s", path, err)
+		}
+	}
+}
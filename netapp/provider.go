@@ -1,13 +1,20 @@
 package netapp
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/cassette"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/pythonapi"
+
+	netappsys "github.com/jogam/terraform-provider-netapp/netapp/internal/helper/system"
 )
 
 // Provider returns a terraform.ResourceProvider
 func Provider() terraform.ResourceProvider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"user": &schema.Schema{
 				Type:        schema.TypeString,
@@ -18,9 +25,24 @@ func Provider() terraform.ResourceProvider {
 
 			"password": &schema.Schema{
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Sensitive:   true,
 				DefaultFunc: schema.EnvDefaultFunc("NETAPP_PASSWORD", nil),
-				Description: "The user password for NetApp ONTAP API.",
+				Description: "The user password for NetApp ONTAP API, required without client_cert_file.",
+			},
+
+			"client_cert_file": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETAPP_CLIENT_CERT_FILE", nil),
+				Description: "PEM client certificate authenticating the user instead of the password, requires client_key_file.",
+			},
+
+			"client_key_file": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETAPP_CLIENT_KEY_FILE", nil),
+				Description: "PEM private key of client_cert_file.",
 			},
 
 			"host": &schema.Schema{
@@ -30,18 +52,97 @@ func Provider() terraform.ResourceProvider {
 				Description: "The NetApp host FQDN/IP for NetApp ONTAP API.",
 			},
 
+			"transport": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETAPP_TRANSPORT", netappsys.TransportHTTPS),
+				Description: "The transport to the NetApp host, must be one of [HTTPS, HTTP] (Default: HTTPS).",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					switch val.(string) {
+					case netappsys.TransportHTTPS:
+						return
+					case netappsys.TransportHTTP:
+						warns = append(warns, fmt.Sprintf("%q HTTP sends the credentials unencrypted", key))
+						return
+					}
+
+					errs = append(errs, fmt.Errorf("%q must be one of [HTTPS, HTTP]", key))
+					return
+				},
+			},
+
+			"port": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETAPP_PORT", 0),
+				Description: "The port of the NetApp host, 0 uses the transport default 443/80 (Default: 0).",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					if v := val.(int); v < 0 || v > 65535 {
+						errs = append(errs, fmt.Errorf("%q must be within [0, 65535], got: %d", key, v))
+					}
+					return
+				},
+			},
+
+			"server_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETAPP_SERVER_TYPE", netappsys.ServerTypeFiler),
+				Description: "The NetApp host type, FILER for the cluster or VSERVER for a SVM management LIF (Default: FILER).",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					switch val.(string) {
+					case netappsys.ServerTypeFiler, netappsys.ServerTypeVserver:
+						return
+					}
+
+					errs = append(errs, fmt.Errorf("%q must be one of [FILER, VSERVER]", key))
+					return
+				},
+			},
+
+			"insecure": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETAPP_INSECURE", false),
+				Description: "Skip the verification of the NetApp host certificate, only for testing (Default: false).",
+			},
+
+			"ca_file": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETAPP_CA_FILE", nil),
+				Description: "PEM CA bundle to verify the NetApp host certificate instead of the system CAs.",
+			},
+
+			"api_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETAPP_API_TYPE", apiTypeNMSDK),
+				Description: "The NetApp API implementation, must be one of [nmsdk, zapi, rest] (Default: nmsdk).",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(string)
+					switch v {
+					case apiTypeNMSDK, apiTypeZAPI, apiTypeREST:
+						return
+					}
+
+					errs = append(errs, fmt.Errorf("%q must be one of [nmsdk, zapi, rest]", key))
+					return
+				},
+			},
+
 			"nmsdk_root_path": &schema.Schema{
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("NETAPP_MSDK_ROOT_PATH", nil),
-				Description: "The path to the NetApp Manageability SDK root folder",
+				Description: "The path to the NetApp Manageability SDK root folder, required for api_type nmsdk.",
 			},
 
 			"api_folder": &schema.Schema{
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("NETAPP_API_FOLDER", nil),
-				Description: "Path to folder where the NetApp api should be unpacked.",
+				Description: "Path to folder where the NetApp api should be unpacked, required for api_type nmsdk.",
 			},
 
 			"api_port": &schema.Schema{
@@ -58,24 +159,135 @@ func Provider() terraform.ResourceProvider {
 				Description: "Port on which the NetApp api client registry should be started (Default: 12342).",
 			},
 
-			// "ontap_version": &schema.Schema{
-			// 	Type:        schema.TypeString,
-			// 	Computed:    true,
-			// 	Description: "The ONTAP version installed on the NetApp host",
-			// },
-
-			// "os_version": &schema.Schema{
-			// 	Type:        schema.TypeString,
-			// 	Computed:    true,
-			// 	Description: "The system OS version installed on the NetApp host",
-			// },
-
-			// "allow_unverified_ssl": &schema.Schema {
-			// 	Type:        schema.TypeBool,
-			// 	Optional:    true,
-			// 	DefaultFunc: schema.EnvDefaultFunc("VSPHERE_ALLOW_UNVERIFIED_SSL", false),
-			// 	Description: "If set, VMware vSphere client will permit unverifiable SSL certificates.",
-			// },
+			"api_install_mode": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETAPP_API_INSTALL_MODE", installModeOnline),
+				Description: "How the api virtualenv packages are installed, must be one of [online, wheelhouse, bundled], wheelhouse installs offline from api_wheelhouse, bundled from the wheels folder next to the provider binary (Default: online).",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(string)
+					switch v {
+					case installModeOnline, installModeWheelhouse, installModeBundled:
+						return
+					}
+
+					errs = append(errs, fmt.Errorf("%q must be one of [online, wheelhouse, bundled]", key))
+					return
+				},
+			},
+
+			"api_wheelhouse": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETAPP_API_WHEELHOUSE", nil),
+				Description: "Path to a folder with the wheels of the api requirements, required for api_install_mode wheelhouse.",
+			},
+
+			"api_log_file": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETAPP_API_LOG_FILE", "python_api.log"),
+				Description: "File log of the NetApp api, relative to api_folder, applies when the provider starts the api (Default: python_api.log).",
+			},
+
+			"api_log_max_size": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETAPP_API_LOG_MAX_SIZE", 10),
+				Description: "Size in MB of the api_log_file before it is rotated, 3 rotated files are kept, 0 never rotates (Default: 10).",
+			},
+
+			"api_timeout": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETAPP_API_TIMEOUT", 300),
+				Description: "Timeout in seconds for a single API call, 0 disables the timeout (Default: 300).",
+			},
+
+			"api_command_timeouts": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Per command timeout in seconds overriding api_timeout, e.g. { \"SVM.CREATE\" = 900 }.",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+
+			"adopt_existing": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETAPP_ADOPT_EXISTING", false),
+				Description: "Adopt objects which already exist on create instead of failing, e.g. left over by an interrupted apply (Default: false).",
+			},
+
+			"max_retries": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETAPP_MAX_RETRIES", 5),
+				Description: "Retries of API calls failed with a transient error, e.g. busy or another operation in progress, 0 disables retries (Default: 5).",
+			},
+
+			"retry_max_wait": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETAPP_RETRY_MAX_WAIT", 30),
+				Description: "Maximum wait in seconds between the retries of an API call, the wait doubles per retry (Default: 30).",
+			},
+
+			"max_concurrent_calls": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETAPP_MAX_CONCURRENT_CALLS", 0),
+				Description: "Maximum API calls running concurrently, further calls are queued, 0 does not limit the calls. Broadcast domain, IPspace, VLAN, port and interface group changes of the cluster are always made one after the other, a broadcast domain change including its port updates, as are subnet changes (Default: 0).",
+			},
+
+			"api_read_cache_ttl": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETAPP_API_READ_CACHE_TTL", 0),
+				Description: "Seconds to cache the IPspace, aggregate, node and broadcast domain reads, invalidated by the changes of the provider, 0 disables the cache (Default: 0).",
+			},
+
+			"api_metrics_format": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETAPP_API_METRICS_FORMAT", ""),
+				Description: "Write the per command API call metrics to api_folder at provider shutdown, must be one of [json, prometheus], the summary is always logged.",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(string)
+					switch v {
+					case "", pythonapi.MetricsFormatJSON, pythonapi.MetricsFormatPrometheus:
+						return
+					}
+
+					errs = append(errs, fmt.Errorf("%q must be one of [json, prometheus]", key))
+					return
+				},
+			},
+
+			"cassette_mode": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETAPP_CASSETTE_MODE", ""),
+				Description: "Record all API calls to or replay them from cassette_file, must be one of [record, replay].",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					v := val.(string)
+					switch v {
+					case "", cassette.ModeRecord, cassette.ModeReplay:
+						return
+					}
+
+					errs = append(errs, fmt.Errorf("%q must be one of [record, replay]", key))
+					return
+				},
+			},
+
+			"cassette_file": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETAPP_CASSETTE_FILE", nil),
+				Description: "Path to the cassette file with the recorded API calls, secrets are redacted.",
+			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -92,17 +304,21 @@ func Provider() terraform.ResourceProvider {
 			"netapp_node": dataSourceNetAppNode(),
 			"netapp_aggr": dataSourceNetAppAggr(),
 		},
+	}
 
-		ConfigureFunc: configureProvider,
+	// the stop context is cancelled on Terraform interrupt (Ctrl-C)
+	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		return configureProvider(provider.StopContext(), d)
 	}
+
+	return provider
 }
 
-func configureProvider(d *schema.ResourceData) (interface{}, error) {
+func configureProvider(stopCtx context.Context, d *schema.ResourceData) (interface{}, error) {
 	c, err := NewConfig(d)
 	if err != nil {
 		return nil, err
 	}
 
-	// need to pass resource data to client for computed ONTAP/OS
-	return c.Client(d)
+	return c.Client(stopCtx)
 }
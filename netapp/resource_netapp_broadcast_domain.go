@@ -1,15 +1,22 @@
 package netapp
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"sort"
 	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	netappnw "github.com/jogam/terraform-provider-netapp/netapp/internal/helper/network"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/pythonapi"
 )
 
+// bcDomainPortUpdateTimeout is the default wait for the port updates of a
+// broadcast domain change, other port changes stay locked while waiting
+const bcDomainPortUpdateTimeout = 5 * time.Minute
+
 func resourceNetAppBroadcastDomain() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -86,6 +93,16 @@ func resourceNetAppBroadcastDomain() *schema.Resource {
 		Update: resourceNetAppBroadcastDomainUpdate,
 		Delete: resourceNetAppBroadcastDomainDelete,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(bcDomainPortUpdateTimeout),
+			Update: schema.DefaultTimeout(bcDomainPortUpdateTimeout),
+			Delete: schema.DefaultTimeout(bcDomainPortUpdateTimeout),
+		},
+
+		CustomizeDiff: customizeDiffCapabilities("netapp_broadcastdomain",
+			attributeCapability{capability: capBroadcastDomain},
+			attributeCapability{attribute: "mtu", capability: capBcDomainMtuUpdate, updateOnly: true}),
+
 		// as per: https://www.terraform.io/docs/extend/resources.html#importers
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -94,7 +111,12 @@ func resourceNetAppBroadcastDomain() *schema.Resource {
 }
 
 func resourceNetAppBroadcastDomainCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*NetAppClient).api
+	// the port updates of the changes are waited for before other port
+	// changes start
+	client, release := pythonapi.Serialized(meta.(*NetAppClient).api)
+	defer release()
+	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
 
 	name := d.Get("name").(string)
 	req := &netappnw.BcDomainRequest{Name: name}
@@ -133,10 +155,20 @@ func resourceNetAppBroadcastDomainCreate(d *schema.ResourceData, meta interface{
 	}
 
 	bcInfo, err := netappnw.BcDomainCreate(client, req)
+	if err != nil {
+		err = adoptExisting(meta, fmt.Sprintf("broadcast domain [%s]", name), name, err)
+		if err != nil {
+			return err
+		}
+
+		// differences to the configuration show up with the next plan
+		d.SetId(name)
+		return resourceNetAppBroadcastDomainRead(d, meta)
+	}
 
 	portStatus := bcInfo.PortUpdateStatus
 	if portStatus == "in_progress" {
-		portStatus, err = netappnw.BcDomainWaitForInProgressDone(client, name)
+		portStatus, err = netappnw.BcDomainWaitForInProgressDone(ctx, client, name)
 		if err != nil {
 			return fmt.Errorf("create wait finished caused: %s", err)
 		}
@@ -156,6 +188,9 @@ func resourceNetAppBroadcastDomainRead(d *schema.ResourceData, meta interface{})
 
 	name := d.Id()
 	bcInfo, err := netappnw.BcDomainGet(client, name)
+	if readGone(d, err) {
+		return nil
+	}
 	if err != nil {
 		return err
 	}
@@ -187,18 +222,23 @@ func resourceNetAppBroadcastDomainRead(d *schema.ResourceData, meta interface{})
 
 	if len(bcInfo.Ports) > 0 {
 		var pqNames []string
+		var completeNames []string
 		for _, pInfo := range bcInfo.Ports {
 			if pInfo.UpdateStatus != "complete" {
 				pqNames = append(pqNames,
 					pInfo.Name+" --> ["+pInfo.UpdateStatus+"]:"+pInfo.StatusDetail)
 			} else {
-				resID, err := getResourceIDfromNetQualifiedName(client, pInfo.Name)
-				if err != nil {
-					log.Printf("[WARN] could not get resource ID, got: %s", err)
-					pqNames = append(pqNames, pInfo.Name)
-				} else {
-					pqNames = append(pqNames, resID)
-				}
+				completeNames = append(completeNames, pInfo.Name)
+			}
+		}
+
+		resIDs, errs := getResourceIDsfromNetQualifiedNames(client, completeNames)
+		for idx, nqName := range completeNames {
+			if errs[idx] != nil {
+				log.Printf("[WARN] could not get resource ID, got: %s", errs[idx])
+				pqNames = append(pqNames, nqName)
+			} else {
+				pqNames = append(pqNames, resIDs[idx])
 			}
 		}
 
@@ -231,7 +271,12 @@ func resourceNetAppBroadcastDomainRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceNetAppBroadcastDomainUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*NetAppClient).api
+	// the port updates of the changes are waited for before other port
+	// changes start
+	client, release := pythonapi.Serialized(meta.(*NetAppClient).api)
+	defer release()
+	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
 
 	// Enable partial state mode
 	d.Partial(true)
@@ -299,7 +344,7 @@ func resourceNetAppBroadcastDomainUpdate(d *schema.ResourceData, meta interface{
 
 			portStatus := bcInfo.PortUpdateStatus
 			if portStatus == "in_progress" {
-				portStatus, err = netappnw.BcDomainWaitForInProgressDone(client, name)
+				portStatus, err = netappnw.BcDomainWaitForInProgressDone(ctx, client, name)
 				if err != nil {
 					return fmt.Errorf("remove port wait finished caused: %s", err)
 				}
@@ -343,7 +388,7 @@ func resourceNetAppBroadcastDomainUpdate(d *schema.ResourceData, meta interface{
 
 			portStatus := bcInfo.PortUpdateStatus
 			if portStatus == "in_progress" {
-				portStatus, err = netappnw.BcDomainWaitForInProgressDone(client, name)
+				portStatus, err = netappnw.BcDomainWaitForInProgressDone(ctx, client, name)
 				if err != nil {
 					return fmt.Errorf("add port wait finished caused: %s", err)
 				}
@@ -376,7 +421,7 @@ func resourceNetAppBroadcastDomainUpdate(d *schema.ResourceData, meta interface{
 
 		portStatus := bcInfo.PortUpdateStatus
 		if portStatus == "in_progress" {
-			portStatus, err = netappnw.BcDomainWaitForInProgressDone(client, name)
+			portStatus, err = netappnw.BcDomainWaitForInProgressDone(ctx, client, name)
 			if err != nil {
 				return fmt.Errorf("general param update wait for caused: %s", err)
 			}
@@ -398,7 +443,12 @@ func resourceNetAppBroadcastDomainUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceNetAppBroadcastDomainDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*NetAppClient).api
+	// the port updates of the changes are waited for before other port
+	// changes start
+	client, release := pythonapi.Serialized(meta.(*NetAppClient).api)
+	defer release()
+	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
 	name := d.Get("name").(string)
 
 	ipSpace := d.Get("ipspace").(string)
@@ -414,7 +464,7 @@ func resourceNetAppBroadcastDomainDelete(d *schema.ResourceData, meta interface{
 
 	portStatus := bcInfo.PortUpdateStatus
 	if portStatus == "in_progress" {
-		portStatus, err = netappnw.BcDomainWaitForInProgressDone(client, name)
+		portStatus, err = netappnw.BcDomainWaitForInProgressDone(ctx, client, name)
 		if err != nil {
 			return fmt.Errorf("delete wait for caused: %s", err)
 		}
@@ -0,0 +1,174 @@
+package netapp
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	netappnw "github.com/jogam/terraform-provider-netapp/netapp/internal/helper/network"
+)
+
+// testBroadcastDomainConfig is a broadcast domain in the ipspace ips of
+// testIPSpaceConfig with the port IDs or references, e.g. to a VLAN
+func testBroadcastDomainConfig(resName, name string, mtu int, ports ...string) string {
+	quoted := make([]string, len(ports))
+	for idx, port := range ports {
+		quoted[idx] = fmt.Sprintf("%q", port)
+	}
+
+	mtuArg := ""
+	if mtu > 0 {
+		mtuArg = fmt.Sprintf("mtu     = %d", mtu)
+	}
+
+	return fmt.Sprintf(`
+resource "netapp_broadcastdomain" %q {
+  name    = %q
+  ipspace = "${netapp_ipspace.ips.id}"
+  ports   = [%s]
+  %s
+}
+`, resName, name, strings.Join(quoted, ", "), mtuArg)
+}
+
+func TestResourceNetAppBroadcastDomain(t *testing.T) {
+	tc := newTestCluster(t)
+	vlan := testIPSpaceConfig("ips1") + testVlanConfig(tc.portIDs["e0d"], 200)
+	vlanRef := "${netapp_vlan.vlan.id}"
+	bcd1 := vlan + testBroadcastDomainConfig("bcd", "bcd1", 0, tc.portIDs["e0c"], vlanRef)
+	renamed := testConfig(
+		vlan + testBroadcastDomainConfig("bcd", "bcd1-renamed", 1500, vlanRef, tc.portIDs["e0e"]))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: tc.providerFactories(),
+		CheckDestroy:      tc.checkDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(bcd1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp_broadcastdomain.bcd", "status_ipspace", "ips1"),
+					resource.TestCheckResourceAttr("netapp_broadcastdomain.bcd", "status_port_update", "complete"),
+					resource.TestCheckResourceAttr("netapp_broadcastdomain.bcd", "ports.#", "2"),
+				),
+			},
+			{
+				// a port can only belong to one broadcast domain
+				Config: testConfig(
+					bcd1 + testBroadcastDomainConfig("bcd2", "bcd2", 0, tc.portIDs["e0c"])),
+				ExpectError: testExpectError("already member of broadcast domain [bcd1]"),
+			},
+			{
+				// rename, swap e0c for e0e and increase the MTU of the
+				// remaining VLAN beyond its parent port MTU, which must fail
+				Config: testConfig(
+					vlan + testBroadcastDomainConfig("bcd", "bcd1-renamed", 9000, vlanRef, tc.portIDs["e0e"])),
+				ExpectError: testExpectError("exceeds MTU [1500] of parent port [e0d]"),
+			},
+			{
+				// rename and port changes are kept in the partial state,
+				// keeping the MTU results in nothing left to do
+				Config:   renamed,
+				PlanOnly: true,
+			},
+			{
+				Config:            renamed,
+				ResourceName:      "netapp_broadcastdomain.bcd",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 || states[0].ID != "bcd1-renamed" {
+						return fmt.Errorf("expected ID to follow rename, got: %v", states)
+					}
+					return nil
+				},
+			},
+			{
+				// the VLAN can not be deleted while in the broadcast domain
+				Config: testConfig(testIPSpaceConfig("ips1") + testBroadcastDomainConfig(
+					"bcd", "bcd1-renamed", 1500, "node1|e0d|200", tc.portIDs["e0e"])),
+				ExpectError: testExpectError("is in broadcast domain [bcd1-renamed]"),
+			},
+			{
+				Config: renamed,
+			},
+		},
+	})
+}
+
+func TestResourceNetAppBroadcastDomainTimeout(t *testing.T) {
+	tc := newTestCluster(t)
+	cfg := testConfig(testIPSpaceConfig("ips1") + fmt.Sprintf(`
+resource "netapp_broadcastdomain" "bcd" {
+  name    = "bcd1"
+  ipspace = "${netapp_ipspace.ips.id}"
+  ports   = [%q]
+
+  timeouts {
+    create = "1s"
+  }
+}
+`, tc.portIDs["e0c"]))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: tc.providerFactories(),
+		CheckDestroy:      tc.checkDestroyed,
+		Steps: []resource.TestStep{
+			{
+				// the port update never finishes, the wait ends with the
+				// create timeout and releases the port lock
+				PreConfig: func() {
+					tc.JobPolls = math.MaxInt32
+				},
+				Config:      cfg,
+				ExpectError: testExpectError("broadcast domain [bcd1] port update wait ended"),
+			},
+			{
+				// the next port changes are not blocked, e.g. after the
+				// left over domain was removed
+				PreConfig: func() {
+					_, err := netappnw.BcDomainDelete(tc.client(t).api, "bcd1", "ips1")
+					if err != nil {
+						t.Fatalf("broadcast domain delete failed: %s", err)
+					}
+					tc.JobPolls = 0
+				},
+				Config: cfg,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp_broadcastdomain.bcd", "id", "bcd1"),
+					resource.TestCheckResourceAttr("netapp_broadcastdomain.bcd", "status_port_update", "complete"),
+					tc.checkCommandCount("NW.BRCDOM.CREATE", 2),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceNetAppBroadcastDomainUpdateReplay(t *testing.T) {
+	tc := newCassetteCluster(t, "broadcast_domain_update")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: tc.providerFactories(),
+		CheckDestroy:      tc.checkDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(testIPSpaceConfig("ips1") +
+					testBroadcastDomainConfig("bcd", "bcd1", 0, tc.portIDs["e0c"])),
+				Check: resource.TestCheckResourceAttr("netapp_broadcastdomain.bcd", "id", "bcd1"),
+			},
+			{
+				// rename, add port and change MTU in a single update
+				Config: testConfig(testIPSpaceConfig("ips1") + testBroadcastDomainConfig(
+					"bcd", "bcd2", 9000, tc.portIDs["e0c"], tc.portIDs["e0d"])),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp_broadcastdomain.bcd", "id", "bcd2"),
+					resource.TestCheckResourceAttr("netapp_broadcastdomain.bcd", "mtu", "9000"),
+					resource.TestCheckResourceAttr("netapp_broadcastdomain.bcd", "ports.#", "2"),
+					resource.TestCheckResourceAttr("netapp_broadcastdomain.bcd", "status_port_update", "complete"),
+				),
+			},
+		},
+	})
+}
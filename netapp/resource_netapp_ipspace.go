@@ -1,10 +1,12 @@
 package netapp
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	netappnw "github.com/jogam/terraform-provider-netapp/netapp/internal/helper/network"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/pythonapi"
 )
 
 func resourceNetAppIPSpace() *schema.Resource {
@@ -60,6 +62,9 @@ func resourceNetAppIPSpace() *schema.Resource {
 		Update: resourceNetAppIPSpaceUpdate,
 		Delete: resourceNetAppIPSpaceDelete,
 
+		CustomizeDiff: customizeDiffCapabilities("netapp_ipspace",
+			attributeCapability{capability: capIPSpace}),
+
 		// as per: https://www.terraform.io/docs/extend/resources.html#importers
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -72,6 +77,15 @@ func resourceNetAppIPSpaceCreate(d *schema.ResourceData, meta interface{}) error
 
 	name := d.Get("name").(string)
 	uuid, err := netappnw.IPSpaceCreate(client, name)
+	if errors.Is(err, pythonapi.ErrAlreadyExists) {
+		ipSpaceInfo, getErr := netappnw.IPSpaceGetByName(client, name)
+		if getErr != nil {
+			return fmt.Errorf("could not get existing IPSpace [%s], got: %s", name, getErr)
+		}
+
+		uuid = ipSpaceInfo.UUID
+		err = adoptExisting(meta, fmt.Sprintf("IPSpace [%s]", name), uuid, err)
+	}
 	if err != nil {
 		return err
 	}
@@ -87,6 +101,9 @@ func resourceNetAppIPSpaceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*NetAppClient).api
 
 	ipSpaceInfo, err := netappnw.IPSpaceGetByUUID(client, d.Id())
+	if readGone(d, err) {
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("IPSpace [%s] read error, got: %s", d.Id(), err)
 	}
@@ -0,0 +1,121 @@
+package netapp
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	netappnw "github.com/jogam/terraform-provider-netapp/netapp/internal/helper/network"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/pythonapi"
+)
+
+// testIPSpaceConfig is an ipspace resource named ips
+func testIPSpaceConfig(name string) string {
+	return `
+resource "netapp_ipspace" "ips" {
+  name = "` + name + `"
+}
+`
+}
+
+func TestResourceNetAppIPSpace(t *testing.T) {
+	tc := newTestCluster(t)
+	var id string
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: tc.providerFactories(),
+		CheckDestroy:      tc.checkDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(testIPSpaceConfig("ips1")),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceID("netapp_ipspace.ips", &id),
+					resource.TestCheckResourceAttrPair(
+						"netapp_ipspace.ips", "uuid", "netapp_ipspace.ips", "id"),
+				),
+			},
+			{
+				// rename keeps the ipspace UUID
+				Config: testConfig(testIPSpaceConfig("ips2")),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceID("netapp_ipspace.ips", &id),
+					resource.TestCheckResourceAttr("netapp_ipspace.ips", "name", "ips2"),
+				),
+			},
+			testImportStep(testConfig(testIPSpaceConfig("ips2")), "netapp_ipspace.ips"),
+		},
+	})
+}
+
+func TestResourceNetAppIPSpaceGone(t *testing.T) {
+	tc := newTestCluster(t)
+	cfg := testConfig(testIPSpaceConfig("ips1"))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: tc.providerFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg,
+			},
+			{
+				// the not-found error of the read removes the ipspace from
+				// the state, the plan creates it again
+				PreConfig: func() {
+					tc.Faults = map[string]int{"NW.IPSPACE.GET": pythonapi.ErrnoObjectNotFound}
+				},
+				Config:             cfg,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+
+	tc.Faults = nil
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: tc.providerFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(testIPSpaceConfig("ips2")),
+			},
+			{
+				// any other error fails the refresh
+				PreConfig: func() {
+					tc.Faults = map[string]int{"NW.IPSPACE.GET": pythonapi.ErrnoBusy}
+				},
+				Config:      testConfig(testIPSpaceConfig("ips2")),
+				PlanOnly:    true,
+				ExpectError: testExpectError("Error refreshing"),
+			},
+		},
+	})
+}
+
+func TestResourceNetAppIPSpaceAdopt(t *testing.T) {
+	tc := newTestCluster(t)
+	cfg := testIPSpaceConfig("ips1")
+
+	// e.g. left over by an interrupted apply
+	existing, err := netappnw.IPSpaceCreate(tc.client(t).api, "ips1")
+	if err != nil {
+		t.Fatalf("ipspace create failed: %s", err)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: tc.providerFactories(),
+		CheckDestroy:      tc.checkDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(cfg),
+				ExpectError: testExpectError(
+					"IPSpace [ips1] already exists, import via cmd: terraform import $RESNAME$ '" +
+						existing + "'"),
+			},
+			{
+				Config: testConfig(cfg, "adopt_existing = true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp_ipspace.ips", "id", existing),
+					tc.checkCommandCount("NW.IPSPACE.CREATE", 1),
+				),
+			},
+		},
+	})
+}
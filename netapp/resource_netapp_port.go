@@ -98,6 +98,9 @@ func resourceNetAppPort() *schema.Resource {
 		Read:   resourceNetAppPortRead,
 		Update: resourceNetAppPortUpdate,
 		Delete: resourceNetAppPortDelete,
+
+		CustomizeDiff: customizeDiffCapabilities("netapp_port",
+			attributeCapability{attribute: "role", capability: capPortRole}),
 	}
 }
 
@@ -119,6 +122,9 @@ func resourceNetAppPortRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	pInfo, err := netappsys.PortGetByNames(client, nodeInfo.Name, portName)
+	if readGone(d, err) {
+		return nil
+	}
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,69 @@
+package netapp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// testPortConfig is the port resource port of the physical port nic
+func testPortConfig(nodeID, nic string, mtu int) string {
+	return fmt.Sprintf(`
+resource "netapp_port" "port" {
+  node_id          = %q
+  nic_name         = %q
+  admin_up         = true
+  admin_mtu        = %d
+  autorevert_delay = 30
+}
+`, nodeID, nic, mtu)
+}
+
+func TestResourceNetAppPort(t *testing.T) {
+	tc := newTestCluster(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: tc.providerFactories(),
+		CheckDestroy: func(s *terraform.State) error {
+			// physical ports are not deleted, the resource is only forgotten
+			rs := s.RootModule().Resources["netapp_port.port"]
+			state, err := resourceNetAppPort().Refresh(rs.Primary, &NetAppClient{api: tc.backend})
+			if err != nil || state == nil {
+				return fmt.Errorf("expected port to exist after destroy, got: %v", err)
+			}
+
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(testPortConfig(tc.nodeID, "e0c", 9000)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp_port.port", "id", tc.portIDs["e0c"]),
+					resource.TestCheckResourceAttr("netapp_port.port", "status_mtu", "9000"),
+					resource.TestCheckResourceAttr("netapp_port.port", "status_autorevert_delay", "30"),
+					resource.TestCheckResourceAttr("netapp_port.port", "status", "up"),
+				),
+			},
+			{
+				Config: testConfig(testPortConfig(tc.nodeID, "e0c", 1500)),
+				Check:  resource.TestCheckResourceAttr("netapp_port.port", "status_mtu", "1500"),
+			},
+		},
+	})
+}
+
+func TestResourceNetAppPortMissing(t *testing.T) {
+	tc := newTestCluster(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: tc.providerFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testConfig(testPortConfig(tc.nodeID, "e9z", 1500)),
+				ExpectError: testExpectError("port [node1:e9z] does not exist"),
+			},
+		},
+	})
+}
@@ -128,6 +128,9 @@ func resourceNetAppPortGroupCreate(d *schema.ResourceData, meta interface{}) err
 	// determine group name following pattern: a[0..999][a-z], e.g. a0a ... a999z
 	// use a0t .. a999t and search via port pattern a*t in net-port-get-iter
 	pfRes, err := netappsys.PortFindByNamePattern(client, nodeInfo.Name, "a*t")
+	if err != nil {
+		return err
+	}
 	// create mapping of existing port names
 	portNameExists := map[string]bool{}
 	for _, pName := range pfRes.Names {
@@ -188,6 +191,9 @@ func resourceNetAppPortGroupRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("node_id", nInfo.UUID)
 
 	pgInfo, err := netappsys.PortGroupGetByNames(client, nodeName, portName)
+	if readGone(d, err) {
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("no PortGroup for Node/Name [%s/%s], got: %s",
 			nodeName, portName, err)
@@ -201,16 +207,21 @@ func resourceNetAppPortGroupRead(d *schema.ResourceData, meta interface{}) error
 	// get port ID's for all ports configured in group
 	var groupPortIds []string
 	portNameDataMap := map[string]string{}
+	var portRequests []*netappsys.PortGetRequest
 	for _, pN := range pgInfo.Ports {
-		pInfo, err := netappsys.PortGetByNames(client, nodeName, pN)
-		if err != nil {
+		portRequests = append(portRequests,
+			&netappsys.PortGetRequest{NodeName: nodeName, PortName: pN})
+	}
+	pInfos, errs := netappsys.PortGetBatch(client, portRequests)
+	for idx, pN := range pgInfo.Ports {
+		if err := errs[idx]; err != nil {
 			return fmt.Errorf(
 				"get port ID's --> no port for "+
 					"Node/Name [%s/%s], got: %s",
 				nodeName, pN, err)
 		}
 
-		pID := createPortID(pInfo)
+		pID := createPortID(pInfos[idx])
 		portNameDataMap[pN] = pID
 		groupPortIds = append(groupPortIds, pID)
 	}
@@ -0,0 +1,95 @@
+package netapp
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	netappnw "github.com/jogam/terraform-provider-netapp/netapp/internal/helper/network"
+)
+
+// testPortGroupConfig is a LACP port group of the ports
+func testPortGroupConfig(resName, nodeID string, ports ...string) string {
+	quoted := make([]string, len(ports))
+	for idx, port := range ports {
+		quoted[idx] = fmt.Sprintf("%q", port)
+	}
+
+	return fmt.Sprintf(`
+resource "netapp_portgroup" %q {
+  node_id           = %q
+  mode              = "multimode_lacp"
+  load_distribution = "port"
+  admin_up          = true
+  ports             = [%s]
+}
+`, resName, nodeID, strings.Join(quoted, ", "))
+}
+
+func TestResourceNetAppPortGroup(t *testing.T) {
+	tc := newTestCluster(t)
+	group := testPortGroupConfig("group", tc.nodeID, tc.portIDs["e0c"], tc.portIDs["e0d"])
+	moved := testConfig(
+		testPortGroupConfig("group", tc.nodeID, tc.portIDs["e0d"], tc.portIDs["e0e"]))
+
+	// a VLAN on the port group created outside of terraform
+	vlan := &netappnw.VlanRequest{NodeName: testNodeName, ParentName: "a0t", VlanID: "100"}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: tc.providerFactories(),
+		CheckDestroy:      tc.checkDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(group),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"netapp_portgroup.group", "id", regexp.MustCompile(`^node1\|a0t\|`)),
+					resource.TestCheckResourceAttr("netapp_portgroup.group", "name", "a0t"),
+					resource.TestCheckResourceAttr("netapp_portgroup.group", "status_group_link", "full"),
+					resource.TestCheckResourceAttr("netapp_portgroup.group", "status_ports_active.#", "2"),
+				),
+			},
+			{
+				// a second group gets the next free name but can not take
+				// the ports
+				Config: testConfig(group +
+					testPortGroupConfig("second", tc.nodeID, tc.portIDs["e0c"], tc.portIDs["e0d"])),
+				ExpectError: testExpectError("already member of port group [a0t]"),
+			},
+			{
+				// group members can not host VLANs
+				Config:      testConfig(group + testVlanConfig(tc.portIDs["e0c"], 100)),
+				ExpectError: testExpectError("parent port [e0c] is member of port group [a0t]"),
+			},
+			{
+				Config: moved,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp_portgroup.group", "ports.0", tc.portIDs["e0d"]),
+					resource.TestCheckResourceAttr("netapp_portgroup.group", "ports.1", tc.portIDs["e0e"]),
+				),
+			},
+			// the admin settings are in the status of the imported group
+			testImportStep(moved, "netapp_portgroup.group", "admin_up", "admin_mtu"),
+			{
+				// a VLAN on the port group keeps it from being deleted
+				PreConfig: func() {
+					if err := netappnw.VlanCreate(tc.backend, vlan); err != nil {
+						t.Fatalf("vlan create failed: %s", err)
+					}
+				},
+				Config:      testConfig(""),
+				ExpectError: testExpectError("port group [a0t] is hosting VLANs"),
+			},
+			{
+				PreConfig: func() {
+					if err := netappnw.VlanDelete(tc.backend, vlan); err != nil {
+						t.Fatalf("vlan delete failed: %s", err)
+					}
+				},
+				Config: moved,
+			},
+		},
+	})
+}
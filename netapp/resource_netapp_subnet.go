@@ -95,6 +95,9 @@ func resourceNetAppSubnet() *schema.Resource {
 		Update: resourceNetAppSubnetUpdate,
 		Delete: resourceNetAppSubnetDelete,
 
+		CustomizeDiff: customizeDiffCapabilities("netapp_subnet",
+			attributeCapability{capability: capSubnet}),
+
 		// as per: https://www.terraform.io/docs/extend/resources.html#importers
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -167,7 +170,18 @@ func resourceNetAppSubnetCreate(d *schema.ResourceData, meta interface{}) error
 
 	sNInfo, err := netappnw.SubnetCreate(client, request)
 	if err != nil {
-		return err
+		snID := createSubnetID(&netappnw.SubnetInfo{
+			SubnetRequest: netappnw.SubnetRequest{
+				Name: request.Name, BroadCastDomain: request.BroadCastDomain,
+				IPSpace: request.IPSpace}})
+		err = adoptExisting(meta, fmt.Sprintf("subnet [%s]", request.Name), snID, err)
+		if err != nil {
+			return err
+		}
+
+		// differences to the configuration show up with the next plan
+		d.SetId(snID)
+		return resourceNetAppSubnetRead(d, meta)
 	}
 
 	return writeSubnetInfoToMeta(sNInfo, d)
@@ -182,6 +196,9 @@ func resourceNetAppSubnetRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	sNInfo, err := netappnw.SubnetGet(client, request)
+	if readGone(d, err) {
+		return nil
+	}
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,68 @@
+package netapp
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// testSubnetConfig is the subnet resource sn in the broadcast domain
+func testSubnetConfig(name, bcd, subnet, gateway string, ranges ...string) string {
+	quoted := make([]string, len(ranges))
+	for idx, ipRange := range ranges {
+		quoted[idx] = fmt.Sprintf("%q", ipRange)
+	}
+
+	return fmt.Sprintf(`
+resource "netapp_subnet" "sn" {
+  name             = %q
+  broadcast_domain = %q
+  subnet           = %q
+  gateway          = %q
+  ip_ranges        = [%s]
+}
+`, name, bcd, subnet, gateway, strings.Join(quoted, ", "))
+}
+
+func TestResourceNetAppSubnet(t *testing.T) {
+	tc := newTestCluster(t)
+	bcd := testIPSpaceConfig("ips1") +
+		testBroadcastDomainConfig("bcd", "bcd1", 0, tc.portIDs["e0c"])
+	bcdRef := "${netapp_broadcastdomain.bcd.id}"
+
+	// move to a new network: rename, replace ranges and gateway
+	moved := testConfig(bcd + testSubnetConfig(
+		"sn2", bcdRef, "10.0.1.0/24", "10.0.1.1", "10.0.1.10-10.0.1.11"))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: tc.providerFactories(),
+		CheckDestroy:      tc.checkDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(bcd + testSubnetConfig(
+					"sn1", bcdRef, "10.0.0.0/24", "10.0.0.1", "10.0.0.10-10.0.0.19", "10.0.0.5")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp_subnet.sn", "id", "bcd1|ips1|sn1"),
+					resource.TestCheckResourceAttr("netapp_subnet.sn", "stat_ip_total", "11"),
+				),
+			},
+			{
+				// the broadcast domain can not be deleted while it has subnets
+				Config: testConfig(testIPSpaceConfig("ips1") +
+					testSubnetConfig(
+						"sn1", "bcd1", "10.0.0.0/24", "10.0.0.1", "10.0.0.10-10.0.0.19", "10.0.0.5")),
+				ExpectError: testExpectError("broadcast domain [bcd1] is used by subnet [sn1]"),
+			},
+			{
+				Config: moved,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp_subnet.sn", "id", "bcd1|ips1|sn2"),
+					resource.TestCheckResourceAttr("netapp_subnet.sn", "stat_ip_total", "2"),
+				),
+			},
+			testImportStep(moved, "netapp_subnet.sn"),
+		},
+	})
+}
@@ -1,17 +1,25 @@
 package netapp
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"log"
 	"reflect"
+	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	netappnw "github.com/jogam/terraform-provider-netapp/netapp/internal/helper/network"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/pythonapi"
 	netappsvm "github.com/jogam/terraform-provider-netapp/netapp/internal/helper/svm"
 	netappsys "github.com/jogam/terraform-provider-netapp/netapp/internal/helper/system"
 )
 
+// svmJobTimeout is the default wait for the SVM create/delete job
+const svmJobTimeout = 10 * time.Minute
+
 func resourceNetAppSVM() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -149,6 +157,11 @@ func resourceNetAppSVM() *schema.Resource {
 		Update: resourceNetAppSVMUpdate,
 		Delete: resourceNetAppSVMDelete,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(svmJobTimeout),
+			Delete: schema.DefaultTimeout(svmJobTimeout),
+		},
+
 		// as per: https://www.terraform.io/docs/extend/resources.html#importers
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -156,14 +169,24 @@ func resourceNetAppSVM() *schema.Resource {
 	}
 }
 
-func resolveSvmJob(client *NetAppClient, jobRes *netappsvm.JobResult, cmdType string) error {
+func resolveSvmJob(
+	client *NetAppClient, jobRes *netappsvm.JobResult,
+	cmdType string, timeout time.Duration) error {
 	// transfer error / status since job and svm create/delete status are different
 	errCode := jobRes.ErrNo
 	errMsg := jobRes.ErrMsg
 	success := (jobRes.Status == "succeeded")
 	if jobRes.Status == "in_progress" {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
 		// status in progress wait for job to 'end'
-		jInfo, err := netappsys.JobWaitDone(client.api, jobRes.JobID)
+		jInfo, err := netappsys.JobWatch(
+			ctx, client.api, jobRes.JobID, func(jInfo *netappsys.JobInfo) {
+				log.Printf(
+					"[INFO] SVM %s job [%d] %s: %s",
+					cmdType, jInfo.ID, jInfo.Status, jInfo.Progress)
+			})
 		if err != nil {
 			return fmt.Errorf("SVM %s job wait error: %s", cmdType, err)
 		}
@@ -216,12 +239,29 @@ func resourceNetAppSVMCreate(d *schema.ResourceData, meta interface{}) error {
 
 	// create the SVM
 	svmJobRes, err := netappsvm.Create(client, request)
+	if errors.Is(err, pythonapi.ErrAlreadyExists) {
+		// duplicate SVM, adopt it or hint at import feature
+		svmInfo, getErr := netappsvm.GetByName(client, request.Name)
+		if getErr != nil {
+			return fmt.Errorf("could not get existing SVM [%s], got: %s", request.Name, getErr)
+		}
+
+		err = adoptExisting(meta, fmt.Sprintf("SVM [%s]", request.Name), svmInfo.UUID, err)
+		if err != nil {
+			return err
+		}
+
+		// the adopted SVM is configured like a newly created one
+		d.SetId(svmInfo.UUID)
+		return resourceNetAppSVMUpdate(d, meta)
+	}
 	if err != nil {
 		return fmt.Errorf("SVM create error: %s", err)
 	}
 
 	// wait for job to complete and process data
-	err = resolveSvmJob(meta.(*NetAppClient), svmJobRes, "create")
+	err = resolveSvmJob(
+		meta.(*NetAppClient), svmJobRes, "create", d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
@@ -257,6 +297,9 @@ func resourceNetAppSVMRead(d *schema.ResourceData, meta interface{}) error {
 		svmInfo, err = netappsvm.GetByUUID(client, d.Id())
 	}
 
+	if readGone(d, err) {
+		return nil
+	}
 	if err != nil {
 		// could not get SVM
 		return fmt.Errorf("could not retrieve SVM info, got: %s", err)
@@ -457,5 +500,6 @@ func resourceNetAppSVMDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// wait for job to complete and process data
-	return resolveSvmJob(meta.(*NetAppClient), svmJobRes, "create")
+	return resolveSvmJob(
+		meta.(*NetAppClient), svmJobRes, "delete", d.Timeout(schema.TimeoutDelete))
 }
@@ -0,0 +1,182 @@
+package netapp
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	netappnw "github.com/jogam/terraform-provider-netapp/netapp/internal/helper/network"
+	netappsvm "github.com/jogam/terraform-provider-netapp/netapp/internal/helper/svm"
+	netappsys "github.com/jogam/terraform-provider-netapp/netapp/internal/helper/system"
+)
+
+// testSVMConfig is the SVM resource svm in the ipspace ips of
+// testIPSpaceConfig, args are added to the resource
+func testSVMConfig(name, aggrID string, args ...string) string {
+	return fmt.Sprintf(`
+resource "netapp_svm" "svm" {
+  name              = %q
+  ipspace           = "${netapp_ipspace.ips.id}"
+  rootvol_aggregate = %q
+  %s
+}
+`, name, aggrID, strings.Join(args, "\n  "))
+}
+
+func TestResourceNetAppSVM(t *testing.T) {
+	tc := newTestCluster(t)
+	renamed := testConfig(testIPSpaceConfig("ips1") + testSVMConfig("svm2", tc.aggrID,
+		`rootvol_security_style = "ntfs"`, `rootvol_size = "2g"`))
+	var id string
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: tc.providerFactories(),
+		CheckDestroy:      tc.checkDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(testIPSpaceConfig("ips1") + testSVMConfig("svm1", tc.aggrID,
+					`rootvol_security_style = "ntfs"`, `rootvol_size = "50m"`)),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceID("netapp_svm.svm", &id),
+					resource.TestCheckResourceAttr("netapp_svm.svm", "status_state_svm", "running"),
+					resource.TestCheckResourceAttr("netapp_svm.svm", "status_rootvol_name", "svm1_root"),
+					resource.TestCheckResourceAttr("netapp_svm.svm", "status_rootvol_size", "50m"),
+					resource.TestCheckResourceAttr("netapp_svm.svm", "status_rootvol_security_style", "ntfs"),
+					// the ipspace can not be deleted while used by the SVM
+					tc.checkDeleteFails("netapp_ipspace.ips", "ips1"),
+				),
+			},
+			{
+				Config: renamed,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceID("netapp_svm.svm", &id),
+					resource.TestCheckResourceAttr("netapp_svm.svm", "status_rootvol_size", "2g"),
+				),
+			},
+			// the root volume settings are in the status of the imported SVM
+			testImportStep(renamed, "netapp_svm.svm", "rootvol_security_style", "rootvol_size"),
+		},
+	})
+}
+
+func TestResourceNetAppSVMAdopt(t *testing.T) {
+	tc := newTestCluster(t)
+	api := tc.client(t).api
+
+	// e.g. left over by an interrupted apply, in the Default IPspace whose
+	// ID the config needs
+	ipsInfo, err := netappnw.IPSpaceGetByName(api, "Default")
+	if err != nil {
+		t.Fatalf("ipspace get failed: %s", err)
+	}
+	jobRes, err := netappsvm.Create(api, &netappsvm.Request{
+		Name: "svm1", IPSpace: ipsInfo.Name, RootAggr: testAggrName})
+	if err != nil {
+		t.Fatalf("SVM create failed: %s", err)
+	}
+	if _, err = netappsys.JobWaitDone(api, jobRes.JobID); err != nil {
+		t.Fatalf("SVM create job failed: %s", err)
+	}
+	existing, err := netappsvm.GetByName(api, "svm1")
+	if err != nil {
+		t.Fatalf("SVM get failed: %s", err)
+	}
+
+	cfg := fmt.Sprintf(`
+resource "netapp_svm" "svm" {
+  name              = "svm1"
+  ipspace           = %q
+  rootvol_aggregate = %q
+}
+`, ipsInfo.UUID, tc.aggrID)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: tc.providerFactories(),
+		CheckDestroy:      tc.checkDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(cfg),
+				ExpectError: testExpectError(
+					"SVM [svm1] already exists, import via cmd: terraform import $RESNAME$ '" +
+						existing.UUID + "'"),
+			},
+			{
+				Config: testConfig(cfg, "adopt_existing = true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp_svm.svm", "id", existing.UUID),
+					resource.TestCheckResourceAttr("netapp_svm.svm", "status_state_svm", "running"),
+					tc.checkCommandCount("SVM.CREATE", 1),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceNetAppSVMCreateReplay(t *testing.T) {
+	tc := newCassetteCluster(t, "svm_create")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: tc.providerFactories(),
+		CheckDestroy:      tc.checkDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(fmt.Sprintf(`
+data "netapp_node" "node" {
+  name = %q
+}
+
+data "netapp_aggr" "aggr" {
+  name    = %q
+  node_id = "${data.netapp_node.node.id}"
+}
+`, testNodeName, testAggrName) + testIPSpaceConfig("ips1") +
+					testSVMConfig("svm1", "${data.netapp_aggr.aggr.id}")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp_svm.svm", "status_state_svm", "running"),
+					resource.TestCheckResourceAttr("netapp_svm.svm", "status_rootvol_name", "svm1_root"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceNetAppSVMCreateDeadline(t *testing.T) {
+	tc := newTestCluster(t)
+	svm := testIPSpaceConfig("ips1") + testSVMConfig("svm1", tc.aggrID)
+	var start time.Time
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: tc.providerFactories(),
+		Steps: []resource.TestStep{
+			{
+				// a hung SVM.CREATE is aborted after the provider api_timeout
+				PreConfig: func() {
+					tc.Delays = map[string]time.Duration{"SVM.CREATE": time.Hour}
+				},
+				Config:      testConfig(svm, "api_timeout = 1"),
+				ExpectError: testExpectError("api call [SVM.CREATE] timed out after 1s"),
+			},
+			{
+				// without timeout for SVM.CREATE only the interrupt aborts
+				// the call
+				PreConfig: func() {
+					start = time.Now()
+					go func() {
+						time.Sleep(1500 * time.Millisecond)
+						tc.interrupt()
+					}()
+				},
+				Config: testConfig(svm, "api_timeout = 1", `api_command_timeouts = {
+    "SVM.CREATE" = 0
+  }`),
+				ExpectError: testExpectError("api call [SVM.CREATE] cancelled"),
+			},
+		},
+	})
+
+	if time.Since(start) > 5*time.Second {
+		t.Fatalf("cancel took too long: %s", time.Since(start))
+	}
+}
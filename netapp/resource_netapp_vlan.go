@@ -1,12 +1,13 @@
 package netapp
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
-	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	netappnw "github.com/jogam/terraform-provider-netapp/netapp/internal/helper/network"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/pythonapi"
 	netappsys "github.com/jogam/terraform-provider-netapp/netapp/internal/helper/system"
 )
 
@@ -111,16 +112,16 @@ func resourceNetAppVlanCreate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 	err = netappnw.VlanCreate(client, req)
-	if err != nil {
-		if strings.Contains(err.Error(), "reason=\"duplicate entry\"") {
-			// duplicate vlan, hint at import feature
-			resID := createVlanID(req)
-			return fmt.Errorf(
-				"vlan [%v] on port [%s] already exists, import via cmd: "+
-					"terraform import $RESNAME$ '%s'",
-				vlanID, parentID, resID)
+	if errors.Is(err, pythonapi.ErrAlreadyExists) {
+		// duplicate vlan, adopt it or hint at import feature
+		err = adoptExisting(meta,
+			fmt.Sprintf("vlan [%v] on port [%s]", vlanID, parentID),
+			createVlanID(req), err)
+		if err != nil {
+			return err
 		}
-
+	}
+	if err != nil {
 		return fmt.Errorf(
 			"vlan ID [%v] on port [%s] create, got: %s",
 			vlanID, parentID, err)
@@ -161,6 +162,9 @@ func resourceNetAppVlanRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	vlanInfo, err := netappnw.VlanGet(client, req)
+	if readGone(d, err) {
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("vlan ID [%v] on port [%s] read, got: %s",
 			vlanID, parentID, err)
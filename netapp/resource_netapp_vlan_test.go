@@ -0,0 +1,72 @@
+package netapp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	netappnw "github.com/jogam/terraform-provider-netapp/netapp/internal/helper/network"
+)
+
+// testVlanConfig is the VLAN resource vlan on the parent port
+func testVlanConfig(parentID string, vlanID int) string {
+	return fmt.Sprintf(`
+resource "netapp_vlan" "vlan" {
+  parent_id = %q
+  vlan_id   = %d
+}
+`, parentID, vlanID)
+}
+
+func TestResourceNetAppVlan(t *testing.T) {
+	tc := newTestCluster(t)
+	cfg := testVlanConfig(tc.portIDs["e0c"], 100)
+
+	// e.g. left over by an interrupted apply
+	err := netappnw.VlanCreate(tc.client(t).api, &netappnw.VlanRequest{
+		NodeName: testNodeName, ParentName: "e0c", VlanID: "100"})
+	if err != nil {
+		t.Fatalf("vlan create failed: %s", err)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: tc.providerFactories(),
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			tc.checkDestroyed,
+			tc.checkCommandCount("NW.VLAN.DELETE", 1),
+		),
+		Steps: []resource.TestStep{
+			{
+				// an existing vlan must hint at import
+				Config:      testConfig(cfg),
+				ExpectError: testExpectError("terraform import $RESNAME$ 'node1|e0c|100'"),
+			},
+			{
+				// or is adopted into the state
+				Config: testConfig(cfg, "adopt_existing = true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netapp_vlan.vlan", "id", "node1|e0c|100"),
+					resource.TestCheckResourceAttr("netapp_vlan.vlan", "interface_name", "e0c-100"),
+					resource.TestCheckResourceAttr("netapp_vlan.vlan", "net_qualified_name", "node1:e0c-100"),
+					tc.checkCommandCount("NW.VLAN.CREATE", 1),
+				),
+			},
+			testImportStep(testConfig(cfg), "netapp_vlan.vlan"),
+		},
+	})
+}
+
+func TestResourceNetAppVlanParentMissing(t *testing.T) {
+	tc := newTestCluster(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: tc.providerFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig(testVlanConfig("node1|e9z|00:a0:98:00:00:99", 100)),
+				ExpectError: testExpectError(
+					"vlan ID [100] on port [node1|e9z|00:a0:98:00:00:99] create"),
+			},
+		},
+	})
+}
@@ -0,0 +1,34 @@
+package netapp
+
+import "sync"
+
+// shutdownHooks release what the providers of this process hold until
+// the plugin exits, e.g. cassette files
+var shutdownHooks = struct {
+	sync.Mutex
+	hooks []func()
+}{}
+
+// atShutdown registers hook to be run on Shutdown
+func atShutdown(hook func()) {
+	shutdownHooks.Lock()
+	defer shutdownHooks.Unlock()
+
+	shutdownHooks.hooks = append(shutdownHooks.hooks, hook)
+}
+
+// Shutdown reports the API call metrics of the run and runs the shutdown
+// hooks in reverse registration order, it is called once the provider
+// plugin is done serving
+func Shutdown() {
+	reportMetrics()
+
+	shutdownHooks.Lock()
+	hooks := shutdownHooks.hooks
+	shutdownHooks.hooks = nil
+	shutdownHooks.Unlock()
+
+	for idx := len(hooks) - 1; idx >= 0; idx-- {
+		hooks[idx]()
+	}
+}
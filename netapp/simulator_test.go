@@ -0,0 +1,229 @@
+package netapp
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/pythonapi"
+	"github.com/jogam/terraform-provider-netapp/netapp/internal/helper/simapi"
+	netappsys "github.com/jogam/terraform-provider-netapp/netapp/internal/helper/system"
+)
+
+// seed of the simulated cluster used by the resource tests
+const (
+	testNodeName = "node1"
+	testAggrName = "aggr1"
+)
+
+var testPortNames = []string{"e0c", "e0d", "e0e"}
+
+// testCluster is a simulated cluster with one node, its physical ports
+// and an aggregate, the providers of the tests use it as API backend
+type testCluster struct {
+	*simapi.Cluster
+	backend pythonapi.Backend
+
+	nodeID  string
+	aggrID  string
+	portIDs map[string]string // key: port name, value: port resource ID
+
+	mutex     sync.Mutex
+	providers []*schema.Provider // all providers created, see interrupt
+}
+
+func newTestCluster(t *testing.T) *testCluster {
+	c := simapi.NewCluster()
+	// finish jobs and port updates on first poll to keep tests fast
+	c.JobPolls = 0
+
+	tc := &testCluster{Cluster: c, backend: c.API(), portIDs: map[string]string{}}
+	tc.nodeID = c.AddNode(testNodeName)
+	for _, name := range testPortNames {
+		mac := c.AddPort(testNodeName, name)
+		tc.portIDs[name] = testNodeName + "|" + name + "|" + mac
+	}
+	tc.aggrID = c.AddAggr(testAggrName, testNodeName)
+
+	return tc
+}
+
+// providerFactories returns the netapp provider for resource.Test, it is
+// configured by the provider block of the test config like any provider
+// but calls the simulator instead of the API of api_type
+func (tc *testCluster) providerFactories() map[string]terraform.ResourceProviderFactory {
+	return map[string]terraform.ResourceProviderFactory{
+		"netapp": func() (terraform.ResourceProvider, error) {
+			provider := Provider().(*schema.Provider)
+			provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+				c, err := NewConfig(d)
+				if err != nil {
+					return nil, err
+				}
+				c.backend = tc.backend
+
+				return c.Client(provider.StopContext())
+			}
+
+			tc.mutex.Lock()
+			tc.providers = append(tc.providers, provider)
+			tc.mutex.Unlock()
+
+			return provider, nil
+		},
+	}
+}
+
+// interrupt stops the running providers as Terraform does on Ctrl-C
+func (tc *testCluster) interrupt() {
+	tc.mutex.Lock()
+	defer tc.mutex.Unlock()
+
+	for _, provider := range tc.providers {
+		provider.Stop()
+	}
+}
+
+// client returns provider meta data connected to the simulator for the
+// tests of single resource functions
+func (tc *testCluster) client(t *testing.T) *NetAppClient {
+	t.Helper()
+
+	_, err := netappsys.Connect(tc.backend, &netappsys.ConnectRequest{
+		Host: "simulator", User: "admin", Password: "secret"})
+	if err != nil {
+		t.Fatalf("simulator connect failed: %s", err)
+	}
+
+	return &NetAppClient{
+		api: tc.backend, OntapVersion: simapi.OntapVersion, OsVersion: simapi.OsVersion}
+}
+
+// testConfig returns the Terraform config of the resources with the
+// provider block of the simulator, providerArgs are added to the block.
+// Failed calls are not retried to keep the fault tests fast
+func testConfig(resources string, providerArgs ...string) string {
+	return fmt.Sprintf(`
+provider "netapp" {
+  user        = "admin"
+  password    = "secret"
+  host        = "simulator"
+  api_type    = "zapi"
+  max_retries = 0
+  %s
+}
+%s`, strings.Join(providerArgs, "\n  "), resources)
+}
+
+// testImportStep imports the resource of the config by its ID, the
+// imported state must match the state of the earlier steps except the
+// ignored attributes, e.g. optional settings only read back when they
+// are configured
+func testImportStep(config, name string, ignore ...string) resource.TestStep {
+	return resource.TestStep{
+		Config:                  config,
+		ResourceName:            name,
+		ImportState:             true,
+		ImportStateVerify:       true,
+		ImportStateVerifyIgnore: ignore,
+	}
+}
+
+// testExpectError matches errors containing msg
+func testExpectError(msg string) *regexp.Regexp {
+	return regexp.MustCompile(regexp.QuoteMeta(msg))
+}
+
+// testCheckResourceID checks the ID of the resource, an empty expected ID
+// takes the ID of the state to check it in later steps
+func testCheckResourceID(name string, expected *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource [%s] not found in state", name)
+		}
+
+		if *expected == "" {
+			*expected = rs.Primary.ID
+		} else if rs.Primary.ID != *expected {
+			return fmt.Errorf(
+				"expected resource [%s] ID [%s], got: [%s]", name, *expected, rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// checkCommandCount checks how often the simulator executed the command
+func (tc *testCluster) checkCommandCount(cmdName string, count int) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if actual := tc.CommandCount(cmdName); actual != count {
+			return fmt.Errorf("expected %d [%s] calls, got: %d", count, cmdName, actual)
+		}
+
+		return nil
+	}
+}
+
+// checkDestroyed checks the resources of the state before the destroy
+// no longer exist, the refresh uses the connection of the providers
+func (tc *testCluster) checkDestroyed(s *terraform.State) error {
+	meta := &NetAppClient{api: tc.backend}
+	resources := Provider().(*schema.Provider).ResourcesMap
+
+	for name, rs := range s.RootModule().Resources {
+		// data sources are not destroyed
+		r, ok := resources[rs.Type]
+		if !ok {
+			continue
+		}
+
+		state, err := r.Refresh(rs.Primary, meta)
+		if err != nil {
+			return fmt.Errorf("refresh of [%s] failed: %s", name, err)
+		}
+		if state != nil {
+			return fmt.Errorf("resource [%s] still exists after destroy: %s", name, state.ID)
+		}
+	}
+
+	return nil
+}
+
+// checkDeleteFails checks the delete of the resource fails with msg, e.g.
+// while used by a resource Terraform always deletes first
+func (tc *testCluster) checkDeleteFails(name, msg string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource [%s] not found in state", name)
+		}
+
+		r := Provider().(*schema.Provider).ResourcesMap[rs.Type]
+		_, err := r.Apply(
+			rs.Primary, &terraform.InstanceDiff{Destroy: true}, &NetAppClient{api: tc.backend})
+		if err == nil || !strings.Contains(err.Error(), msg) {
+			return fmt.Errorf("expected delete of [%s] to fail with [%s], got: %v", name, msg, err)
+		}
+
+		return nil
+	}
+}
+
+func testResourceConfig(t *testing.T, raw map[string]interface{}) *terraform.ResourceConfig {
+	t.Helper()
+
+	rc, err := config.NewRawConfig(raw)
+	if err != nil {
+		t.Fatalf("invalid test config %v: %s", raw, err)
+	}
+
+	return terraform.NewResourceConfig(rc)
+}
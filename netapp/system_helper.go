@@ -200,15 +200,40 @@ func getNetQualifiedNameFromID(pvid string) (string, error) {
 		pvid, builder.String(), err)
 }
 
-func getResourceIDfromNetQualifiedName(client *pythonapi.NetAppAPI, nqName string) (string, error) {
-	parts := strings.Split(nqName, ":")
-	if len(parts) != 2 {
-		return "", fmt.Errorf(
-			"net-qualified name format shoud be "+
-				"[NodeName:PortName], got: %s", nqName)
+func getResourceIDsfromNetQualifiedNames(
+	client pythonapi.Backend, nqNames []string) ([]string, []error) {
+
+	resIDs := make([]string, len(nqNames))
+	errs := make([]error, len(nqNames))
+
+	// the ports of all valid names are read with one batch
+	var valid []int
+	var requests []*netappsys.PortGetRequest
+	for idx, nqName := range nqNames {
+		parts := strings.Split(nqName, ":")
+		if len(parts) != 2 {
+			errs[idx] = fmt.Errorf(
+				"net-qualified name format shoud be "+
+					"[NodeName:PortName], got: %s", nqName)
+			continue
+		}
+
+		valid = append(valid, idx)
+		requests = append(requests,
+			&netappsys.PortGetRequest{NodeName: parts[0], PortName: parts[1]})
 	}
 
-	pInfo, err := netappsys.PortGetByNames(client, parts[0], parts[1])
+	pInfos, pErrs := netappsys.PortGetBatch(client, requests)
+	for pIdx, idx := range valid {
+		resIDs[idx], errs[idx] = resourceIDfromPortInfo(
+			nqNames[idx], pInfos[pIdx], pErrs[pIdx])
+	}
+
+	return resIDs, errs
+}
+
+func resourceIDfromPortInfo(
+	nqName string, pInfo *netappsys.PortInfo, err error) (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("could not get port [%s] info, got: %s", nqName, err)
 	}